@@ -20,12 +20,15 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
@@ -33,7 +36,6 @@ import (
 	"github.com/azure-octo/same-cli/pkg/utils"
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
 
 	"github.com/spf13/cobra"
 )
@@ -47,14 +49,30 @@ var compileProgramCmd = &cobra.Command{
 			return err
 		}
 
+		sameConfigFilePath, err := resolveAndValidateSAME(cmd, args, filePath)
+		if err != nil {
+			return err
+		}
+
 		persistTempFiles, err := cmd.Flags().GetBool("persist-temp-files")
 		if err != nil {
 			return err
 		}
 
-		target, err := cmd.Flags().GetString("target")
+		// Load config file. Explicit parameters take precedent over config file.
+		loadParams, err := runParamsFromFlags(cmd)
 		if err != nil {
-			target = "kubeflow"
+			return err
+		}
+		sameConfigFile, err := loaders.V1{Params: loadParams}.LoadSAME(sameConfigFilePath)
+		if err != nil {
+			log.Errorf("could not load SAME config file: %v", err)
+			return err
+		}
+
+		target, err := resolveTarget(cmd, sameConfigFile)
+		if err != nil {
+			return err
 		}
 
 		if target == "aml" {
@@ -85,19 +103,6 @@ var compileProgramCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		// Load config file. Explicit parameters take precedent over config file.
-		u := utils.GetUtils(cmd, args)
-		sameConfigFilePath, err := u.GetConfigFilePath(filePath)
-		if err != nil {
-			log.Errorf("could not resolve SAME config file path: %v", err)
-			return err
-		}
-
-		sameConfigFile, err := loaders.V1{}.LoadSAME(sameConfigFilePath)
-		if err != nil {
-			log.Errorf("could not load SAME config file: %v", err)
-			return err
-		}
 
 		for env_name, env := range sameConfigFile.Spec.Environments {
 			var missing_credentials []string
@@ -156,35 +161,74 @@ var compileProgramCmd = &cobra.Command{
 		}
 
 		if sameConfigFile.Spec.ConfigFilePath == "" {
-			sameConfigFile.Spec.ConfigFilePath = filePath
+			// Files the SAME file references are found next to it, in the cache for remote programs
+			sameConfigFile.Spec.ConfigFilePath = localConfigFilePath(sameConfigFilePath)
 		}
 
-		params, _ := cmd.Flags().GetStringSlice("run-param")
-
-		runParams := make(map[string]interface{})
-
-		if len(sameConfigFile.Spec.Run.Parameters) > 0 {
-			runParams = sameConfigFile.Spec.Run.Parameters
+		if _, err := RecordGitSource(cmd, sameConfigFilePath, sameConfigFile); err != nil {
+			return err
 		}
 
 		// override the explicitly set run parameters
-		for _, param := range params {
-			parts := strings.SplitN(param, "=", 2)
-			if len(parts) != 2 {
-				println(fmt.Sprintf("Invalid param format %q. Expect: key=value", param))
-			}
-			runParams[parts[0]] = parts[1]
+		runParams, err := sameConfigFile.Spec.ResolveRunParameters(sameConfigFile.Spec.Run, loadParams)
+		if err != nil {
+			return err
 		}
+		sameConfigFile.Spec.Run.Parameters = runParams
 
 		doNotCopyFiles, _ := cmd.Flags().GetBool("do-not-copy-files")
 
-		compiledDir, _, err := CompileFile(target, *sameConfigFile, persistTempFiles, doNotCopyFiles)
+		outputDir, _ := cmd.Flags().GetString("output-dir")
+
+		strictPackages, _ := cmd.Flags().GetBool("strict-packages")
+
+		explain, _ := cmd.Flags().GetBool("explain")
+		explainFormat, _ := cmd.Flags().GetString("output")
+		if explainFormat != "text" && explainFormat != "json" {
+			return fmt.Errorf("unknown output format '%v', expected 'text' or 'json'", explainFormat)
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "python" && format != "argo-yaml" {
+			return fmt.Errorf("unknown format '%v', expected 'python' or 'argo-yaml'", format)
+		}
+		if format == "argo-yaml" && target != "kubeflow" {
+			return fmt.Errorf("only programs compiled for kubeflow run as Argo workflows, this one is compiled for %v", target)
+		}
+		if format == "argo-yaml" && explain {
+			return fmt.Errorf("--explain can't be combined with --format argo-yaml, as both are printed to stdout")
+		}
+
+		// The JSON report, or the workflow, is the only thing written to stdout, so it can be parsed
+		progress := cmd.OutOrStdout()
+		if (explain && explainFormat == "json") || format == "argo-yaml" {
+			progress = cmd.ErrOrStderr()
+		}
+		compiledDir, _, err := CompileFile(target, *sameConfigFile, persistTempFiles, doNotCopyFiles, outputDir, strictPackages, progress)
 		if err != nil {
 			return err
 		}
-		if !persistTempFiles {
+		if !persistTempFiles && outputDir == "" {
 			defer os.Remove(compiledDir)
 		}
+
+		if explain {
+			report, err := utils.ReadCompileReport(compiledDir)
+			if err != nil {
+				return err
+			}
+			return printCompileReport(cmd.OutOrStdout(), *report, explainFormat)
+		}
+		if format == "argo-yaml" {
+			workflowYAML, err := ioutil.ReadFile(filepath.Join(compiledDir, utils.ArgoWorkflowFileName))
+			if err != nil {
+				return fmt.Errorf("could not read the Argo workflow in %v: %v", compiledDir, err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), string(workflowYAML))
+			return nil
+		}
+		// The root file defines the pipeline in Python, for the KFP or AML SDK
+		fmt.Fprintln(cmd.OutOrStdout(), filepath.Join(compiledDir, "root.py"))
 		return nil
 	},
 }
@@ -199,7 +243,7 @@ func getTemporaryCompileDirectory() (string, error) {
 }
 
 func writeSameConfigFile(compiledDir string, sameConfigFile loaders.SameConfig) error {
-	sameConfigFileYaml, err := yaml.Marshal(&sameConfigFile.Spec)
+	sameConfigFileYaml, err := loaders.V1{}.MarshalSAME(sameConfigFile)
 	if err != nil {
 		return fmt.Errorf("error marshaling same config file: %v", err.Error())
 	}
@@ -227,25 +271,23 @@ func writeRootFile(compiledDir string, rootFileContents string) error {
 	return nil
 }
 
-func checkExecutableAndFile(sameConfigFile loaders.SameConfig) (string, string, error) {
-	jupytextExecutable, err := exec.LookPath("jupytext")
-	if err != nil {
-		return "", "", fmt.Errorf("could not find 'jupytext'. Please run 'python3 -m pip install jupytext'. You may also need to add it to your path by executing: export PATH=$PATH:$HOME/.local/bin")
-	}
-
+func checkNotebookFile(sameConfigFile loaders.SameConfig) (string, error) {
 	notebookRootDir := filepath.Dir(sameConfigFile.Spec.ConfigFilePath)
 	notebookFilePath, err := utils.ResolveLocalFilePath(filepath.Join(notebookRootDir, sameConfigFile.Spec.Pipeline.Package))
 	if err != nil {
-		return "", "", fmt.Errorf("program_compile.go: could not find pipeline definition specified in SAME program: %v", notebookFilePath)
+		return "", fmt.Errorf("program_compile.go: could not find pipeline definition specified in SAME program: %v", notebookFilePath)
 	}
 
-	return jupytextExecutable, notebookFilePath, nil
+	return notebookFilePath, nil
 
 }
 
-func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFiles bool, doNotCopyFiles bool) (compileDirectory string, updatedSameConfig loaders.SameConfig, err error) {
+// CompileFile compiles the notebook of a SAME program into outputDir, or a temporary directory if outputDir is
+// empty. The output is the same for the same inputs, and a manifest of the inputs is written with it, so
+// compiling unchanged inputs into the same outputDir again is skipped. Progress is printed to progress.
+func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFiles bool, doNotCopyFiles bool, outputDir string, strictPackages bool, progress io.Writer) (compileDirectory string, updatedSameConfig loaders.SameConfig, err error) {
 	var c = utils.GetCompileFunctions()
-	jupytextExecutablePath, notebookFilePath, err := checkExecutableAndFile(sameConfigFile)
+	notebookFilePath, err := checkNotebookFile(sameConfigFile)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
 	}
@@ -254,18 +296,57 @@ func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFi
 		return "", loaders.SameConfig{}, fmt.Errorf("no experiment name detected in Metadata.Name")
 	}
 
-	missingPackages, err := c.ConfirmPackages(sameConfigFile)
-	if err != nil || len(missingPackages) > 0 {
-		return "", loaders.SameConfig{}, fmt.Errorf("It appears your default environment in your same file () does not have the following packages installed. You can update it by cutting and pasting the following text into it: %v", missingPackages)
+	// Environments appending the current environment also install every package installed locally. The packages
+	// they declare are compared to the local ones, and are reported when they differ, failing the compile only
+	// with strictPackages set
+	if utils.AppendsCurrentEnvironment(sameConfigFile) {
+		currentPackages, err := utils.CurrentPackages()
+		if err != nil {
+			return "", loaders.SameConfig{}, err
+		}
+		if mismatches := c.ConfirmPackages(sameConfigFile, currentPackages); len(mismatches) > 0 {
+			message := packageMismatchesMessage(mismatches)
+			if strictPackages {
+				return "", loaders.SameConfig{}, fmt.Errorf("%v. Install them, or pin the environment to the local packages with 'same program run --capture-current-environment <environment> --save-captured-environment'", message)
+			}
+			log.Warnf("%v. The packages that aren't installed locally are installed as declared, the others at their local versions.", message)
+		}
+		utils.AppendCurrentEnvironments(&sameConfigFile, currentPackages)
+	}
+
+	inputs, err := utils.CompileInputs(target, RootCmd.Version, sameConfigFile, notebookFilePath, !doNotCopyFiles, outputDir)
+	if err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+
+	compiledDir := outputDir
+	if outputDir != "" {
+		if manifest, err := utils.ReadCompileManifest(outputDir); err == nil {
+			if manifest.UpToDate(inputs, outputDir) {
+				fmt.Fprintf(progress, "The program in %v is up to date with its notebook and SAME file, skipping compilation.\n", outputDir)
+				sameConfigFile.Spec.Pipeline.Package = compiledPackage(target, outputDir)
+				return outputDir, sameConfigFile, nil
+			}
+			if err := manifest.RemoveOutputs(outputDir); err != nil {
+				return "", loaders.SameConfig{}, err
+			}
+		}
+		if err := os.MkdirAll(outputDir, 0700); err != nil {
+			return "", loaders.SameConfig{}, fmt.Errorf("error creating the output directory %v: %v", outputDir, err)
+		}
+	} else {
+		compiledDir, err = getTemporaryCompileDirectory()
+		if err != nil {
+			return "", loaders.SameConfig{}, err
+		}
 	}
-	_ = missingPackages
 
-	convertedText, err := c.ConvertNotebook(jupytextExecutablePath, notebookFilePath)
+	cells, err := c.ReadNotebook(notebookFilePath)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
 	}
 
-	foundSteps, err := c.FindAllSteps(convertedText)
+	foundSteps, err := c.FindAllSteps(cells)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
 	}
@@ -275,10 +356,29 @@ func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFi
 		return "", loaders.SameConfig{}, err
 	}
 
-	compiledDir, err := getTemporaryCompileDirectory()
+	// Every step gets every run parameter, decoded to its declared type
+	runParameters := utils.RunParameters(sameConfigFile)
+	importMap, err := utils.ImportMapFor(sameConfigFile, filepath.Dir(notebookFilePath))
+	if err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+	contextStorage, err := utils.ResolveContextStorage(sameConfigFile)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
 	}
+	for stepName, thisCodeBlock := range aggregatedSteps {
+		thisCodeBlock.RunParameters = runParameters
+		thisCodeBlock.ImportMap = importMap
+		thisCodeBlock.ContextStorage = contextStorage
+		thisCodeBlock.SourceFile = filepath.Base(notebookFilePath)
+		aggregatedSteps[stepName] = thisCodeBlock
+	}
+
+	variableFlows, err := utils.VariableFlows(aggregatedSteps)
+	if err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+	printVariableFlows(progress, aggregatedSteps, variableFlows)
 
 	packagesBySteps, err := c.WriteStepFiles(target, compiledDir, aggregatedSteps)
 	if err != nil {
@@ -308,6 +408,14 @@ func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFi
 		aggregatedSteps[stepName] = thisCodeBlock
 	}
 
+	report, err := utils.NewCompileReport(target, aggregatedSteps, sameConfigFile, inputs)
+	if err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+	if err := report.Write(compiledDir); err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+
 	rootFileContents, err := c.CreateRootFile(target, aggregatedSteps, sameConfigFile)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
@@ -318,7 +426,18 @@ func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFi
 		return "", loaders.SameConfig{}, err
 	}
 
-	sameConfigFile.Spec.Pipeline.Package = filepath.Join(compiledDir, "root.py")
+	// Kubeflow runs the workflow, which is generated here rather than by compiling the root file with the KFP SDK
+	if target == "kubeflow" {
+		workflow, err := c.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, compiledDir)
+		if err != nil {
+			return "", loaders.SameConfig{}, err
+		}
+		if err := utils.WriteArgoWorkflow(compiledDir, workflow); err != nil {
+			return "", loaders.SameConfig{}, err
+		}
+	}
+
+	sameConfigFile.Spec.Pipeline.Package = compiledPackage(target, compiledDir)
 	err = writeSameConfigFile(compiledDir, sameConfigFile)
 	if err != nil {
 		return "", loaders.SameConfig{}, err
@@ -332,23 +451,140 @@ func CompileFile(target string, sameConfigFile loaders.SameConfig, persistTempFi
 				supportFileDestinationDirectories = append(supportFileDestinationDirectories, filepath.Join(compiledDir, step.StepIdentifier))
 			}
 		}
-		err = c.WriteSupportFiles(filepath.Dir(notebookFilePath), supportFileDestinationDirectories)
+		if err := c.WriteSupportFiles(filepath.Dir(notebookFilePath), supportFileDestinationDirectories); err != nil {
+			return "", loaders.SameConfig{}, err
+		}
+	}
+
+	manifest, err := utils.NewCompileManifest(inputs, compiledDir)
+	if err != nil {
+		return "", loaders.SameConfig{}, err
+	}
+	if err := manifest.Write(compiledDir); err != nil {
+		return "", loaders.SameConfig{}, err
 	}
 
-	fmt.Printf("Compilation complete! In order to upload, go to this directory (%v) and execute 'same program run'.\n", compiledDir)
-	return compiledDir, updatedSameConfig, err
+	fmt.Fprintf(progress, "Compilation complete! In order to upload, go to this directory (%v) and execute 'same program run'.\n", compiledDir)
+	return compiledDir, updatedSameConfig, nil
 
 }
 
+// packageMismatchesMessage describes the declared packages that don't match the ones installed locally, see
+// utils.PackageMismatches.
+func packageMismatchesMessage(mismatches map[string]string) string {
+	requirements := make([]string, 0, len(mismatches))
+	for requirement := range mismatches {
+		requirements = append(requirements, requirement)
+	}
+	sort.Strings(requirements)
+	descriptions := make([]string, 0, len(requirements))
+	for _, requirement := range requirements {
+		if mismatches[requirement] == "" {
+			descriptions = append(descriptions, fmt.Sprintf("%v (not installed)", requirement))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("%v (installed: %v)", requirement, mismatches[requirement]))
+		}
+	}
+	return fmt.Sprintf("the environments with 'append_current_environment' set declare packages that don't match the ones installed locally: %v", strings.Join(descriptions, ", "))
+}
+
+// compiledPackage is the file of a compiled program that is uploaded to the target: the Argo workflow on
+// Kubeflow, and the root file on AML.
+func compiledPackage(target string, compiledDir string) string {
+	if target == "kubeflow" {
+		return filepath.Join(compiledDir, utils.ArgoWorkflowFileName)
+	}
+	return filepath.Join(compiledDir, "root.py")
+}
+
+// printVariableFlows prints a table of the variables each step passes to the steps after it, see
+// utils.VariableFlows.
+func printVariableFlows(out io.Writer, aggregatedSteps map[string]utils.CodeBlock, variableFlows []utils.VariableFlow) {
+	if len(variableFlows) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Variables passed between steps:")
+	w := NewTabWriter(out)
+	fmt.Fprintf(w, "%s\t%s\t%s\n", "FROM", "TO", "VARIABLES")
+	for _, flow := range variableFlows {
+		variables := strings.Join(flow.Variables, ", ")
+		if flow.All {
+			variables = "all (read dynamically)"
+		} else if variables == "" {
+			variables = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", aggregatedSteps[flow.From].DisplayName, aggregatedSteps[flow.To].DisplayName, variables)
+	}
+	w.Flush()
+}
+
+// printCompileReport prints the report of a compiled program, as text or as JSON.
+func printCompileReport(out io.Writer, report utils.CompileReport, format string) error {
+	if format == "json" {
+		reportBytes, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not marshal the compile report: %v", err)
+		}
+		fmt.Fprintln(out, string(reportBytes))
+		return nil
+	}
+
+	listOrNone := func(items []string) string {
+		if len(items) == 0 {
+			return "-"
+		}
+		return strings.Join(items, ", ")
+	}
+
+	fmt.Fprintf(out, "Program:        %v (%v)\n", report.Program, report.Target)
+	fmt.Fprintf(out, "Notebook:       %v\n", report.Notebook)
+	fmt.Fprintf(out, "Inputs hash:    %v\n", report.InputsHash)
+	fmt.Fprintln(out, "Parameters:")
+	if len(report.Parameters) > 0 {
+		w := NewTabWriter(out)
+		fmt.Fprintf(w, "    %s\t%s\t%s\n", "NAME", "TYPE", "DEFAULT")
+		for _, parameter := range report.Parameters {
+			fmt.Fprintf(w, "    %s\t%s\t%s\n", parameter.Name, parameter.Type, parameter.Default)
+		}
+		w.Flush()
+	}
+	fmt.Fprintln(out, "Support files:")
+	for _, supportFile := range report.SupportFiles {
+		fmt.Fprintf(out, "    %v\n", supportFile)
+	}
+	fmt.Fprintln(out, "Steps:")
+	for _, step := range report.Steps {
+		cells := make([]string, 0, len(step.Cells))
+		for _, cell := range step.Cells {
+			cells = append(cells, strconv.Itoa(cell))
+		}
+		fmt.Fprintf(out, "  %v (%v)\n", step.DisplayName, step.Name)
+		fmt.Fprintf(out, "    Cells:           %v\n", listOrNone(cells))
+		fmt.Fprintf(out, "    Environment:     %v\n", step.Environment)
+		fmt.Fprintf(out, "    Image:           %v\n", step.Image)
+		fmt.Fprintf(out, "    Packages:        %v\n", listOrNone(step.Packages))
+		fmt.Fprintf(out, "    Cache staleness: %v\n", step.CacheStaleness)
+		fmt.Fprintf(out, "    Tags:            %v\n", listOrNone(step.Tags))
+		fmt.Fprintf(out, "    Predecessors:    %v\n", listOrNone(step.Predecessors))
+	}
+	return nil
+}
+
 func init() {
 	programCmd.AddCommand(compileProgramCmd)
 
 	compileProgramCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml').")
 	compileProgramCmd.Flags().Bool("persist-temp-files", false, "Persist the temporary compilation files.")
-	compileProgramCmd.Flags().StringP("target", "t", "kubeflow", "Enter one of 'kubeflow', 'aml'. Defaults to: kubeflow")
+	compileProgramCmd.Flags().String("git-dirty", "", "What to do when the SAME file's git working tree has uncommitted changes: 'warn', 'fail' or 'ignore'. Defaults to the 'git_dirty' setting, then 'warn'.")
+	compileProgramCmd.Flags().StringP("target", "t", "", "Enter one of 'kubeflow', 'aml'. Defaults to workflow.type in the SAME file, then the 'target' setting, then kubeflow.")
 	compileProgramCmd.Flags().String("image-pull-secret-server", "", "Image pull server for any private repos (only one server currently supported for all private repos)")
 	compileProgramCmd.Flags().String("image-pull-secret-username", "", "Image pull username for any private repos (only one username currently supported for all private repos)")
 	compileProgramCmd.Flags().String("image-pull-secret-password", "", "Image pull password for any private repos (only one password currently supported for all private repos)")
 	compileProgramCmd.Flags().String("image-pull-secret-email", "", "Image pull email for any private repos (only one email currently supported for all private repos)")
 	compileProgramCmd.Flags().Bool("do-not-copy-files", false, "Do not copy all python files in the same directory as the notebook.")
+	compileProgramCmd.Flags().Bool("explain", false, "Print a report of what the program was compiled to: its steps (with their cells, environment, image, packages, cache staleness, tags and predecessors), parameters and support files.")
+	compileProgramCmd.Flags().StringP("output", "o", "text", "The format of the --explain report: 'text' or 'json'.")
+	compileProgramCmd.Flags().String("format", "python", "What to print once the program is compiled: the path of the Python root file defining the pipeline for 'python', or the Argo workflow Kubeflow runs (and nothing else on stdout) for 'argo-yaml'. --explain prints its report instead.")
+	compileProgramCmd.Flags().Bool("strict-packages", false, "Fail instead of warning when the environments with 'append_current_environment' set declare packages that don't match the ones installed locally.")
+	compileProgramCmd.Flags().String("output-dir", "", "The directory to compile the program into, instead of a temporary directory. Compiling an unchanged notebook and SAME file into it again is skipped.")
 }
@@ -0,0 +1,106 @@
+package cmd
+
+/*
+Copyright © 2021 The SAME Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"fmt"
+	"io/ioutil"
+	netUrl "net/url"
+	"os"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/spf13/cobra"
+)
+
+var migrateProgramCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrates a SAME program file to another apiVersion",
+	Long:  `Rewrites a SAME program file in place in the format of another apiVersion, keeping comments where possible. Bases are not migrated, run this against each of them as well.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		toAPIVersion, err := cmd.Flags().GetString("to")
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(toAPIVersion, "/") {
+			toAPIVersion = "projectsame.io/" + toAPIVersion
+		}
+		if _, ok := loaders.LoaderForAPIVersion(toAPIVersion); !ok {
+			return fmt.Errorf("unsupported apiVersion '%v', expected one of: %v", toAPIVersion, strings.Join(loaders.LoaderAPIVersions(), ", "))
+		}
+
+		isRemoteFile, err := utils.GetUtils(cmd, args).IsRemoteFilePath(filePath)
+		if err != nil {
+			return err
+		}
+		if isRemoteFile {
+			return fmt.Errorf("only local SAME program files can be migrated, found: %v", filePath)
+		}
+
+		sameConfigFilePath, err := utils.GetUtils(cmd, args).GetConfigFilePath(filePath)
+		if err != nil {
+			return fmt.Errorf("could not resolve SAME config file path: %v", err)
+		}
+
+		// Local paths are resolved to file:// URLs
+		if resolvedConfigFilePath, err := netUrl.Parse(sameConfigFilePath); err == nil && resolvedConfigFilePath.Path != "" {
+			sameConfigFilePath = resolvedConfigFilePath.Path
+		}
+
+		configFileBytes, err := ioutil.ReadFile(sameConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("could not read from config file %s: %v", filePath, err)
+		}
+
+		migratedBytes, err := loaders.MigrateSAMEBytes(filePath, configFileBytes, toAPIVersion)
+		if err != nil {
+			return err
+		}
+
+		fileInfo, err := os.Stat(sameConfigFilePath)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(sameConfigFilePath, migratedBytes, fileInfo.Mode()); err != nil {
+			return fmt.Errorf("could not write migrated SAME file to %v: %v", filePath, err)
+		}
+
+		var sameDef map[string]interface{}
+		if err := yaml.Unmarshal(migratedBytes, &sameDef); err == nil && sameDef["bases"] != nil {
+			log.Warnf("%v has bases, which have to be migrated separately.", filePath)
+		}
+
+		cmd.Printf("Migrated %v to %v.\n", filePath, toAPIVersion)
+		return nil
+	},
+}
+
+func init() {
+	programCmd.AddCommand(migrateProgramCmd)
+
+	migrateProgramCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml').")
+	migrateProgramCmd.Flags().String("to", loaders.LatestAPIVersion, "The apiVersion to migrate the SAME program file to.")
+}
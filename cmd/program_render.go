@@ -0,0 +1,67 @@
+package cmd
+
+/*
+Copyright © 2021 The SAME Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"fmt"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+
+	"github.com/spf13/cobra"
+)
+
+var renderProgramCmd = &cobra.Command{
+	Use:   "render",
+	Short: "Prints the fully merged SAME program file",
+	Long:  `Prints a SAME program file after it has been layered over all of its bases, exactly as the other program commands will see it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		sameConfigFilePath, err := resolveAndValidateSAME(cmd, args, filePath)
+		if err != nil {
+			return err
+		}
+
+		params, err := runParamsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		sameConfigFile, err := loaders.V1{Params: params}.LoadSAME(sameConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("could not load SAME config file: %v", err)
+		}
+
+		rendered, err := loaders.V1{}.MarshalSAME(*sameConfigFile)
+		if err != nil {
+			return fmt.Errorf("could not render SAME config file: %v", err)
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), string(rendered))
+		return nil
+	},
+}
+
+func init() {
+	programCmd.AddCommand(renderProgramCmd)
+
+	renderProgramCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml').")
+	renderProgramCmd.Flags().StringSliceP("run-param", "p", nil, "A parameter to interpolate into the SAME program file in key=value form. Repeat for multiple params.")
+}
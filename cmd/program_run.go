@@ -25,7 +25,6 @@ import (
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
 	"github.com/azure-octo/same-cli/pkg/infra"
 	"github.com/azure-octo/same-cli/pkg/utils"
-	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
 	"bytes"
@@ -45,6 +44,11 @@ var runProgramCmd = &cobra.Command{
 			return err
 		}
 
+		sameConfigFilePath, err := resolveAndValidateSAME(cmd, args, filePath)
+		if err != nil {
+			return err
+		}
+
 		programName, err := cmd.Flags().GetString("program-name")
 		if err != nil {
 			programName = ""
@@ -75,32 +79,50 @@ var runProgramCmd = &cobra.Command{
 			persistTemporaryFiles = false
 		}
 
-		target, err := cmd.Flags().GetString("target")
-		if err != nil {
-			target = "kubeflow"
-		}
+		outputDir, _ := cmd.Flags().GetString("output-dir")
 
-		_ = target
+		strictPackages, _ := cmd.Flags().GetBool("strict-packages")
 
-		if err := infra.GetDependencyCheckers(cmd, args).CheckDependenciesInstalled(); err != nil {
-			return fmt.Errorf("Failed during dependency checks: %v", err)
+		// Load config file. Explicit parameters take precedent over config file.
+		loadParams, err := runParamsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		profile := runProfileFromFlags(cmd)
+		sameConfigFile, err := loaders.V1{Params: loadParams, Profile: profile}.LoadSAME(sameConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("could not load SAME config file: %v", err)
 		}
 
-		err = infra.GetDependencyCheckers(cmd, args).CheckForMissingPackages(target)
+		target, err := resolveTarget(cmd, sameConfigFile)
 		if err != nil {
 			return err
 		}
 
-		// Load config file. Explicit parameters take precedent over config file.
-		u := utils.GetUtils(cmd, args)
-		sameConfigFilePath, err := u.GetConfigFilePath(filePath)
-		if err != nil {
-			return fmt.Errorf("could not resolve SAME config file path: %v", err)
+		// Capturing the current environment pins an environment to the packages installed locally
+		if captureEnvironment, _ := cmd.Flags().GetString("capture-current-environment"); captureEnvironment != "" {
+			saveCapturedEnvironment, _ := cmd.Flags().GetBool("save-captured-environment")
+			if saveCapturedEnvironment {
+				isRemoteFile, err := utils.GetUtils(cmd, args).IsRemoteFilePath(filePath)
+				if err != nil {
+					return err
+				}
+				if isRemoteFile {
+					return fmt.Errorf("only local SAME program files can be updated with a captured environment, found: %v", filePath)
+				}
+			}
+			if err := CaptureCurrentEnvironment(cmd, sameConfigFilePath, sameConfigFile, captureEnvironment, saveCapturedEnvironment); err != nil {
+				return err
+			}
 		}
 
-		sameConfigFile, err := loaders.V1{}.LoadSAME(sameConfigFilePath)
+		if err := infra.GetDependencyCheckers(cmd, args).CheckDependenciesInstalled(); err != nil {
+			return fmt.Errorf("Failed during dependency checks: %v", err)
+		}
+
+		err = infra.GetDependencyCheckers(cmd, args).CheckForMissingPackages(target)
 		if err != nil {
-			return fmt.Errorf("could not load SAME config file: %v", err)
+			return err
 		}
 
 		for env_name, env := range sameConfigFile.Spec.Environments {
@@ -160,31 +182,37 @@ var runProgramCmd = &cobra.Command{
 		}
 
 		if sameConfigFile.Spec.ConfigFilePath == "" {
-			sameConfigFile.Spec.ConfigFilePath = filePath
+			// Files the SAME file references are found next to it, in the cache for remote programs
+			sameConfigFile.Spec.ConfigFilePath = localConfigFilePath(sameConfigFilePath)
+		}
+
+		gitInfo, err := RecordGitSource(cmd, sameConfigFilePath, sameConfigFile)
+		if err != nil {
+			return err
 		}
 
 		if sameConfigFile.Spec.Pipeline.Name != "" && programName == "" {
 			programName = sameConfigFile.Spec.Pipeline.Name
 		}
 
-		params, _ := cmd.Flags().GetStringSlice("run-param")
-
-		runParams := make(map[string]interface{})
-
-		if len(sameConfigFile.Spec.Run.Parameters) > 0 {
-			runParams = sameConfigFile.Spec.Run.Parameters
+		run, err := sameConfigFile.Spec.RunProfile(profile)
+		if err != nil {
+			return err
+		}
+		if runDescription == "" {
+			runDescription = run.Description
 		}
 
-		// override the explicitly set run parameters
-		for _, param := range params {
-			parts := strings.SplitN(param, "=", 2)
-			if len(parts) != 2 {
-				return fmt.Errorf("Invalid param format %q. Expect: key=value", param)
-			}
-
-			runParams[parts[0]] = parts[1]
+		// Explicitly set run parameters override the run's, and are checked before anything is uploaded
+		runParams, err := sameConfigFile.Spec.ResolveRunParameters(run, loadParams)
+		if err != nil {
+			return err
 		}
 
+		// The defaults of the generated pipeline come from the run being started
+		run.Parameters = runParams
+		sameConfigFile.Spec.Run = run
+
 		log.Tracef("Target: %v", target)
 		if target == "kubeflow" {
 
@@ -200,7 +228,7 @@ var runProgramCmd = &cobra.Command{
 					if sameConfigFile.Spec.Pipeline.Description != "" && programDescription == "" {
 						programDescription = sameConfigFile.Spec.Pipeline.Description
 					}
-					uploadedPipeline, err := UploadPipeline(target, sameConfigFile, programName, programDescription, persistTemporaryFiles)
+					uploadedPipeline, err := UploadPipeline(target, sameConfigFile, programName, withSourceDescription(programDescription, gitInfo), persistTemporaryFiles, outputDir, strictPackages, cmd.OutOrStdout())
 					if err != nil {
 						return err
 					}
@@ -213,8 +241,14 @@ ID: %v
 `, uploadedPipeline.Name, uploadedPipeline.ID)
 				} else {
 					pipelineID = pipeline.ID
-					newID, _ := uuid.NewRandom()
-					uploadedPipelineVersion, err := UpdatePipeline(target, sameConfigFile, pipelineID, newID.String(), persistTemporaryFiles)
+					// The upload API takes no description for pipeline versions, so only their name records the
+					// source, leading with the commit they were built from. The branch and remote are recorded on
+					// the run started from the version
+					versionPrefix := ""
+					if gitInfo != nil {
+						versionPrefix = fmt.Sprintf("%v-", gitInfo.ShortSHA())
+					}
+					uploadedPipelineVersion, err := UpdatePipeline(target, sameConfigFile, pipelineID, versionPrefix, persistTemporaryFiles, outputDir, strictPackages, cmd.OutOrStdout())
 					if err != nil {
 						return err
 					}
@@ -236,19 +270,27 @@ VersionID: %v
 				return fmt.Errorf("could not determine program ID for run")
 			}
 
-			experimentID := ""
-			experiment, err := FindExperimentByName(sameConfigFile.Spec.Metadata.Name)
+			namespace, _ := cmd.Flags().GetString("namespace")
+			experiment, err := FindExperimentByName(run.Experiment, namespace)
 			if experiment == nil || err != nil {
-				experimentEntity, err := CreateExperiment(sameConfigFile.Spec.Metadata.Name, experimentDescription)
+				experiment, err = CreateExperiment(run.Experiment, experimentDescription, namespace)
 				if err != nil {
 					return err
 				}
-				experimentID = experimentEntity.ID
-			} else {
-				experimentID = experiment.ID
+			}
+			experimentID := experiment.ID
+
+			// The steps read their secrets from the namespace they run in
+			namespace = runNamespace(experiment, namespace)
+			if err := CreateEnvFileSecrets(sameConfigFile, namespace); err != nil {
+				return err
+			}
+			if err := CreateImagePullSecrets(sameConfigFile, namespace); err != nil {
+				return err
 			}
 
-			runDetails, err := CreateRun(sameConfigFile.Spec.Run.Name, pipelineID, pipelineVersionID, experimentID, runDescription, runParams)
+			// Runs have no labels in the Kubeflow Pipelines API, so the profile and source go in the description
+			runDetails, err := CreateRun(run.Name, pipelineID, pipelineVersionID, experimentID, withSourceDescription(withProfileDescription(runDescription, profile), gitInfo), runParams)
 			if err != nil {
 				return err
 			}
@@ -276,9 +318,20 @@ VersionID: %v
 				return fmt.Errorf("missing environment variables for: %v", strings.Join(missingFields, ", "))
 			}
 
+			// The root file reads the secret looking envfile values from the environment when it submits the pipeline
+			envVarsByEnvironment, err := utils.EnvVarsByEnvironment(*sameConfigFile)
+			if err != nil {
+				return err
+			}
+			for _, envVars := range envVarsByEnvironment {
+				for name, value := range envVars.Secrets {
+					os.Setenv(name, value)
+				}
+			}
+
 			doNotCopyFiles, _ := cmd.Flags().GetBool("do-not-copy-files")
 
-			compileDir, _, err := CompileFile("aml", *sameConfigFile, persistTemporaryFiles, doNotCopyFiles)
+			compileDir, _, err := CompileFile("aml", *sameConfigFile, persistTemporaryFiles, doNotCopyFiles, outputDir, strictPackages, cmd.OutOrStdout())
 			if err != nil {
 				return err
 			}
@@ -311,15 +364,21 @@ func init() {
 	runProgramCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml')")
 
 	runProgramCmd.Flags().String("experiment-description", "", "The description of a SAME Experiment to be created.")
+	runProgramCmd.Flags().String("namespace", "", "The namespace to find or create the experiment in, e.g. your profile's namespace on multi-user Kubeflow. The secrets the steps read are created in the experiment's namespace. Defaults to 'kubeflow'.")
 
 	runProgramCmd.Flags().String("run-description", "", "A description of the SAME program run.")
 	runProgramCmd.Flags().StringSliceP("run-param", "p", nil, "A paramater to pass to the program in key=value form. Repeat for multiple params.")
+	runProgramCmd.Flags().String("profile", "", "The name of a run profile under 'runs' in the SAME file. Parameters passed with --run-param override the profile's.")
 	runProgramCmd.Flags().String("program-description", "", "Brief description of the program")
 	runProgramCmd.Flags().StringP("program-name", "n", "", "The program name")
 	runProgramCmd.Flags().Bool("run-only", false, "Indicates whether to skip program upload")
 	runProgramCmd.Flags().Bool("persist-temporary-files", false, "Persist temporary files in /tmp.")
-	runProgramCmd.Flags().StringP("target", "t", "kubeflow", "Enter one of 'kubeflow', 'aml'. Defaults to: kubeflow")
-	runProgramCmd.Flags().String("capture-current-environment", "", "Update the 'base' environment in the same file with the current package list.")
+	runProgramCmd.Flags().String("output-dir", "", "The directory to compile the program into, instead of a temporary directory. An unchanged notebook and SAME file skip compiling and uploading again.")
+	runProgramCmd.Flags().Bool("strict-packages", false, "Fail instead of warning when the environments with 'append_current_environment' set declare packages that don't match the ones installed locally.")
+	runProgramCmd.Flags().String("git-dirty", "", "What to do when the SAME file's git working tree has uncommitted changes: 'warn', 'fail' or 'ignore'. Defaults to the 'git_dirty' setting, then 'warn'.")
+	runProgramCmd.Flags().StringP("target", "t", "", "Enter one of 'kubeflow', 'aml'. Defaults to workflow.type in the SAME file, then the 'target' setting, then kubeflow.")
+	runProgramCmd.Flags().String("capture-current-environment", "", "The name of an environment (e.g. 'default') to pin to the packages installed locally for this run.")
+	runProgramCmd.Flags().Bool("save-captured-environment", false, "Write the environment captured with --capture-current-environment back to the SAME file.")
 	runProgramCmd.Flags().String("image-pull-secret-server", "", "Image pull server for any private repos (only one username currently supported for all private repos)")
 	runProgramCmd.Flags().String("image-pull-secret-username", "", "Image pull username for any private repos (only one username currently supported for all private repos)")
 	runProgramCmd.Flags().String("image-pull-secret-password", "", "Image pull password for any private repos (only one password currently supported for all private repos)")
@@ -2,12 +2,17 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
+	netUrl "net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/google/uuid"
 	"github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_client/experiment_service"
 	"github.com/kubeflow/pipelines/backend/api/go_http_client/experiment_model"
 	"github.com/kubeflow/pipelines/backend/api/go_http_client/pipeline_client/pipeline_service"
@@ -19,11 +24,16 @@ import (
 	apiclient "github.com/kubeflow/pipelines/backend/src/common/client/api_server"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/azure-octo/same-cli/pkg/utils"
 )
 
-func UploadPipeline(target string, sameConfigFile *loaders.SameConfig, pipelineName string, pipelineDescription string, persistTemporaryFiles bool) (uploadedPipeline *pipeline_upload_model.APIPipeline, err error) {
+// defaultNamespace is the namespace single-user Kubeflow Pipelines runs its pipelines in.
+const defaultNamespace = "kubeflow"
+
+func UploadPipeline(target string, sameConfigFile *loaders.SameConfig, pipelineName string, pipelineDescription string, persistTemporaryFiles bool, outputDir string, strictPackages bool, progress io.Writer) (uploadedPipeline *pipeline_upload_model.APIPipeline, err error) {
 	kfpconfig, err := utils.NewKFPConfig()
 	if err != nil {
 		return nil, err
@@ -39,12 +49,12 @@ func UploadPipeline(target string, sameConfigFile *loaders.SameConfig, pipelineN
 	uploadparams.Name = &pipelineName
 	uploadparams.Description = &pipelineDescription
 
-	if strings.HasSuffix(strings.TrimSpace(sameConfigFile.Spec.Pipeline.Package), ".ipynb") {
-		tempCompileDir, updatedSameConfig, err := CompileFile(target, *sameConfigFile, true, false)
+	if isNotebookPipeline(*sameConfigFile) {
+		tempCompileDir, updatedSameConfig, err := CompileFile(target, *sameConfigFile, true, false, outputDir, strictPackages, progress)
 		if err != nil {
 			return nil, err
 		}
-		if !persistTemporaryFiles {
+		if !persistTemporaryFiles && outputDir == "" {
 			defer os.Remove(tempCompileDir)
 		}
 		sameConfigFile.Spec.ConfigFilePath = updatedSameConfig.Spec.ConfigFilePath
@@ -54,13 +64,13 @@ func UploadPipeline(target string, sameConfigFile *loaders.SameConfig, pipelineN
 	log.Tracef("ConfigFilePath: %v", sameConfigFile.Spec.ConfigFilePath)
 	pipelinePath, _ := filepath.Abs(sameConfigFile.Spec.Pipeline.Package)
 	log.Tracef("PipelinePath: %v", pipelinePath)
-	pipelineFilePath, err := utils.CompileForKFP(pipelinePath)
+	pipelineFilePath, compiledForKFP, err := pipelinePackageFile(pipelinePath)
 	if err != nil {
 		return nil, err
 	}
 
 	uploadedPipeline, err = uploadclient.UploadFile(pipelineFilePath, uploadparams)
-	if !persistTemporaryFiles {
+	if !persistTemporaryFiles && compiledForKFP {
 		defer os.Remove(pipelineFilePath)
 	}
 
@@ -76,7 +86,11 @@ func UploadPipeline(target string, sameConfigFile *loaders.SameConfig, pipelineN
 	return uploadedPipeline, nil
 }
 
-func UpdatePipeline(target string, sameConfigFile *loaders.SameConfig, pipelineID string, pipelineVersion string, persistTemporaryFiles bool) (uploadedPipelineVersion *pipeline_upload_model.APIPipelineVersion, err error) {
+// UpdatePipeline uploads a new version of a pipeline, named versionPrefix followed by the hash of the inputs
+// it was compiled from (or a random ID for pipelines that aren't compiled by SAME). If the pipeline already
+// has a version with that name, it is the same program, so that version is returned instead of uploading it
+// again.
+func UpdatePipeline(target string, sameConfigFile *loaders.SameConfig, pipelineID string, versionPrefix string, persistTemporaryFiles bool, outputDir string, strictPackages bool, progress io.Writer) (uploadedPipelineVersion *pipeline_upload_model.APIPipelineVersion, err error) {
 	kfpconfig, err := utils.NewKFPConfig()
 	if err != nil {
 		return nil, err
@@ -88,32 +102,51 @@ func UpdatePipeline(target string, sameConfigFile *loaders.SameConfig, pipelineI
 		return nil, err
 	}
 
-	uploadparams := pipeline_upload_service.NewUploadPipelineVersionParams()
-	uploadparams.Pipelineid = &pipelineID
-	uploadparams.Name = &pipelineVersion
+	newID, _ := uuid.NewRandom()
+	pipelineVersion := versionPrefix + newID.String()
 
-	if strings.HasSuffix(strings.TrimSpace(sameConfigFile.Spec.Pipeline.Package), ".ipynb") {
-		tempCompileDir, updatedSameConfig, err := CompileFile(target, *sameConfigFile, true, false)
+	if isNotebookPipeline(*sameConfigFile) {
+		tempCompileDir, updatedSameConfig, err := CompileFile(target, *sameConfigFile, true, false, outputDir, strictPackages, progress)
 		if err != nil {
 			return nil, err
 		}
-		if !persistTemporaryFiles {
+		if !persistTemporaryFiles && outputDir == "" {
 			defer os.Remove(tempCompileDir)
 		}
 		sameConfigFile.Spec.ConfigFilePath = updatedSameConfig.Spec.ConfigFilePath
 		sameConfigFile.Spec.Pipeline.Package = updatedSameConfig.Spec.Pipeline.Package
+
+		manifest, err := utils.ReadCompileManifest(tempCompileDir)
+		if err != nil {
+			return nil, err
+		}
+		pipelineVersion = versionPrefix + manifest.InputsHash[:12]
+		existingVersions, err := ListPipelineVersions(pipelineID)
+		if err != nil {
+			return nil, err
+		}
+		for _, existingVersion := range existingVersions {
+			if existingVersion.Name == pipelineVersion {
+				log.Infof("The pipeline version %v was uploaded from the same notebook and SAME file, skipping upload.", pipelineVersion)
+				return &pipeline_upload_model.APIPipelineVersion{ID: existingVersion.ID, Name: existingVersion.Name, CreatedAt: existingVersion.CreatedAt}, nil
+			}
+		}
 	}
 
+	uploadparams := pipeline_upload_service.NewUploadPipelineVersionParams()
+	uploadparams.Pipelineid = &pipelineID
+	uploadparams.Name = &pipelineVersion
+
 	log.Tracef("ConfigFilePath: %v", sameConfigFile.Spec.ConfigFilePath)
 	pipelinePath, _ := filepath.Abs(sameConfigFile.Spec.Pipeline.Package)
 	log.Tracef("PipelinePath: %v", pipelinePath)
-	pipelineFilePath, err := utils.CompileForKFP(pipelinePath)
+	pipelineFilePath, compiledForKFP, err := pipelinePackageFile(pipelinePath)
 	if err != nil {
 		return nil, err
 	}
 
 	uploadedPipelineVersion, err = uploadclient.UploadPipelineVersion(pipelineFilePath, uploadparams)
-	if !persistTemporaryFiles {
+	if !persistTemporaryFiles && compiledForKFP {
 		defer os.Remove(pipelineFilePath)
 	}
 
@@ -130,6 +163,210 @@ func UpdatePipeline(target string, sameConfigFile *loaders.SameConfig, pipelineI
 	return uploadedPipelineVersion, nil
 }
 
+// pipelinePackageFile returns the file to upload for a pipeline package. Argo workflows, like the ones notebooks
+// are compiled to, and KFP packages are uploaded as they are, while pipelines written with the KFP SDK are
+// compiled with it first. compiledForKFP is whether the file was compiled, into a temporary file.
+func pipelinePackageFile(pipelinePath string) (pipelineFilePath string, compiledForKFP bool, err error) {
+	for _, extension := range []string{".yaml", ".yml", ".zip", ".tar.gz"} {
+		if strings.HasSuffix(pipelinePath, extension) {
+			return pipelinePath, false, nil
+		}
+	}
+	pipelineFilePath, err = utils.CompileForKFP(pipelinePath)
+	return pipelineFilePath, true, err
+}
+
+// CreateImagePullSecrets creates (or updates) the secret the compiled Kubeflow steps pull their images with, for
+// environments on private registries that don't name a secret of their own. It goes in the namespace the run is
+// submitted to, where the step pods are created.
+func CreateImagePullSecrets(sameConfigFile *loaders.SameConfig, namespace string) error {
+	for envName, env := range sameConfigFile.Spec.Environments {
+		if !env.PrivateRegistry || env.Credentials.SecretName != "" {
+			continue
+		}
+
+		k8sClient, err := utils.GetKubernetesClient(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("could not create a Kubernetes client to create the image pull secret: %v", err)
+		}
+
+		secretName := utils.ImagePullSecretName(sameConfigFile.Spec.Metadata.Name)
+		log.Tracef("Creating secret '%v' to pull the images of environment '%v'", secretName, envName)
+		if err := k8sClient.ApplyImagePullSecret(namespace, secretName, env.Credentials); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateEnvFileSecrets creates (or updates) the Kubernetes secrets holding the secret looking values from the
+// envfiles of each environment, which the compiled Kubeflow steps reference instead of the values themselves. They go in
+// the namespace the run is submitted to, as the step pods can only read secrets from their own namespace.
+func CreateEnvFileSecrets(sameConfigFile *loaders.SameConfig, namespace string) error {
+	envVarsByEnvironment, err := utils.EnvVarsByEnvironment(*sameConfigFile)
+	if err != nil {
+		return err
+	}
+
+	for envName, envVars := range envVarsByEnvironment {
+		if len(envVars.Secrets) == 0 {
+			continue
+		}
+
+		k8sClient, err := utils.GetKubernetesClient(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("could not create a Kubernetes client to create the env file secrets: %v", err)
+		}
+
+		secretName := utils.EnvSecretName(sameConfigFile.Spec.Metadata.Name, envName)
+		log.Tracef("Creating secret '%v' for the secret values in the envfiles of environment '%v'", secretName, envName)
+		if err := k8sClient.ApplySecret(namespace, secretName, envVars.Secrets); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordGitSource fills in metadata.sha when the SAME file is in a git repository, and returns where the
+// source came from (nil if it isn't in one). How a dirty working tree is handled comes from --git-dirty, then
+// the 'git_dirty' setting, and is a warning otherwise.
+func RecordGitSource(cmd *cobra.Command, sameConfigFilePath string, sameConfigFile *loaders.SameConfig) (*utils.GitInfo, error) {
+	policy, _ := cmd.Flags().GetString("git-dirty")
+	if policy == "" {
+		policy = viper.GetString("git_dirty")
+	}
+	if policy == "" {
+		policy = utils.GitDirtyWarn
+	}
+
+	gitInfo, err := utils.GetGitInfo(filepath.Dir(localConfigFilePath(sameConfigFilePath)))
+	if err != nil || gitInfo == nil {
+		return nil, err
+	}
+
+	if err := utils.ApplyGitInfo(sameConfigFile, *gitInfo, policy); err != nil {
+		return nil, err
+	}
+	log.Tracef("Recording source: %v", gitInfo.Describe())
+	return gitInfo, nil
+}
+
+// CaptureCurrentEnvironment pins an environment of the SAME file to the packages installed locally, see
+// utils.CapturePackages. With save set, the environment is written back to the SAME file as well.
+func CaptureCurrentEnvironment(cmd *cobra.Command, sameConfigFilePath string, sameConfigFile *loaders.SameConfig, environmentName string, save bool) error {
+	env, exists := sameConfigFile.Spec.Environments[environmentName]
+	if !exists && environmentName != "default" {
+		return fmt.Errorf("could not capture the current environment into '%v', which is not an environment in the SAME file", environmentName)
+	}
+
+	currentPackages, err := utils.CurrentPackages()
+	if err != nil {
+		return err
+	}
+	env.Packages = utils.CapturePackages(env.Packages, currentPackages)
+	if sameConfigFile.Spec.Environments == nil {
+		sameConfigFile.Spec.Environments = make(map[string]loaders.Environment)
+	}
+	sameConfigFile.Spec.Environments[environmentName] = env
+	cmd.Printf("Captured %v packages from the current environment into the '%v' environment.\n", len(env.Packages), environmentName)
+
+	if !save {
+		return nil
+	}
+	configFilePath := localConfigFilePath(sameConfigFilePath)
+	configFileBytes, err := ioutil.ReadFile(configFilePath)
+	if err != nil {
+		return fmt.Errorf("could not read from config file %s: %v", configFilePath, err)
+	}
+	updatedBytes, err := loaders.SetEnvironmentPackagesBytes(configFileBytes, environmentName, env.Packages)
+	if err != nil {
+		return err
+	}
+	fileInfo, err := os.Stat(configFilePath)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(configFilePath, updatedBytes, fileInfo.Mode()); err != nil {
+		return fmt.Errorf("could not write the captured environment to %v: %v", configFilePath, err)
+	}
+	cmd.Printf("Saved the '%v' environment to %v.\n", environmentName, configFilePath)
+	return nil
+}
+
+// isNotebookPipeline reports whether pipeline.package is a notebook, or a script in one of the notebook
+// formats, that has to be compiled before it is uploaded. Other .py files are KFP pipelines, which go
+// straight to dsl-compile.
+func isNotebookPipeline(sameConfigFile loaders.SameConfig) bool {
+	pipelinePackage := strings.TrimSpace(sameConfigFile.Spec.Pipeline.Package)
+	if !filepath.IsAbs(pipelinePackage) {
+		pipelinePackage = filepath.Join(filepath.Dir(sameConfigFile.Spec.ConfigFilePath), pipelinePackage)
+	}
+	return utils.IsNotebookSource(pipelinePackage)
+}
+
+// localConfigFilePath turns the path returned by GetConfigFilePath (a file:// URL for local files, or a path
+// into the cache for remote ones) into a plain local path.
+func localConfigFilePath(sameConfigFilePath string) string {
+	if parsed, err := netUrl.Parse(sameConfigFilePath); err == nil && parsed.Path != "" {
+		return parsed.Path
+	}
+	return sameConfigFilePath
+}
+
+// runNamespace returns the namespace the runs of an experiment are submitted to, and so the one their step
+// pods run in: the namespace that owns the experiment (on multi-user Kubeflow), then --namespace, then
+// 'kubeflow'.
+func runNamespace(experiment *experiment_model.APIExperiment, namespace string) string {
+	if experiment != nil {
+		for _, reference := range experiment.ResourceReferences {
+			if reference.Key != nil && reference.Key.Type == experiment_model.APIResourceTypeNAMESPACE && reference.Key.ID != "" {
+				return reference.Key.ID
+			}
+		}
+	}
+	if namespace != "" {
+		return namespace
+	}
+	return defaultNamespace
+}
+
+// resolveTarget picks the backend for a command: --target, then workflow.type in the SAME file, then the
+// 'target' setting in the SAME config (~/.same/config.yaml), then Kubeflow.
+func resolveTarget(cmd *cobra.Command, sameConfigFile *loaders.SameConfig) (string, error) {
+	flagTarget, _ := cmd.Flags().GetString("target")
+	return loaders.ResolveTarget(flagTarget, sameConfigFile.Spec.Workflow.Type, viper.GetString("target"))
+}
+
+// profileDescriptionPrefix starts the line of a run's description naming the profile it was started with, see
+// withProfileDescription.
+const profileDescriptionPrefix = "Profile: "
+
+// withProfileDescription appends the run profile a run was started with to its description, so 'same run list
+// --profile' can find the runs of a profile.
+func withProfileDescription(description string, profile string) string {
+	if profile == "" {
+		return description
+	}
+	line := profileDescriptionPrefix + profile
+	if description == "" {
+		return line
+	}
+	return fmt.Sprintf("%v\n\n%v", description, line)
+}
+
+// withSourceDescription appends the git source to a description shown in Kubeflow, so the pipeline or run can
+// be traced back to the code it came from.
+func withSourceDescription(description string, gitInfo *utils.GitInfo) string {
+	if gitInfo == nil {
+		return description
+	}
+	source := fmt.Sprintf("Source: %v", gitInfo.Describe())
+	if description == "" {
+		return source
+	}
+	return fmt.Sprintf("%v\n\n%v", description, source)
+}
+
 func FindPipelineByName(pipelineName string) (uploadedPipeline *pipeline_model.APIPipeline, err error) {
 	listOfPipelines, err := ListPipelines()
 	if err != nil {
@@ -212,7 +449,9 @@ func ListPipelineVersions(pipelineID string) ([]*pipeline_model.APIPipelineVersi
 	return listOfPipelineVersions, vErr
 }
 
-func FindExperimentByName(experimentName string) (experiment *experiment_model.APIExperiment, err error) {
+// FindExperimentByName finds an experiment by its name, in the given namespace if there is one (as multi-user
+// Kubeflow Pipelines only lists the experiments of a namespace).
+func FindExperimentByName(experimentName string, namespace string) (experiment *experiment_model.APIExperiment, err error) {
 	kfpconfig, err := utils.NewKFPConfig()
 	if err != nil {
 		return nil, err
@@ -220,6 +459,10 @@ func FindExperimentByName(experimentName string) (experiment *experiment_model.A
 	eClient, _ := apiclient.NewExperimentClient(kfpconfig, false)
 	apiExperimentType := experiment_model.APIResourceTypeEXPERIMENT
 	experimentClientParams := experiment_service.NewListExperimentParams().WithResourceReferenceKeyType((*string)(&apiExperimentType))
+	if namespace != "" {
+		apiNamespaceType := experiment_model.APIResourceTypeNAMESPACE
+		experimentClientParams = experimentClientParams.WithResourceReferenceKeyType((*string)(&apiNamespaceType)).WithResourceReferenceKeyID(&namespace)
+	}
 	listOfExperiments, _, _, err := eClient.List(experimentClientParams)
 	if err != nil {
 		return nil, err
@@ -232,7 +475,8 @@ func FindExperimentByName(experimentName string) (experiment *experiment_model.A
 	return nil, fmt.Errorf("could not find an experiment with the name: %v", experimentName)
 }
 
-func CreateExperiment(experimentName string, experimentDescription string) (*experiment_model.APIExperiment, error) {
+// CreateExperiment creates an experiment, owned by the given namespace if there is one.
+func CreateExperiment(experimentName string, experimentDescription string, namespace string) (*experiment_model.APIExperiment, error) {
 	kfpconfig, err := utils.NewKFPConfig()
 	if err != nil {
 		return nil, err
@@ -246,6 +490,12 @@ func CreateExperiment(experimentName string, experimentDescription string) (*exp
 		Name:        experimentName,
 		Description: experimentDescription,
 	}
+	if namespace != "" {
+		expBody.ResourceReferences = []*experiment_model.APIResourceReference{{
+			Key:          &experiment_model.APIResourceKey{Type: experiment_model.APIResourceTypeNAMESPACE, ID: namespace},
+			Relationship: experiment_model.APIRelationshipOWNER,
+		}}
+	}
 	createExperimentParams.Body = &expBody
 	createdExperiment, err := experimentclient.Create(createExperimentParams)
 
@@ -265,17 +515,8 @@ func CreateRun(runName string, pipelineID string, pipelineVersionID string, expe
 	runParams := make([]*run_model.APIParameter, 0)
 
 	for name, untyped_value := range runParameters {
-
-		// Probably don't need to do this - should just convert to JSON. Should investigate.
-		value_string := ""
-		switch untyped_value.(type) {
-		case int, int8, uint8, int16, uint16, int32, uint32, int64, uint64, uint, uintptr, float32, float64, bool, string:
-			value_string = fmt.Sprintf("%v", untyped_value)
-		default:
-			log.Warnf("We only support numeric, bool and strings as default parameters (no dicts or lists). Skipping '%v'.", name)
-			continue
-		}
-		runParams = append(runParams, &run_model.APIParameter{Name: name, Value: value_string})
+		// Lists and dicts are passed as JSON, and decoded again by the steps
+		runParams = append(runParams, &run_model.APIParameter{Name: name, Value: loaders.EncodeParameterValue(untyped_value)})
 	}
 
 	runclient, err := apiclient.NewRunClient(kfpconfig, false)
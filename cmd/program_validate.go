@@ -0,0 +1,116 @@
+package cmd
+
+/*
+Copyright © 2021 The SAME Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var validateProgramCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validates a SAME program file",
+	Long:  `Validates a SAME program file against the schema for its apiVersion, reporting unknown keys, wrong types, missing required fields and run parameters that don't match their declarations.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		sameConfigFilePath, err := resolveAndValidateSAME(cmd, args, filePath)
+		if err != nil {
+			return err
+		}
+
+		params, err := runParamsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		sameConfigFile, err := loaders.V1{Params: params}.LoadSAME(sameConfigFilePath)
+		if err != nil {
+			return fmt.Errorf("could not load SAME config file: %v", err)
+		}
+		if err := sameConfigFile.Spec.ValidateParameters(); err != nil {
+			return fmt.Errorf("SAME file '%v' is not valid:\n%v", filePath, err)
+		}
+
+		cmd.Printf("%v is a valid SAME program file.\n", sameConfigFilePath)
+		return nil
+	},
+}
+
+// resolveAndValidateSAME resolves the SAME file path (downloading it if it is remote) and validates it,
+// returning the local path to the file.
+func resolveAndValidateSAME(cmd *cobra.Command, args []string, filePath string) (string, error) {
+	sameConfigFilePath, err := utils.GetUtils(cmd, args).GetConfigFilePath(filePath)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve SAME config file path: %v", err)
+	}
+
+	params, err := runParamsFromFlags(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	if err := loaders.ValidateSAME(sameConfigFilePath, params, runProfileFromFlags(cmd)); err != nil {
+		return "", fmt.Errorf("SAME file '%v' is not valid:\n%v", filePath, err)
+	}
+
+	return sameConfigFilePath, nil
+}
+
+// runParamsFromFlags returns the key=value pairs passed with --run-param, for commands that have the flag.
+func runParamsFromFlags(cmd *cobra.Command) (map[string]string, error) {
+	params := make(map[string]string)
+	if cmd.Flags().Lookup("run-param") == nil {
+		return params, nil
+	}
+
+	rawParams, err := cmd.Flags().GetStringSlice("run-param")
+	if err != nil {
+		return nil, err
+	}
+	for _, param := range rawParams {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid param format %q. Expect: key=value", param)
+		}
+		params[parts[0]] = parts[1]
+	}
+	return params, nil
+}
+
+// runProfileFromFlags returns the run profile passed with --profile, for commands that have the flag.
+func runProfileFromFlags(cmd *cobra.Command) string {
+	if cmd.Flags().Lookup("profile") == nil {
+		return ""
+	}
+	profile, _ := cmd.Flags().GetString("profile")
+	return profile
+}
+
+func init() {
+	programCmd.AddCommand(validateProgramCmd)
+
+	validateProgramCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml').")
+	validateProgramCmd.Flags().StringSliceP("run-param", "p", nil, "A parameter to interpolate into the SAME program file in key=value form. Repeat for multiple params.")
+}
@@ -18,8 +18,10 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
@@ -79,15 +81,37 @@ var listRunCmd = &cobra.Command{
 			}
 			allRuns = append(allRuns, runs...)
 		}
+
+		if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+			if _, err := sameConfigFile.Spec.RunProfile(profile); err != nil {
+				return err
+			}
+			allRuns = filterRunsByProfile(allRuns, profile)
+		}
 		prettyPrintRunList(allRuns, pipelineVersionLookupMap)
 		return nil
 	},
 }
 
+// filterRunsByProfile keeps the runs started with a run profile, which is recorded in their description, see
+// withProfileDescription.
+func filterRunsByProfile(runs []*run_model.APIRun, profile string) []*run_model.APIRun {
+	filtered := make([]*run_model.APIRun, 0, len(runs))
+	for _, run := range runs {
+		for _, line := range strings.Split(run.Description, "\n") {
+			if line == profileDescriptionPrefix+profile {
+				filtered = append(filtered, run)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 // NewTabWriter returns a *tabwriter.Writer with some visually
 // pleasing settings.
-func NewTabWriter() *tabwriter.Writer {
-	return tabwriter.NewWriter(os.Stdout,
+func NewTabWriter(out io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(out,
 		0,   // minWidth
 		0,   // tabWidth
 		3,   // padding
@@ -98,7 +122,7 @@ func NewTabWriter() *tabwriter.Writer {
 
 func prettyPrintRunList(runs []*run_model.APIRun, pipelineVersionLookupMap map[string]string) {
 	metricNames := getMetricsNames(runs)
-	w := NewTabWriter()
+	w := NewTabWriter(os.Stdout)
 	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", "ID", "NAME", "PIPELINEVERSION", "CREATED", "STATUS")
 	for _, metricName := range metricNames {
 		fmt.Fprintf(w, "\t%s", metricName)
@@ -160,5 +184,6 @@ func getMetric(run *run_model.APIRun, metricName string) (value float64, exist b
 func init() {
 	listRunCmd.Flags().StringP("program-name", "n", "", "The SAME Program name")
 	listRunCmd.Flags().StringP("file", "f", "same.yaml", "a SAME program file (defaults to 'same.yaml')")
+	listRunCmd.Flags().String("profile", "", "Only list the runs started with this run profile from the SAME file, which is recorded in their description")
 	runCmd.AddCommand(listRunCmd)
 }
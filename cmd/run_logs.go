@@ -0,0 +1,127 @@
+/*
+Copyright © 2021 The SAME author.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/azure-octo/same-cli/pkg/infra"
+	"github.com/azure-octo/same-cli/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var logsRunCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Prints the logs of the steps of a SAME program run",
+	Long: `Prints the logs of the steps of a SAME program run. Python tracebacks in the logs point at the notebook
+cell and line the failing code came from, rather than at the code SAME generated.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := infra.GetDependencyCheckers(cmd, args).CheckDependenciesInstalled(); err != nil {
+			return fmt.Errorf("Failed during dependency checks: %v", err)
+		}
+
+		runId, err := cmd.Flags().GetString("run-id")
+		if err != nil {
+			return err
+		}
+		stepName, err := cmd.Flags().GetString("step")
+		if err != nil {
+			return err
+		}
+
+		_, wf, err := GetRun(runId)
+		if err != nil {
+			return err
+		}
+
+		k8sClient, err := utils.GetKubernetesClient(30 * time.Second)
+		if err != nil {
+			return fmt.Errorf("could not create a Kubernetes client to read the logs of the run: %v", err)
+		}
+		namespace := wf.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		sourceMaps := workflowSourceMaps(wf)
+		nodes := stepPodNodes(wf, stepName)
+		if len(nodes) == 0 && stepName != "" {
+			return fmt.Errorf("run %v has no step named '%v'", runId, stepName)
+		}
+		for _, node := range nodes {
+			cmd.Printf("==> %v (%v) <==\n", node.DisplayName, node.Phase)
+			// Argo names the pod of a step after its node
+			logs, err := k8sClient.PodLogs(namespace, node.ID, "main")
+			if err != nil {
+				cmd.Printf("%v\n\n", err)
+				continue
+			}
+			cmd.Println(utils.RewriteTracebacks(logs, sourceMaps))
+		}
+		return nil
+	},
+}
+
+// workflowSourceMaps returns the source maps carried by the steps of a run, which are in the programs its
+// templates run.
+func workflowSourceMaps(wf *v1alpha1.Workflow) []utils.StepSourceMap {
+	sourceMaps := make([]utils.StepSourceMap, 0)
+	for _, template := range wf.Spec.Templates {
+		programs := make([]string, 0)
+		if template.Container != nil {
+			programs = append(programs, template.Container.Command...)
+			programs = append(programs, template.Container.Args...)
+		}
+		if template.Script != nil {
+			programs = append(programs, template.Script.Source)
+		}
+		for _, program := range programs {
+			sourceMaps = append(sourceMaps, utils.FindSourceMaps(program)...)
+		}
+	}
+	return sourceMaps
+}
+
+// stepPodNodes returns the nodes of a run that ran in a pod, in the order they started. If stepName is set,
+// only the nodes of that step, by the name it is shown with, are returned.
+func stepPodNodes(wf *v1alpha1.Workflow, stepName string) []v1alpha1.NodeStatus {
+	nodes := make([]v1alpha1.NodeStatus, 0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != v1alpha1.NodeTypePod {
+			continue
+		}
+		if stepName != "" && node.DisplayName != stepName {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+	return nodes
+}
+
+func init() {
+	logsRunCmd.Flags().StringP("run-id", "r", "", "The SAME run ID")
+	logsRunCmd.Flags().String("step", "", "Only print the logs of the step with this name")
+	_ = logsRunCmd.MarkFlagRequired("run-id")
+	runCmd.AddCommand(logsRunCmd)
+}
@@ -0,0 +1,163 @@
+// Package v1alpha2 holds the types of the projectsame.io/v1alpha2 SAME file format. It has the same
+// fields as v1alpha1, with every key spelled in snake_case.
+package v1alpha2
+
+// APIVersion is the apiVersion of SAME files in this format.
+const APIVersion = "projectsame.io/v1alpha2"
+
+// SameSpec is the spec of a SAME project
+type SameSpec struct {
+	APIVersion            string                 `yaml:"apiVersion,omitempty"`
+	Version               string                 `yaml:"version,omitempty"`
+	Bases                 []string               `yaml:"bases,omitempty"`
+	Metadata              Metadata               `yaml:"metadata,omitempty"`
+	EnvFiles              []string               `yaml:"env_files,omitempty"`
+	Resources             Resource               `yaml:"resources,omitempty"`
+	Workflow              Workflow               `yaml:"workflow,omitempty"`
+	Pipeline              Pipeline               `yaml:"pipeline,omitempty"`
+	Environments          map[string]Environment `yaml:"environments,omitempty"`
+	DataSets              []DataSet              `yaml:"datasets,omitempty"`
+	Parameters            map[string]Parameter   `yaml:"parameters,omitempty"`
+	ImportMap             map[string]string      `yaml:"import_map,omitempty"`
+	ContextStorage        ContextStorage         `yaml:"context_storage,omitempty"`
+	Run                   Run                    `yaml:"run,omitempty"`
+	Runs                  map[string]Run         `yaml:"runs,omitempty"`
+	DebuggingFeatureFlags map[string]bool        `yaml:"debugging_feature_flags,omitempty"`
+	ConfigFilePath        string                 `yaml:"config_file_path,omitempty"`
+	KubeConfig            string                 `yaml:"kube_config,omitempty"`
+}
+
+// Metadata is summary data about the SAME program.
+type Metadata struct {
+	Name    string            `yaml:"name,omitempty"`
+	SHA     string            `yaml:"sha,omitempty"`
+	Labels  map[string]string `yaml:"labels,omitempty"`
+	Version string            `yaml:"version,omitempty"`
+}
+
+// Resource (may be poorly named) describes the agent pool to be provisioned in the Kubernetes cluster
+type Resource struct {
+	Provider          string `yaml:"provider,omitempty"`
+	ClusterProfile    string `yaml:"cluster_profile,omitempty"`
+	NodePoolName      string `yaml:"node_pool_name,omitempty"`
+	CreateNewNodePool bool   `yaml:"create_new_node_pool,omitempty"`
+	Cores             Cores  `yaml:"cores,omitempty"`
+	GPU               GPU    `yaml:"gpus,omitempty"`
+	Disks             []Disk `yaml:"disks,omitempty"`
+}
+
+// Cores lists the requested, required cores for the entire cluster, and the minimum amount per machine.
+type Cores struct {
+	Requested         int `yaml:"requested,omitempty"`
+	Required          int `yaml:"required,omitempty"`
+	MinimumPerMachine int `yaml:"minimum_per_machine,omitempty"`
+}
+
+// GPU names the specific GPU required for the machine (by string) and the number per machine
+type GPU struct {
+	Type       string `yaml:"type,omitempty"`
+	PerMachine int    `yaml:"per_machine,omitempty"`
+}
+
+// Disk is the name, size and volume mount of disks to provision for the cluster. Assumed that all volume mounts will be made available for every pod.
+type Disk struct {
+	Name        string      `yaml:"name,omitempty"`
+	Size        string      `yaml:"size,omitempty"`
+	VolumeMount VolumeMount `yaml:"volume_mount,omitempty"`
+}
+
+// VolumeMount is the specific volume handle for the mounted disk, and a name.
+type VolumeMount struct {
+	MountPath string `yaml:"mount_path,omitempty"`
+	Name      string `yaml:"name,omitempty"`
+}
+
+// Workflow is the workflow executor for SAME
+type Workflow struct {
+	Type       string   `yaml:"type,omitempty"`
+	Parameters Kubeflow `yaml:"parameters,omitempty"`
+}
+
+// Kubeflow specifies the version of the Kubeflow cluster and all associated services to provision. It also names the namespace to deploy to.
+type Kubeflow struct {
+	KubernetesAPIServerURI string   `yaml:"kubernetes_api_server_uri,omitempty"`
+	KubeflowVersion        string   `yaml:"kubeflow_version,omitempty"`
+	KubeflowNamespace      string   `yaml:"kubeflow_namespace,omitempty"`
+	Services               []string `yaml:"services,omitempty"`
+	CredentialFile         string   `yaml:"credential_file,omitempty"`
+}
+
+// Pipeline names the specific (pre-compiled) pipeline package to upload (or verify already exists) on Kubeflow.
+type Pipeline struct {
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Package     string `yaml:"package,omitempty"`
+}
+
+// DataSet is the data to be downloaded/mounted into the cluster.
+type DataSet struct {
+	Name          string `yaml:"name,omitempty"`
+	Type          string `yaml:"type,omitempty"`
+	URL           string `yaml:"url,omitempty"`
+	MakeLocalCopy bool   `yaml:"make_local_copy,omitempty"`
+}
+
+// Run is the name and specific parameters to run against one of the previously created pipelines.
+// RunWrapper comes from here: https://medium.com/@nate510/dynamic-json-umarshalling-in-go-88095561d6a0
+// Named profiles in 'runs' are layered over 'run' and picked with 'same program run --profile'.
+type Run struct {
+	Name        string                 `yaml:"name,omitempty"`
+	Description string                 `yaml:"description,omitempty"`
+	Experiment  string                 `yaml:"experiment,omitempty"`
+	Parameters  map[string]interface{} `yaml:"parameters,omitempty"`
+}
+
+type Environment struct {
+	ImageTag                 string                `yaml:"image_tag,omitempty"`
+	AppendCurrentEnvironment bool                  `yaml:"append_current_environment,omitempty"`
+	Packages                 []string              `yaml:"packages,omitempty"`
+	PrivateRegistry          bool                  `yaml:"private_registry,omitempty"`
+	Credentials              RepositoryCredentials `yaml:"repository_credentials,omitempty"`
+	EnvFiles                 []string              `yaml:"env_files,omitempty"`
+	Resources                StepResources         `yaml:"resources,omitempty"`
+}
+
+// StepResources are the resources a step runs with. They are set per step with cell tags (e.g. 'cpu=2',
+// 'memory=8Gi', 'gpu=1', 'gpu_type=nvidia-tesla-k80'), and default to the resources of the step's environment.
+type StepResources struct {
+	CPU     string `yaml:"cpu,omitempty"`
+	Memory  string `yaml:"memory,omitempty"`
+	GPU     string `yaml:"gpu,omitempty"`
+	GPUType string `yaml:"gpu_type,omitempty"`
+}
+
+type RepositoryCredentials struct {
+	SecretName string `yaml:"secret_name,omitempty"`
+	Server     string `yaml:"server,omitempty"`
+	Username   string `yaml:"username,omitempty"`
+	Password   string `yaml:"password,omitempty"`
+	Email      string `yaml:"email,omitempty"`
+}
+
+// Parameter declares a run parameter: its type (string, int, float, bool, list or dict), the value used when
+// neither 'run' nor --run-param sets it, and the values it may take.
+type Parameter struct {
+	Type        string        `yaml:"type,omitempty"`
+	Default     interface{}   `yaml:"default,omitempty"`
+	Description string        `yaml:"description,omitempty"`
+	Enum        []interface{} `yaml:"enum,omitempty"`
+	Min         *float64      `yaml:"min,omitempty"`
+	Max         *float64      `yaml:"max,omitempty"`
+}
+
+// ContextStorage is where steps write the variables they pass on once their context is too large to pass
+// inline. Spilling is off unless 'spill_threshold' (a size, e.g. '4Mi') is set; the variables go under
+// 'prefix' (an 's3://bucket/path' URI) on the S3 compatible 'endpoint', with the credentials in the
+// Kubernetes secret 'credentials_secret'. All but the threshold default to the artifact store of Kubeflow
+// Pipelines.
+type ContextStorage struct {
+	SpillThreshold    string `yaml:"spill_threshold,omitempty"`
+	Prefix            string `yaml:"prefix,omitempty"`
+	Endpoint          string `yaml:"endpoint,omitempty"`
+	CredentialsSecret string `yaml:"credentials_secret,omitempty"`
+}
@@ -0,0 +1,295 @@
+package loaders
+
+import (
+	"fmt"
+	"io/ioutil"
+	netUrl "net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	gogetter "github.com/hashicorp/go-getter"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// sameLayer is a single SAME file taking part in a composition, either the file itself or one of its bases.
+type sameLayer struct {
+	Location string
+	Document *yamlv3.Node
+	Spec     map[string]interface{}
+}
+
+// ComposeSAME reads the SAME file at configFilePath, layers it over each of its bases (in order, later
+// layers winning) and returns the merged document. Maps are merged key by key, while scalars and lists
+// in later layers replace earlier ones. Relative paths inside bases (e.g. pipeline.package) are resolved
+// against the top level file, as they are today. References in each layer are interpolated (see
+// InterpolateSAME) before it is parsed, with ${param:...} references resolving to the parameters a run of
+// the profile starts with, params (from --run-param) included.
+func ComposeSAME(configFilePath string, params map[string]string, profile string) (map[string]interface{}, error) {
+	layers, err := loadInterpolatedLayers(configFilePath, params, profile)
+	if err != nil {
+		return nil, err
+	}
+	return mergeLayers(layers)
+}
+
+// loadInterpolatedLayers loads the layers of the SAME file at configFilePath with every reference resolved.
+// The parameters ${param:...} references resolve to are declared in the layers themselves, so they are read
+// with those references left alone first, and the layers loaded again if there were any.
+func loadInterpolatedLayers(configFilePath string, params map[string]string, profile string) ([]sameLayer, error) {
+	location := canonicalLocation(configFilePath)
+	layers, deferred, err := loadLayers(location, nil, true, nil)
+	if err != nil || !deferred {
+		return layers, err
+	}
+
+	composed, err := mergeLayers(layers)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := parameterSpec(composed)
+	if err != nil {
+		return nil, err
+	}
+	values, err := spec.RunParameterValues(profile, params)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, _, err = loadLayers(location, values, false, nil)
+	return layers, err
+}
+
+// mergeLayers merges the layers of a SAME file, deepest base first, into one document.
+func mergeLayers(layers []sameLayer) (map[string]interface{}, error) {
+	// Keys are spelled differently between versions of the format, so the layers can only be merged if
+	// they agree on the apiVersion
+	apiVersion, apiVersionLocation := "", ""
+	for _, layer := range layers {
+		layerAPIVersion, _ := layer.Spec["apiVersion"].(string)
+		if layerAPIVersion == "" {
+			continue
+		}
+		if apiVersion != "" && layerAPIVersion != apiVersion {
+			return nil, fmt.Errorf("'%v' (%v) and '%v' (%v) use different apiVersions, use 'same program migrate' to bring them to the same version", apiVersionLocation, apiVersion, layer.Location, layerAPIVersion)
+		}
+		apiVersion, apiVersionLocation = layerAPIVersion, layer.Location
+	}
+
+	composed := make(map[string]interface{})
+	for _, layer := range layers {
+		spec := make(map[string]interface{}, len(layer.Spec))
+		for k, v := range layer.Spec {
+			if k != "bases" {
+				spec[k] = v
+			}
+		}
+		composed = mergeMaps(composed, spec)
+	}
+
+	return composed, nil
+}
+
+// parameterSpec reads the parameter declarations and runs of a composed SAME file, which are spelled the same
+// in every version of the format.
+func parameterSpec(composed map[string]interface{}) (SameSpec, error) {
+	spec := SameSpec{}
+	specBytes, err := yaml.Marshal(map[string]interface{}{"parameters": composed["parameters"], "run": composed["run"], "runs": composed["runs"]})
+	if err == nil {
+		err = yaml.Unmarshal(specBytes, &spec)
+	}
+	if err != nil {
+		return SameSpec{}, fmt.Errorf("could not read the parameters to interpolate ${param:...} references with: %v", err)
+	}
+	return spec, nil
+}
+
+// loadLayers returns the layers that make up the SAME file at location, deepest base first and the file
+// itself last, and whether any ${param:...} references were left alone (see interpolateSAME). stack holds
+// the locations currently being resolved and is used to detect cycles.
+func loadLayers(location string, params map[string]string, deferParams bool, stack []string) ([]sameLayer, bool, error) {
+	location = canonicalLocation(location)
+	for _, seen := range stack {
+		if seen == location {
+			return nil, false, fmt.Errorf("cycle detected in SAME file bases: %v -> %v", strings.Join(stack, " -> "), location)
+		}
+	}
+
+	fileBytes, err := readLayer(location)
+	if err != nil {
+		return nil, false, err
+	}
+
+	gitDir := ""
+	if !isRemoteLocation(location) {
+		gitDir = filepath.Dir(location)
+	}
+	document, fileBytes, deferred, err := interpolateSAME(location, fileBytes, params, gitDir, deferParams)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var obj map[interface{}]interface{}
+	if err = yaml.Unmarshal(fileBytes, &obj); err != nil {
+		return nil, false, fmt.Errorf("unable to unmarshal the yaml file '%v' - invalid config file format: %v", location, err)
+	}
+	spec, _ := normalizeYAML(obj).(map[string]interface{})
+	if spec == nil {
+		spec = make(map[string]interface{})
+	}
+
+	layers := make([]sameLayer, 0)
+	if rawBases, ok := spec["bases"]; ok && rawBases != nil {
+		bases, ok := rawBases.([]interface{})
+		if !ok {
+			return nil, false, fmt.Errorf("'bases' in %v must be a list of paths or URLs", location)
+		}
+		for _, rawBase := range bases {
+			base, ok := rawBase.(string)
+			if !ok || base == "" {
+				return nil, false, fmt.Errorf("'bases' in %v must be a list of paths or URLs, found: %v", location, rawBase)
+			}
+			baseLocation, err := resolveBaseLocation(location, base)
+			if err != nil {
+				return nil, false, err
+			}
+			log.Tracef("Resolving base '%v' of '%v' to '%v'", base, location, baseLocation)
+			baseLayers, baseDeferred, err := loadLayers(baseLocation, params, deferParams, append(stack, location))
+			if err != nil {
+				return nil, false, err
+			}
+			layers = append(layers, baseLayers...)
+			deferred = deferred || baseDeferred
+		}
+	}
+
+	return append(layers, sameLayer{Location: location, Document: document, Spec: spec}), deferred, nil
+}
+
+// readLayer reads a SAME file from a local path or downloads it through go-getter.
+func readLayer(location string) ([]byte, error) {
+	if isRemoteLocation(location) {
+		tempDir, err := ioutil.TempDir("", "SAME-base-*")
+		if err != nil {
+			return nil, fmt.Errorf("could not create a temporary directory to download the base to: %v", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		tempFile := filepath.Join(tempDir, "same.yaml")
+		log.Infof("Downloading base from %v", location)
+		if err := gogetter.GetFile(tempFile, location); err != nil {
+			return nil, fmt.Errorf("could not download SAME base from '%v': %v", location, err)
+		}
+		return ioutil.ReadFile(tempFile)
+	}
+
+	fileBytes, err := ioutil.ReadFile(location)
+	if err != nil {
+		return nil, fmt.Errorf("could not read from config file %s: %v", location, err)
+	}
+	return fileBytes, nil
+}
+
+// resolveBaseLocation resolves a base relative to the layer that references it.
+func resolveBaseLocation(parent string, base string) (string, error) {
+	if isRemoteLocation(base) || filepath.IsAbs(base) {
+		return base, nil
+	}
+
+	if isRemoteLocation(parent) {
+		forcedGetter := ""
+		parentURL := parent
+		if i := strings.Index(parent, "::"); i >= 0 {
+			forcedGetter, parentURL = parent[:i+2], parent[i+2:]
+		}
+		parsed, err := netUrl.Parse(parentURL)
+		if err != nil {
+			return "", fmt.Errorf("could not parse base url '%v': %v", parent, err)
+		}
+		parsed.Path = path.Join(path.Dir(parsed.Path), base)
+		return forcedGetter + parsed.String(), nil
+	}
+
+	return canonicalLocation(filepath.Join(filepath.Dir(parent), base)), nil
+}
+
+// canonicalLocation turns local paths into absolute paths to a file, so that the same layer is always
+// known by the same name when looking for cycles. Local directories are treated as containing a
+// 'same.yaml', the same way kustomize treats bases.
+func canonicalLocation(location string) string {
+	if isRemoteLocation(location) {
+		return location
+	}
+
+	localPath := location
+	if resolved, err := netUrl.Parse(location); err == nil && resolved.Path != "" {
+		localPath = resolved.Path
+	}
+	if absPath, err := filepath.Abs(localPath); err == nil {
+		localPath = absPath
+	}
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, "same.yaml")
+	}
+	return localPath
+}
+
+func isRemoteLocation(location string) bool {
+	if strings.Contains(location, "::") {
+		return true
+	}
+	parsed, err := netUrl.Parse(location)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme != "" && parsed.Scheme != "file" && len(parsed.Scheme) > 1
+}
+
+// mergeMaps deep merges overlay into base, returning a new map. Nested maps are merged, anything else
+// in the overlay replaces the value in the base.
+func mergeMaps(base map[string]interface{}, overlay map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overlay))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, overlayValue := range overlay {
+		baseMap, baseIsMap := merged[k].(map[string]interface{})
+		overlayMap, overlayIsMap := overlayValue.(map[string]interface{})
+		if baseIsMap && overlayIsMap {
+			merged[k] = mergeMaps(baseMap, overlayMap)
+		} else {
+			merged[k] = overlayValue
+		}
+	}
+	return merged
+}
+
+// normalizeYAML converts the map[interface{}]interface{} values produced by yaml.v2 into
+// map[string]interface{} so they can be merged and marshalled to JSON.
+func normalizeYAML(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		normalized := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			normalized[fmt.Sprintf("%v", k)] = normalizeYAML(v)
+		}
+		return normalized
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			normalized[k] = normalizeYAML(v)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(typed))
+		for i, v := range typed {
+			normalized[i] = normalizeYAML(v)
+		}
+		return normalized
+	default:
+		return value
+	}
+}
@@ -0,0 +1,66 @@
+package loaders
+
+import (
+	"bytes"
+	"fmt"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// SetEnvironmentPackagesBytes rewrites the contents of a SAME file with the packages of one of its environments
+// replaced, adding the environment if the file doesn't have it. As with MigrateSAMEBytes, the file is edited in
+// place, so comments and ordering survive.
+func SetEnvironmentPackagesBytes(configFileBytes []byte, environmentName string, packages []string) ([]byte, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(configFileBytes, &doc); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the yaml file - invalid config file format: %v", err)
+	}
+	if len(doc.Content) == 0 || resolveAlias(doc.Content[0]).Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("expected a mapping at the top level of the file")
+	}
+	root := resolveAlias(doc.Content[0])
+
+	environments := mappingValue(root, "environments")
+	if environments == nil || isNull(environments) {
+		environments = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		setMappingValue(root, "environments", environments)
+	} else if environments.Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("expected 'environments' to be a mapping")
+	}
+
+	environment := mappingValue(environments, environmentName)
+	if environment == nil || isNull(environment) {
+		environment = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		setMappingValue(environments, environmentName, environment)
+	} else if environment.Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("expected the environment '%v' to be a mapping", environmentName)
+	}
+
+	packagesNode := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+	for _, packageName := range packages {
+		packagesNode.Content = append(packagesNode.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: packageName})
+	}
+	setMappingValue(environment, "packages", packagesNode)
+
+	var updated bytes.Buffer
+	encoder := yamlv3.NewEncoder(&updated)
+	encoder.SetIndent(detectIndent(configFileBytes))
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("could not write SAME file: %v", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("could not write SAME file: %v", err)
+	}
+	return updated.Bytes(), nil
+}
+
+// setMappingValue replaces the value of a key in a mapping node, or adds the key at the end.
+func setMappingValue(node *yamlv3.Node, key string, value *yamlv3.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, value)
+}
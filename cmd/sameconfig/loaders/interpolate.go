@@ -0,0 +1,224 @@
+package loaders
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// referenceRegex matches ${source:name} and ${source:name:-default}. A reference can be escaped as $${...}.
+var referenceRegex = regexp.MustCompile(`\$?\$\{([A-Za-z_]+):([^}:]*)(:-([^}]*))?\}`)
+
+var notFoundMessages = map[string]string{
+	"env":   "the environment variable is not set",
+	"param": "the parameter was not passed with --run-param, set by the run or declared with a default",
+	"git":   "the SAME file is not in a git repository with a branch checked out",
+}
+
+// InterpolationError is a reference in a SAME file that could not be resolved.
+type InterpolationError struct {
+	File      string
+	Line      int
+	Column    int
+	Reference string
+	Message   string
+}
+
+func (e InterpolationError) Error() string {
+	return fmt.Sprintf("%v:%v:%v: %v: %v", e.File, e.Line, e.Column, e.Reference, e.Message)
+}
+
+// InterpolationErrors is every reference in a SAME file that could not be resolved.
+type InterpolationErrors []InterpolationError
+
+func (e InterpolationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// InterpolateSAME replaces the references in the values (and keys) of a SAME file:
+//
+//	${env:VAR}      the environment variable VAR
+//	${param:name}   the parameter 'name' from params (see SameSpec.RunParameterValues)
+//	${git:sha}      the commit checked out in the git repository holding gitDir (also ${git:branch})
+//
+// Any reference can have a default, used when it can't be resolved, e.g. ${env:TAG:-latest}. Substitution
+// happens on the parsed YAML, so a value can't change the structure of the file whatever it contains, and
+// references in comments are left alone. A value written without quotes is typed by what it interpolates to,
+// e.g. 'false' is a bool. fileName is only used to label errors.
+func InterpolateSAME(fileName string, contents []byte, params map[string]string, gitDir string) ([]byte, error) {
+	_, interpolated, _, err := interpolateSAME(fileName, contents, params, gitDir, false)
+	return interpolated, err
+}
+
+// interpolateSAME is InterpolateSAME, also returning the interpolated document, which keeps the positions of
+// the original file for errors. ${param:...} references are left as they are when deferParams is set, and it
+// reports whether there were any.
+func interpolateSAME(fileName string, contents []byte, params map[string]string, gitDir string, deferParams bool) (*yamlv3.Node, []byte, bool, error) {
+	doc := &yamlv3.Node{}
+	if err := yamlv3.Unmarshal(contents, doc); err != nil {
+		return nil, nil, false, fmt.Errorf("unable to unmarshal the yaml file '%v' - invalid config file format: %v", fileName, err)
+	}
+
+	errs := InterpolationErrors{}
+	git := &gitReferences{dir: gitDir}
+	changed, deferred := false, false
+
+	var interpolate func(node *yamlv3.Node)
+	interpolate = func(node *yamlv3.Node) {
+		for _, child := range node.Content {
+			interpolate(child)
+		}
+		if node.Kind != yamlv3.ScalarNode {
+			return
+		}
+
+		value := replaceAllSubmatchFunc(referenceRegex, node.Value, func(match []int) string {
+			reference := node.Value[match[0]:match[1]]
+			if strings.HasPrefix(reference, "$$") {
+				return reference[1:]
+			}
+
+			source, name := node.Value[match[2]:match[3]], node.Value[match[4]:match[5]]
+			if source == "param" && deferParams {
+				deferred = true
+				return reference
+			}
+			value, found, err := resolveReference(source, name, params, git)
+			if !found && err == nil && match[6] != -1 {
+				return node.Value[match[8]:match[9]]
+			}
+			if err == nil && !found {
+				err = fmt.Errorf("%v and there is no default", notFoundMessages[source])
+			}
+			if err != nil {
+				line, column := referencePosition(node, match[0])
+				errs = append(errs, InterpolationError{File: fileName, Line: line, Column: column, Reference: reference, Message: err.Error()})
+				return reference
+			}
+			return value
+		})
+		if value == node.Value {
+			return
+		}
+
+		node.Value = value
+		// Without quotes, the value is typed by what it interpolates to, as if it had been written out
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+		changed = true
+	}
+	interpolate(doc)
+
+	if len(errs) > 0 {
+		return nil, nil, deferred, errs
+	}
+	if !changed {
+		return doc, contents, deferred, nil
+	}
+
+	var interpolated bytes.Buffer
+	encoder := yamlv3.NewEncoder(&interpolated)
+	encoder.SetIndent(detectIndent(contents))
+	if err := encoder.Encode(doc); err != nil {
+		return nil, nil, deferred, fmt.Errorf("could not write interpolated SAME file '%v': %v", fileName, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, nil, deferred, fmt.Errorf("could not write interpolated SAME file '%v': %v", fileName, err)
+	}
+	return doc, interpolated.Bytes(), deferred, nil
+}
+
+// referencePosition returns the line and column of a reference offset bytes into the value of a scalar. The
+// scalar's own position is used when the reference isn't on its first line, or the scalar is a block.
+func referencePosition(node *yamlv3.Node, offset int) (int, int) {
+	if strings.Contains(node.Value[:offset], "\n") {
+		return node.Line, node.Column
+	}
+	switch node.Style {
+	case 0:
+		return node.Line, node.Column + offset
+	case yamlv3.SingleQuotedStyle, yamlv3.DoubleQuotedStyle:
+		return node.Line, node.Column + 1 + offset
+	default:
+		return node.Line, node.Column
+	}
+}
+
+func resolveReference(source string, name string, params map[string]string, git *gitReferences) (string, bool, error) {
+	switch source {
+	case "env":
+		value, ok := os.LookupEnv(name)
+		return value, ok, nil
+	case "param":
+		value, ok := params[name]
+		return value, ok, nil
+	case "git":
+		return git.resolve(name)
+	default:
+		return "", false, fmt.Errorf("unknown reference type '%v', expected one of: env, param, git", source)
+	}
+}
+
+// replaceAllSubmatchFunc is regexp.ReplaceAllStringFunc, but passing the submatch indexes to repl.
+func replaceAllSubmatchFunc(re *regexp.Regexp, s string, repl func([]int) string) string {
+	var result strings.Builder
+	last := 0
+	for _, match := range re.FindAllStringSubmatchIndex(s, -1) {
+		result.WriteString(s[last:match[0]])
+		result.WriteString(repl(match))
+		last = match[1]
+	}
+	result.WriteString(s[last:])
+	return result.String()
+}
+
+// gitReferences resolves ${git:...} references, opening the repository the first time one is used.
+type gitReferences struct {
+	dir    string
+	opened bool
+	repo   *git.Repository
+	err    error
+}
+
+func (g *gitReferences) resolve(name string) (string, bool, error) {
+	if name != "sha" && name != "branch" {
+		return "", false, fmt.Errorf("unknown git reference '%v', expected one of: sha, branch", name)
+	}
+
+	if !g.opened {
+		g.opened = true
+		if g.dir == "" {
+			g.err = fmt.Errorf("remote SAME files are not in a local git repository")
+		} else {
+			g.repo, g.err = git.PlainOpenWithOptions(g.dir, &git.PlainOpenOptions{DetectDotGit: true})
+		}
+	}
+	if g.err != nil {
+		// Not being in a repository is the same as the value not being set, so a default can be used
+		return "", false, nil
+	}
+
+	head, err := g.repo.Head()
+	if err != nil {
+		return "", false, fmt.Errorf("could not read the current git commit: %v", err)
+	}
+
+	if name == "sha" {
+		return head.Hash().String(), true, nil
+	}
+	if !head.Name().IsBranch() {
+		// Detached HEAD
+		return "", false, nil
+	}
+	return head.Name().Short(), true, nil
+}
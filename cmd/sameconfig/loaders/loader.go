@@ -2,54 +2,50 @@ package loaders
 
 import (
 	"fmt"
-	"io/ioutil"
-
-	netUrl "net/url"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
-// // Empty struct - used to implement Converter interface.
-// type V1 struct {
-// }
+// unMarshallSAME converts the loaded definition file into a SameConfig, using the loader for its apiVersion.
+func (v V1) unMarshallSAME(def map[string]interface{}) (sameConfig *SameConfig, err error) {
+	apiVersion := DefaultAPIVersion
+	if value, ok := def["apiVersion"].(string); ok && value != "" {
+		apiVersion = value
+	}
 
-// LoadSameConfig takes the loaded definiton file, loads it and then unmarshalls it into a SameConfig struct.
-func (v V1) unMarshallSAME(def interface{}) (sameConfig *SameConfig, err error) {
-	// First create the struct to unmarshall the yaml into
-	sameConfigFromFile := &SameSpec{}
+	loader, ok := LoaderForAPIVersion(apiVersion)
+	if !ok {
+		return nil, fmt.Errorf("unsupported apiVersion '%v', expected one of: %v", apiVersion, strings.Join(LoaderAPIVersions(), ", "))
+	}
 
-	bytes, err := yaml.Marshal(def)
+	sameConfig, err = loader.LoadSameConfig(def)
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal input file into bytes: %v", err)
+		return nil, err
 	}
-	err = yaml.Unmarshal(bytes, sameConfigFromFile)
+	sameConfig.Spec.APIVersion = apiVersion
+
+	return sameConfig, nil
+}
+
+// MarshalSAME writes a SameConfig out as a SAME file in the format of its apiVersion.
+func (v V1) MarshalSAME(sameConfig SameConfig) ([]byte, error) {
+	apiVersion := sameConfig.Spec.APIVersion
+	if apiVersion == "" {
+		apiVersion = DefaultAPIVersion
+	}
+	def, err := NewSameDef(apiVersion)
 	if err != nil {
-		return nil, fmt.Errorf("could not unpack same configuration file: %v", err)
+		return nil, err
 	}
 
-	sameConfig = &SameConfig{
-		Spec: SameSpec{
-			APIVersion: sameConfigFromFile.APIVersion,
-			Version:    sameConfigFromFile.Version,
-		},
+	loader, _ := LoaderForAPIVersion(apiVersion)
+	if err := loader.LoadSameDef(sameConfig, def); err != nil {
+		return nil, err
 	}
 
-	sameConfig.Spec.Metadata = sameConfigFromFile.Metadata
-	sameConfig.Spec.Bases = sameConfigFromFile.Bases
-	sameConfig.Spec.EnvFiles = sameConfigFromFile.EnvFiles
-	sameConfig.Spec.Resources = sameConfigFromFile.Resources
-	sameConfig.Spec.Workflow.Parameters = sameConfigFromFile.Workflow.Parameters
-	sameConfig.Spec.Pipeline = sameConfigFromFile.Pipeline
-	sameConfig.Spec.DataSets = sameConfigFromFile.DataSets
-	sameConfig.Spec.Run = sameConfigFromFile.Run
-	sameConfig.Spec.ConfigFilePath = sameConfigFromFile.ConfigFilePath
-	sameConfig.Spec.Environments = sameConfigFromFile.Environments
-
-	// a, _ := yaml.Marshal(sameConfig)
-	// fmt.Println(string(a))
-
-	return sameConfig, nil
+	return yaml.Marshal(def)
 }
 
 // LoadSAMEConfig reads the samedef from a remote URI or local file,
@@ -60,28 +56,15 @@ func (v V1) LoadSAME(configFilePath string) (*SameConfig, error) {
 		return &SameConfig{}, fmt.Errorf("config file must be the URI of a SameDef spec")
 	}
 
-	// Read contents
+	// Read contents and layer them over any bases
 	log.Tracef("Config File Path: %v\n", configFilePath)
-	resolvedConfigFilePath, err := netUrl.Parse(configFilePath)
-	if err != nil {
-		log.Errorf("root.go: could not resolve same config file path: %v", err)
-		return &SameConfig{}, err
-	}
-	log.Tracef("Parsed file path to: %v\n", resolvedConfigFilePath.Path)
-	configFileBytes, err := ioutil.ReadFile(resolvedConfigFilePath.Path)
+	obj, err := ComposeSAME(configFilePath, v.Params, v.Profile)
 	if err != nil {
-		message := fmt.Errorf("root.go: could not read from config file %s: %v", configFilePath, err)
+		message := fmt.Errorf("root.go: could not load config file %s: %v", configFilePath, err)
 		log.Errorf(message.Error())
 		return &SameConfig{}, message
 	}
 
-	log.Tracef("Loaded file into bytes of size: %v\n", len(configFileBytes))
-	// Check API version.
-	var obj map[string]interface{}
-	if err = yaml.Unmarshal(configFileBytes, &obj); err != nil {
-		return nil, fmt.Errorf("unable to unmarshal the yaml file - invalid config file format: %v", err)
-	}
-
 	log.Tracef("Unmarshalled bytes to yaml of size: %v\n", len(obj))
 
 	sameconfig, err := v.unMarshallSAME(obj)
@@ -90,6 +73,12 @@ func (v V1) LoadSAME(configFilePath string) (*SameConfig, error) {
 		return &SameConfig{}, err
 	}
 
+	if sameconfig.Spec.Workflow.Type != "" {
+		if err := ValidateTarget(sameconfig.Spec.Workflow.Type); err != nil {
+			return &SameConfig{}, fmt.Errorf("workflow.type in %v: %v", configFilePath, err)
+		}
+	}
+
 	log.Trace("Loaded SAME")
 
 	return sameconfig, nil
@@ -0,0 +1,175 @@
+package loaders
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// MigrateSAMEBytes rewrites the contents of a SAME file from its apiVersion to toAPIVersion. Keys are renamed
+// in place rather than round tripping through the Go types, so comments and ordering survive. Bases are not
+// followed - each one has to be migrated on its own, so required fields are not checked either. fileName is
+// only used to label errors.
+func MigrateSAMEBytes(fileName string, configFileBytes []byte, toAPIVersion string) ([]byte, error) {
+	if errs := validateDocument(fileName, configFileBytes, false); len(errs) > 0 {
+		return nil, fmt.Errorf("SAME file '%v' is not valid:\n%v", fileName, errs)
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(configFileBytes, &doc); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the yaml file - invalid config file format: %v", err)
+	}
+	if len(doc.Content) == 0 || resolveAlias(doc.Content[0]).Kind != yamlv3.MappingNode {
+		return nil, fmt.Errorf("expected a mapping at the top level of the file")
+	}
+	root := resolveAlias(doc.Content[0])
+
+	fromAPIVersion := DefaultAPIVersion
+	if apiVersionNode := mappingValue(root, "apiVersion"); apiVersionNode != nil && apiVersionNode.Value != "" {
+		fromAPIVersion = apiVersionNode.Value
+	}
+
+	fromDef, err := NewSameDef(fromAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	toDef, err := NewSameDef(toAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	renameKeys(root, reflect.TypeOf(fromDef), reflect.TypeOf(toDef))
+
+	if apiVersionNode := mappingValue(root, "apiVersion"); apiVersionNode != nil {
+		apiVersionNode.Value = toAPIVersion
+	} else {
+		root.Content = append([]*yamlv3.Node{
+			{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: "apiVersion"},
+			{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: toAPIVersion},
+		}, root.Content...)
+	}
+
+	var migrated bytes.Buffer
+	encoder := yamlv3.NewEncoder(&migrated)
+	encoder.SetIndent(detectIndent(configFileBytes))
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("could not write migrated SAME file: %v", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("could not write migrated SAME file: %v", err)
+	}
+
+	if errs := validateDocument(fileName, migrated.Bytes(), false); len(errs) > 0 {
+		return nil, fmt.Errorf("migrated SAME file is not valid:\n%v", errs)
+	}
+	return migrated.Bytes(), nil
+}
+
+// renameKeys walks a yaml node alongside the Go types of two versions of the format, renaming each key from
+// its spelling in the first version to the spelling of the field with the same Go name in the second.
+func renameKeys(node *yamlv3.Node, from reflect.Type, to reflect.Type) {
+	node = resolveAlias(node)
+	for from.Kind() == reflect.Ptr {
+		from = from.Elem()
+	}
+	for to.Kind() == reflect.Ptr {
+		to = to.Elem()
+	}
+
+	switch from.Kind() {
+	case reflect.Struct:
+		if node.Kind != yamlv3.MappingNode || to.Kind() != reflect.Struct {
+			return
+		}
+		fromFields := yamlFieldsByKey(from)
+		toFields := yamlFieldsByName(to)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fromField, ok := fromFields[key.Value]
+			if !ok {
+				continue
+			}
+			toField, ok := toFields[fromField.Name]
+			if !ok {
+				continue
+			}
+			key.Value = yamlKey(toField)
+			renameKeys(value, fromField.Type, toField.Type)
+		}
+	case reflect.Map:
+		if node.Kind != yamlv3.MappingNode || to.Kind() != reflect.Map {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			renameKeys(node.Content[i+1], from.Elem(), to.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yamlv3.SequenceNode || (to.Kind() != reflect.Slice && to.Kind() != reflect.Array) {
+			return
+		}
+		for _, item := range node.Content {
+			renameKeys(item, from.Elem(), to.Elem())
+		}
+	}
+}
+
+func yamlFieldsByKey(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for _, field := range yamlFields(t) {
+		fields[yamlKey(field)] = field
+	}
+	return fields
+}
+
+func yamlFieldsByName(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for _, field := range yamlFields(t) {
+		fields[field.Name] = field
+	}
+	return fields
+}
+
+// yamlFields lists the fields of a struct as yaml sees them, with inlined structs flattened.
+func yamlFields(t reflect.Type) []reflect.StructField {
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("yaml")
+		if strings.Split(tag, ",")[0] == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		if strings.Contains(tag, ",inline") {
+			inner := field.Type
+			for inner.Kind() == reflect.Ptr {
+				inner = inner.Elem()
+			}
+			if inner.Kind() == reflect.Struct {
+				fields = append(fields, yamlFields(inner)...)
+			}
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func yamlKey(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("yaml"), ",")[0]; name != "" {
+		return name
+	}
+	return strings.ToLower(field.Name)
+}
+
+var indentRegex = regexp.MustCompile(`(?m)^( +)[^ #-]`)
+
+// detectIndent returns the indentation used by a yaml file, so a rewritten file keeps its look.
+func detectIndent(contents []byte) int {
+	if match := indentRegex.FindSubmatch(contents); match != nil && len(match[1]) >= 2 {
+		return len(match[1])
+	}
+	return 2
+}
@@ -0,0 +1,331 @@
+package loaders
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Types a declared parameter can have
+const (
+	ParameterTypeString = "string"
+	ParameterTypeInt    = "int"
+	ParameterTypeFloat  = "float"
+	ParameterTypeBool   = "bool"
+	ParameterTypeList   = "list"
+	ParameterTypeDict   = "dict"
+)
+
+var parameterTypes = []string{ParameterTypeString, ParameterTypeInt, ParameterTypeFloat, ParameterTypeBool, ParameterTypeList, ParameterTypeDict}
+
+// ParameterType returns the declared type of a parameter. Without a declared type, the type of the default
+// is used, and parameters without either are strings.
+func (p Parameter) ParameterType() string {
+	if p.Type != "" {
+		return p.Type
+	}
+	if p.Default != nil {
+		return InferParameterType(p.Default)
+	}
+	return ParameterTypeString
+}
+
+// InferParameterType returns the parameter type matching a value read from a SAME file.
+func InferParameterType(value interface{}) string {
+	switch normalizeYAML(value).(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return ParameterTypeInt
+	case float32, float64:
+		return ParameterTypeFloat
+	case bool:
+		return ParameterTypeBool
+	case []interface{}:
+		return ParameterTypeList
+	case map[string]interface{}:
+		return ParameterTypeDict
+	default:
+		return ParameterTypeString
+	}
+}
+
+// ParseValue parses a value passed on the command line (e.g. with --run-param) as the parameter's type. Lists
+// and dicts are given as JSON.
+func (p Parameter) ParseValue(raw string) (interface{}, error) {
+	var value interface{}
+	var err error
+	switch p.ParameterType() {
+	case ParameterTypeInt:
+		value, err = strconv.Atoi(strings.TrimSpace(raw))
+	case ParameterTypeFloat:
+		value, err = strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	case ParameterTypeBool:
+		value, err = strconv.ParseBool(strings.TrimSpace(raw))
+	case ParameterTypeList, ParameterTypeDict:
+		err = json.Unmarshal([]byte(raw), &value)
+	default:
+		value = raw
+	}
+	if err != nil {
+		return nil, fmt.Errorf("'%v' is not a valid %v", raw, p.ParameterType())
+	}
+	return p.CheckValue(value)
+}
+
+// CheckValue checks a value against the parameter's type, enum and bounds, returning it in the form it is
+// passed on in (ints are widened to floats for float parameters, and yaml maps get string keys).
+func (p Parameter) CheckValue(value interface{}) (interface{}, error) {
+	value = normalizeYAML(value)
+	parameterType := p.ParameterType()
+
+	valueType := InferParameterType(value)
+	if valueType == ParameterTypeInt && parameterType == ParameterTypeFloat {
+		value = reflect.ValueOf(value).Convert(reflect.TypeOf(float64(0))).Interface()
+		valueType = ParameterTypeFloat
+	}
+	if valueType != parameterType {
+		return nil, fmt.Errorf("expected a %v, found %v '%v'", parameterType, valueType, EncodeParameterValue(value))
+	}
+
+	if len(p.Enum) > 0 {
+		allowed := make([]string, 0, len(p.Enum))
+		found := false
+		for _, enumValue := range p.Enum {
+			encoded := EncodeParameterValue(normalizeYAML(enumValue))
+			allowed = append(allowed, encoded)
+			found = found || encoded == EncodeParameterValue(value)
+		}
+		if !found {
+			return nil, fmt.Errorf("'%v' is not one of: %v", EncodeParameterValue(value), strings.Join(allowed, ", "))
+		}
+	}
+
+	if p.Min != nil || p.Max != nil {
+		var number float64
+		switch parameterType {
+		case ParameterTypeInt, ParameterTypeFloat:
+			number = reflect.ValueOf(value).Convert(reflect.TypeOf(float64(0))).Float()
+		case ParameterTypeString:
+			number = float64(len(value.(string)))
+		case ParameterTypeList:
+			number = float64(len(value.([]interface{})))
+		default:
+			return value, nil
+		}
+		if p.Min != nil && number < *p.Min {
+			return nil, fmt.Errorf("'%v' is less than the minimum of %v", EncodeParameterValue(value), *p.Min)
+		}
+		if p.Max != nil && number > *p.Max {
+			return nil, fmt.Errorf("'%v' is more than the maximum of %v", EncodeParameterValue(value), *p.Max)
+		}
+	}
+
+	return value, nil
+}
+
+// EncodeParameterValue turns a parameter value into the string passed across the workflow boundary. Strings are
+// passed as they are and everything else (including lists and dicts) as JSON, to be decoded again in the step.
+func EncodeParameterValue(value interface{}) string {
+	value = normalizeYAML(value)
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// ParameterNames returns the sorted names of the declared parameters.
+func (s SameSpec) ParameterNames() []string {
+	names := make([]string, 0, len(s.Parameters))
+	for name := range s.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateParameters checks the parameter declarations, and that the values set under 'run' and 'runs' match them.
+func (s SameSpec) ValidateParameters() error {
+	problems := make([]string, 0)
+	for _, name := range s.ParameterNames() {
+		problems = append(problems, checkParameterDeclaration(name, s.Parameters[name])...)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%v", strings.Join(problems, "\n"))
+	}
+
+	runs := map[string]Run{"run": s.Run}
+	for name, run := range s.Runs {
+		runs[fmt.Sprintf("runs.%v", name)] = run
+	}
+	runNames := make([]string, 0, len(runs))
+	for name := range runs {
+		runNames = append(runNames, name)
+	}
+	sort.Strings(runNames)
+
+	for _, runName := range runNames {
+		for _, name := range sortedValueKeys(runs[runName].Parameters) {
+			if _, err := s.checkParameterValue(name, runs[runName].Parameters[name]); err != nil {
+				problems = append(problems, fmt.Sprintf("%v.parameters.%v: %v", runName, name, err))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%v", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// ResolveRunParameters works out the parameters to start a run with: the declared defaults, then the run's
+// parameters, then overrides (from --run-param), which are parsed as the declared type. Every value is checked
+// against its declaration. Once a SAME file declares parameters, only declared parameters can be passed.
+func (s SameSpec) ResolveRunParameters(run Run, overrides map[string]string) (map[string]interface{}, error) {
+	resolved, problems, err := s.resolveRunParameters(run, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range s.ParameterNames() {
+		if _, ok := resolved[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%v: has no default and was not set by the run or with --run-param", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid run parameters:\n%v", strings.Join(problems, "\n"))
+	}
+	return resolved, nil
+}
+
+// RunParameterValues returns the parameters a run of the profile starts with (see ResolveRunParameters), in
+// the form ${param:...} references are replaced with. Parameters without a value are left out, so references
+// to them fall back to their own default.
+func (s SameSpec) RunParameterValues(profile string, overrides map[string]string) (map[string]string, error) {
+	run, err := s.RunProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	resolved, problems, err := s.resolveRunParameters(run, overrides)
+	if err != nil {
+		return nil, err
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid run parameters:\n%v", strings.Join(problems, "\n"))
+	}
+
+	values := make(map[string]string, len(resolved))
+	for name, value := range resolved {
+		values[name] = EncodeParameterValue(value)
+	}
+	return values, nil
+}
+
+// resolveRunParameters layers the run's parameters and the overrides over the declared defaults, returning
+// the values it could resolve and the problems with the rest.
+func (s SameSpec) resolveRunParameters(run Run, overrides map[string]string) (map[string]interface{}, []string, error) {
+	if err := s.ValidateParameters(); err != nil {
+		return nil, nil, err
+	}
+
+	problems := make([]string, 0)
+	resolved := make(map[string]interface{})
+	for _, name := range s.ParameterNames() {
+		if s.Parameters[name].Default != nil {
+			resolved[name], _ = s.Parameters[name].CheckValue(s.Parameters[name].Default)
+		}
+	}
+	for _, name := range sortedValueKeys(run.Parameters) {
+		value, err := s.checkParameterValue(name, run.Parameters[name])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%v: %v", name, err))
+			continue
+		}
+		resolved[name] = value
+	}
+
+	overrideNames := make([]string, 0, len(overrides))
+	for name := range overrides {
+		overrideNames = append(overrideNames, name)
+	}
+	sort.Strings(overrideNames)
+	for _, name := range overrideNames {
+		parameter, declared := s.Parameters[name]
+		if !declared {
+			if len(s.Parameters) > 0 {
+				problems = append(problems, fmt.Sprintf("%v: is not declared under 'parameters', expected one of: %v", name, strings.Join(s.ParameterNames(), ", ")))
+				continue
+			}
+			resolved[name] = overrides[name]
+			continue
+		}
+		value, err := parameter.ParseValue(overrides[name])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%v: %v", name, err))
+			continue
+		}
+		resolved[name] = value
+	}
+	return resolved, problems, nil
+}
+
+// checkParameterValue checks a value set for a parameter by a run. Undeclared parameters are only allowed
+// in SAME files that don't declare any.
+func (s SameSpec) checkParameterValue(name string, value interface{}) (interface{}, error) {
+	parameter, declared := s.Parameters[name]
+	if !declared {
+		if len(s.Parameters) > 0 {
+			return nil, fmt.Errorf("is not declared under 'parameters', expected one of: %v", strings.Join(s.ParameterNames(), ", "))
+		}
+		return normalizeYAML(value), nil
+	}
+	return parameter.CheckValue(value)
+}
+
+func checkParameterDeclaration(name string, parameter Parameter) []string {
+	problems := make([]string, 0)
+	if parameter.Type != "" && !containsString(parameterTypes, parameter.Type) {
+		return append(problems, fmt.Sprintf("parameters.%v.type: unknown type '%v', expected one of: %v", name, parameter.Type, strings.Join(parameterTypes, ", ")))
+	}
+	if parameter.Min != nil && parameter.Max != nil && *parameter.Min > *parameter.Max {
+		problems = append(problems, fmt.Sprintf("parameters.%v: min (%v) is more than max (%v)", name, *parameter.Min, *parameter.Max))
+	}
+
+	// The enum is checked on its own, so its values are only checked against the type and bounds
+	unrestricted := parameter
+	unrestricted.Enum = nil
+	for _, enumValue := range parameter.Enum {
+		if _, err := unrestricted.CheckValue(enumValue); err != nil {
+			problems = append(problems, fmt.Sprintf("parameters.%v.enum: %v", name, err))
+		}
+	}
+	if parameter.Default != nil {
+		if _, err := parameter.CheckValue(parameter.Default); err != nil {
+			problems = append(problems, fmt.Sprintf("parameters.%v.default: %v", name, err))
+		}
+	}
+	return problems
+}
+
+func sortedValueKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
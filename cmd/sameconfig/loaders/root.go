@@ -1,11 +1,65 @@
 package loaders
 
-// V1 Empty struct - used to implement Converter interface.
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/apis/v1alpha1"
+	"github.com/azure-octo/same-cli/cmd/sameconfig/apis/v1alpha2"
+)
+
+// V1 Empty struct - used to implement Converter interface. Params (from --run-param) and Profile are used to
+// interpolate ${param:...} references in the SAME file, see ComposeSAME.
 type V1 struct {
+	Params  map[string]string
+	Profile string
 }
 
 // Loader is an interface describing LoadSameConfig and LoadSameDef for this version of the API.
+// LoadSameConfig converts a SAME file of this version (as unmarshalled from yaml) into the hub SameConfig,
+// and LoadSameDef converts a SameConfig back into this version's types, which out must point to.
 type Loader interface {
 	LoadSameConfig(samedef interface{}) (*SameConfig, error)
 	LoadSameDef(config SameConfig, out interface{}) error
 }
+
+// registeredVersion is a version of the SAME file format: its loader, and a constructor for its Go type.
+type registeredVersion struct {
+	Loader Loader
+	NewDef func() interface{}
+}
+
+// registeredVersions holds every version of the SAME file format we understand, keyed by apiVersion.
+var registeredVersions = map[string]registeredVersion{
+	v1alpha1.APIVersion: {Loader: V1Alpha1{}, NewDef: func() interface{} { return &v1alpha1.SameSpec{} }},
+	v1alpha2.APIVersion: {Loader: V1Alpha2{}, NewDef: func() interface{} { return &v1alpha2.SameSpec{} }},
+}
+
+// LatestAPIVersion is the newest version of the SAME file format.
+const LatestAPIVersion = v1alpha2.APIVersion
+
+// LoaderForAPIVersion returns the loader registered for the apiVersion, or false if there is none.
+func LoaderForAPIVersion(apiVersion string) (Loader, bool) {
+	version, ok := registeredVersions[apiVersion]
+	return version.Loader, ok
+}
+
+// NewSameDef returns a pointer to an empty SAME file of the apiVersion's Go type.
+func NewSameDef(apiVersion string) (interface{}, error) {
+	version, ok := registeredVersions[apiVersion]
+	if !ok {
+		return nil, fmt.Errorf("unsupported apiVersion '%v', expected one of: %v", apiVersion, strings.Join(LoaderAPIVersions(), ", "))
+	}
+	return version.NewDef(), nil
+}
+
+// LoaderAPIVersions returns the sorted list of apiVersions that have a loader.
+func LoaderAPIVersions() []string {
+	versions := make([]string, 0, len(registeredVersions))
+	for v := range registeredVersions {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
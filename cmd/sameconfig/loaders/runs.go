@@ -0,0 +1,62 @@
+package loaders
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RunProfileNames returns the sorted names of the profiles under 'runs'.
+func (s SameSpec) RunProfileNames() []string {
+	names := make([]string, 0, len(s.Runs))
+	for name := range s.Runs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RunProfile returns the run to start for a profile. The profile is layered over 'run': parameters are
+// merged key by key and any other field set in the profile wins. A profile without a name of its own is
+// named after the profile, so its runs can be told apart (e.g. by 'same run list --profile'). An empty
+// profile returns 'run' as it is. The experiment defaults to the name of the program.
+func (s SameSpec) RunProfile(profile string) (Run, error) {
+	run := Run{
+		Name:        s.Run.Name,
+		Description: s.Run.Description,
+		Experiment:  s.Run.Experiment,
+		Parameters:  make(map[string]interface{}, len(s.Run.Parameters)),
+	}
+	for k, v := range s.Run.Parameters {
+		run.Parameters[k] = v
+	}
+
+	if profile != "" {
+		profileRun, ok := s.Runs[profile]
+		if !ok {
+			if len(s.Runs) == 0 {
+				return Run{}, fmt.Errorf("no run profile named '%v', the SAME file does not declare any under 'runs'", profile)
+			}
+			return Run{}, fmt.Errorf("no run profile named '%v', expected one of: %v", profile, strings.Join(s.RunProfileNames(), ", "))
+		}
+
+		run.Name = profile
+		if profileRun.Name != "" {
+			run.Name = profileRun.Name
+		}
+		if profileRun.Description != "" {
+			run.Description = profileRun.Description
+		}
+		if profileRun.Experiment != "" {
+			run.Experiment = profileRun.Experiment
+		}
+		for k, v := range profileRun.Parameters {
+			run.Parameters[k] = v
+		}
+	}
+
+	if run.Experiment == "" {
+		run.Experiment = s.Metadata.Name
+	}
+	return run, nil
+}
@@ -0,0 +1,113 @@
+package loaders
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/apis/v1alpha1"
+	"github.com/azure-octo/same-cli/cmd/sameconfig/apis/v1alpha2"
+)
+
+// SchemaKind is the type of value a node in a SAME file is expected to hold.
+type SchemaKind string
+
+// Kinds of values understood by the schema validator
+const (
+	KindObject SchemaKind = "object"
+	KindMap    SchemaKind = "map"
+	KindList   SchemaKind = "list"
+	KindString SchemaKind = "string"
+	KindInt    SchemaKind = "int"
+	KindFloat  SchemaKind = "float"
+	KindBool   SchemaKind = "bool"
+	KindAny    SchemaKind = "any"
+)
+
+// Schema describes the shape of a node in a SAME file. Objects have a fixed set of Fields,
+// maps and lists describe their values with Elem.
+type Schema struct {
+	Kind     SchemaKind
+	Fields   map[string]*Schema
+	Elem     *Schema
+	Required []string
+}
+
+// schemas holds the schema for every apiVersion we know how to validate.
+var schemas = map[string]*Schema{
+	v1alpha1.APIVersion: SchemaFor(reflect.TypeOf(v1alpha1.SameSpec{}), "metadata.name", "pipeline.package"),
+	v1alpha2.APIVersion: SchemaFor(reflect.TypeOf(v1alpha2.SameSpec{}), "metadata.name", "pipeline.package"),
+}
+
+// DefaultAPIVersion is used for files that do not declare an apiVersion.
+const DefaultAPIVersion = v1alpha1.APIVersion
+
+// SchemaForAPIVersion returns the schema registered for the apiVersion, or false if there is none.
+func SchemaForAPIVersion(apiVersion string) (*Schema, bool) {
+	s, ok := schemas[apiVersion]
+	return s, ok
+}
+
+// SupportedAPIVersions returns the sorted list of apiVersions that have a schema.
+func SupportedAPIVersions() []string {
+	versions := make([]string, 0, len(schemas))
+	for v := range schemas {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// SchemaFor builds a schema from the yaml tags of a Go type. Required fields are given as
+// dotted paths from the root (e.g. "metadata.name").
+func SchemaFor(t reflect.Type, required ...string) *Schema {
+	s := schemaForType(t)
+	for _, path := range required {
+		parts := strings.Split(path, ".")
+		parent := s
+		for _, part := range parts[:len(parts)-1] {
+			if parent == nil || parent.Fields == nil {
+				break
+			}
+			parent = parent.Fields[part]
+		}
+		if parent != nil && parent.Kind == KindObject {
+			parent.Required = append(parent.Required, parts[len(parts)-1])
+		}
+	}
+	return s
+}
+
+func schemaForType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		s := &Schema{Kind: KindObject, Fields: map[string]*Schema{}}
+		addStructFields(s, t)
+		return s
+	case reflect.Map:
+		return &Schema{Kind: KindMap, Elem: schemaForType(t.Elem())}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Kind: KindList, Elem: schemaForType(t.Elem())}
+	case reflect.String:
+		return &Schema{Kind: KindString}
+	case reflect.Bool:
+		return &Schema{Kind: KindBool}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Kind: KindInt}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Kind: KindFloat}
+	default:
+		return &Schema{Kind: KindAny}
+	}
+}
+
+func addStructFields(s *Schema, t reflect.Type) {
+	for _, field := range yamlFields(t) {
+		s.Fields[yamlKey(field)] = schemaForType(field.Type)
+	}
+}
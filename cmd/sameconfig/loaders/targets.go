@@ -0,0 +1,46 @@
+package loaders
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Backends a SAME program can be compiled for and run on
+const (
+	TargetKubeflow = "kubeflow"
+	TargetAML      = "aml"
+)
+
+// Targets are the valid values for workflow.type and --target.
+var Targets = []string{TargetKubeflow, TargetAML}
+
+// ValidateTarget returns an error listing the valid targets if target isn't one of them.
+func ValidateTarget(target string) error {
+	if !containsString(Targets, target) {
+		return fmt.Errorf("unknown target '%v', expected one of: %v", target, strings.Join(Targets, ", "))
+	}
+	return nil
+}
+
+// ResolveTarget picks the backend to use: the --target flag, then workflow.type in the SAME file, then the
+// 'target' setting in the SAME config, then Kubeflow. Empty values are skipped.
+func ResolveTarget(flagTarget string, fileTarget string, configTarget string) (string, error) {
+	sources := []struct {
+		name   string
+		target string
+	}{
+		{"--target", flagTarget},
+		{"workflow.type", fileTarget},
+		{"the 'target' setting", configTarget},
+	}
+	for _, source := range sources {
+		if source.target == "" {
+			continue
+		}
+		if err := ValidateTarget(source.target); err != nil {
+			return "", fmt.Errorf("%v: %v", source.name, err)
+		}
+		return source.target, nil
+	}
+	return TargetKubeflow, nil
+}
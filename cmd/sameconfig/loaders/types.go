@@ -29,7 +29,11 @@ type SameSpec struct {
 	Pipeline              Pipeline               `yaml:"pipeline,omitempty"`
 	Environments          map[string]Environment `yaml:"environments,omitempty"`
 	DataSets              []DataSet              `yaml:"dataSets,omitempty"`
+	Parameters            map[string]Parameter   `yaml:"parameters,omitempty"`
+	ImportMap             map[string]string      `yaml:"import_map,omitempty"`
+	ContextStorage        ContextStorage         `yaml:"context_storage,omitempty"`
 	Run                   Run                    `yaml:"run,omitempty"`
+	Runs                  map[string]Run         `yaml:"runs,omitempty"`
 	DebuggingFeatureFlags map[string]bool        `yaml:"debugging_features_flags,omitempty"`
 	ConfigFilePath        string                 `yaml:"configfilepath,omitempty"`
 	KubeConfig            string                 `yaml:"kubeconfig,omitempty"`
@@ -43,12 +47,6 @@ type Metadata struct {
 	Version string            `yaml:"version,omitempty"`
 }
 
-// // EnvFile lists all files that have environment variables that should be mounted in the running pods.
-// // TODO: Mount all environment variables in the pods.
-// type EnvFile struct {
-// 	File string `yaml:"envfiles,omitempty"`
-// }
-
 // Resource (may be poorly named) describes the agent pool to be provisioned in the Kubernetes cluster
 type Resource struct {
 	Provider          string `yaml:"provider,omitempty"`
@@ -90,7 +88,7 @@ type VolumeMount struct {
 // Workflow is the workflow executor for SAME
 // TODO: Obviously parameters can't just be a 'Kubeflow' but it's good enough for now
 type Workflow struct {
-	Type       string   `yaml:"mountPath,omitempty"`
+	Type       string   `yaml:"type,omitempty"`
 	Parameters Kubeflow `yaml:"parameters,omitempty"`
 }
 
@@ -112,6 +110,7 @@ type Pipeline struct {
 
 // DataSet is the data to be downloaded/mounted into the cluster.
 type DataSet struct {
+	Name          string `yaml:"name,omitempty"`
 	Type          string `yaml:"type,omitempty"`
 	URL           string `yaml:"url,omitempty"`
 	MakeLocalCopy bool   `yaml:"makeLocalCopy,omitempty"`
@@ -119,9 +118,12 @@ type DataSet struct {
 
 // Run is the name and specific parameters to run against one of the previously created pipelines.
 // RunWrapper comes from here: https://medium.com/@nate510/dynamic-json-umarshalling-in-go-88095561d6a0
+// Named profiles in 'runs' are layered over 'run' and picked with 'same program run --profile'.
 type Run struct {
-	Name       string                 `yaml:"name,omitempty"`
-	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
+	Name        string                 `yaml:"name,omitempty"`
+	Description string                 `yaml:"description,omitempty"`
+	Experiment  string                 `yaml:"experiment,omitempty"`
+	Parameters  map[string]interface{} `yaml:"parameters,omitempty"`
 }
 
 type Environment struct {
@@ -130,6 +132,17 @@ type Environment struct {
 	Packages                 []string              `yaml:"packages,omitempty,omitempty"`
 	PrivateRegistry          bool                  `yaml:"private_registry,omitempty"`
 	Credentials              RepositoryCredentials `yaml:"repository_credentials,omitempty"`
+	EnvFiles                 []string              `yaml:"envfiles,omitempty"`
+	Resources                StepResources         `yaml:"resources,omitempty"`
+}
+
+// StepResources are the resources a step runs with. They are set per step with cell tags (e.g. 'cpu=2',
+// 'memory=8Gi', 'gpu=1', 'gpu_type=nvidia-tesla-k80'), and default to the resources of the step's environment.
+type StepResources struct {
+	CPU     string `yaml:"cpu,omitempty"`
+	Memory  string `yaml:"memory,omitempty"`
+	GPU     string `yaml:"gpu,omitempty"`
+	GPUType string `yaml:"gpu_type,omitempty"`
 }
 
 type RepositoryCredentials struct {
@@ -140,19 +153,25 @@ type RepositoryCredentials struct {
 	Email      string `yaml:"email,omitempty"`
 }
 
-// type Parameter struct {
-// 	Parameter ParameterWrapper `yaml:"-,omitempty"`
-// }
-// type ParameterWrapper struct {
-// 	Parameter
-// 	Partial bool `json:”-”`
-// }
-
-// func (w *ParameterWrapper) UnmarshalJSON(data []byte) error {
-// 	if id, err := strconv.Atoi(string(data)); err == nil {
-// 		w.ID = id
-// 		w.Partial = true
-// 		return nil
-// 	}
-// 	return json.Unmarshal(data, &w.Sprocket)
-// }
+// Parameter declares a run parameter: its type (string, int, float, bool, list or dict), the value used when
+// neither 'run' nor --run-param sets it, and the values it may take.
+type Parameter struct {
+	Type        string        `yaml:"type,omitempty"`
+	Default     interface{}   `yaml:"default,omitempty"`
+	Description string        `yaml:"description,omitempty"`
+	Enum        []interface{} `yaml:"enum,omitempty"`
+	Min         *float64      `yaml:"min,omitempty"`
+	Max         *float64      `yaml:"max,omitempty"`
+}
+
+// ContextStorage is where steps write the variables they pass on once their context is too large to pass
+// inline. Spilling is off unless 'spill_threshold' (a size, e.g. '4Mi') is set; the variables go under
+// 'prefix' (an 's3://bucket/path' URI) on the S3 compatible 'endpoint', with the credentials in the
+// Kubernetes secret 'credentials_secret'. All but the threshold default to the artifact store of Kubeflow
+// Pipelines.
+type ContextStorage struct {
+	SpillThreshold    string `yaml:"spill_threshold,omitempty"`
+	Prefix            string `yaml:"prefix,omitempty"`
+	Endpoint          string `yaml:"endpoint,omitempty"`
+	CredentialsSecret string `yaml:"credentials_secret,omitempty"`
+}
@@ -0,0 +1,183 @@
+package loaders
+
+import (
+	"fmt"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/apis/v1alpha1"
+	"gopkg.in/yaml.v2"
+)
+
+// V1Alpha1 loads projectsame.io/v1alpha1 SAME files.
+type V1Alpha1 struct {
+}
+
+// LoadSameConfig converts a v1alpha1 SAME file into a SameConfig.
+func (V1Alpha1) LoadSameConfig(samedef interface{}) (*SameConfig, error) {
+	def := &v1alpha1.SameSpec{}
+	if err := remarshal(samedef, def); err != nil {
+		return nil, err
+	}
+
+	return &SameConfig{Spec: *V1Alpha1{}.ConvertToHub(def)}, nil
+}
+
+// LoadSameDef converts a SameConfig into a v1alpha1.SameSpec.
+func (V1Alpha1) LoadSameDef(config SameConfig, out interface{}) error {
+	def, ok := out.(*v1alpha1.SameSpec)
+	if !ok {
+		return fmt.Errorf("expected a *v1alpha1.SameSpec, found %T", out)
+	}
+	*def = *V1Alpha1{}.ConvertFromHub(&config.Spec)
+	def.APIVersion = v1alpha1.APIVersion
+	return nil
+}
+
+// ConvertToHub converts a v1alpha1 SAME file to the hub types, which every version is loaded into.
+func (V1Alpha1) ConvertToHub(in *v1alpha1.SameSpec) *SameSpec {
+	out := &SameSpec{
+		APIVersion: in.APIVersion,
+		Version:    in.Version,
+		Bases:      in.Bases,
+		Metadata:   Metadata(in.Metadata),
+		EnvFiles:   in.EnvFiles,
+		Resources: Resource{
+			Provider:          in.Resources.Provider,
+			ClusterProfile:    in.Resources.ClusterProfile,
+			NodePoolName:      in.Resources.NodePoolName,
+			CreateNewNodePool: in.Resources.CreateNewNodePool,
+			Cores:             Cores(in.Resources.Cores),
+			GPU:               GPU(in.Resources.GPU),
+		},
+		Workflow: Workflow{
+			Type:       in.Workflow.Type,
+			Parameters: Kubeflow(in.Workflow.Parameters),
+		},
+		Pipeline:              Pipeline(in.Pipeline),
+		ImportMap:             in.ImportMap,
+		ContextStorage:        ContextStorage(in.ContextStorage),
+		Run:                   Run(in.Run),
+		DebuggingFeatureFlags: in.DebuggingFeatureFlags,
+		ConfigFilePath:        in.ConfigFilePath,
+		KubeConfig:            in.KubeConfig,
+	}
+	if in.Resources.Disks != nil {
+		out.Resources.Disks = make([]Disk, 0, len(in.Resources.Disks))
+		for _, disk := range in.Resources.Disks {
+			out.Resources.Disks = append(out.Resources.Disks, Disk{Name: disk.Name, Size: disk.Size, VolumeMount: VolumeMount(disk.VolumeMount)})
+		}
+	}
+	if in.Environments != nil {
+		out.Environments = make(map[string]Environment, len(in.Environments))
+		for name, env := range in.Environments {
+			out.Environments[name] = Environment{
+				ImageTag:                 env.ImageTag,
+				AppendCurrentEnvironment: env.AppendCurrentEnvironment,
+				Packages:                 env.Packages,
+				PrivateRegistry:          env.PrivateRegistry,
+				Credentials:              RepositoryCredentials(env.Credentials),
+				EnvFiles:                 env.EnvFiles,
+				Resources:                StepResources(env.Resources),
+			}
+		}
+	}
+	if in.DataSets != nil {
+		out.DataSets = make([]DataSet, 0, len(in.DataSets))
+		for _, dataSet := range in.DataSets {
+			out.DataSets = append(out.DataSets, DataSet(dataSet))
+		}
+	}
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]Parameter, len(in.Parameters))
+		for name, parameter := range in.Parameters {
+			out.Parameters[name] = Parameter(parameter)
+		}
+	}
+	if in.Runs != nil {
+		out.Runs = make(map[string]Run, len(in.Runs))
+		for name, run := range in.Runs {
+			out.Runs[name] = Run(run)
+		}
+	}
+	return out
+}
+
+// ConvertFromHub converts the hub types to a v1alpha1 SAME file.
+func (V1Alpha1) ConvertFromHub(in *SameSpec) *v1alpha1.SameSpec {
+	out := &v1alpha1.SameSpec{
+		APIVersion: in.APIVersion,
+		Version:    in.Version,
+		Bases:      in.Bases,
+		Metadata:   v1alpha1.Metadata(in.Metadata),
+		EnvFiles:   in.EnvFiles,
+		Resources: v1alpha1.Resource{
+			Provider:          in.Resources.Provider,
+			ClusterProfile:    in.Resources.ClusterProfile,
+			NodePoolName:      in.Resources.NodePoolName,
+			CreateNewNodePool: in.Resources.CreateNewNodePool,
+			Cores:             v1alpha1.Cores(in.Resources.Cores),
+			GPU:               v1alpha1.GPU(in.Resources.GPU),
+		},
+		Workflow: v1alpha1.Workflow{
+			Type:       in.Workflow.Type,
+			Parameters: v1alpha1.Kubeflow(in.Workflow.Parameters),
+		},
+		Pipeline:              v1alpha1.Pipeline(in.Pipeline),
+		ImportMap:             in.ImportMap,
+		ContextStorage:        v1alpha1.ContextStorage(in.ContextStorage),
+		Run:                   v1alpha1.Run(in.Run),
+		DebuggingFeatureFlags: in.DebuggingFeatureFlags,
+		ConfigFilePath:        in.ConfigFilePath,
+		KubeConfig:            in.KubeConfig,
+	}
+	if in.Resources.Disks != nil {
+		out.Resources.Disks = make([]v1alpha1.Disk, 0, len(in.Resources.Disks))
+		for _, disk := range in.Resources.Disks {
+			out.Resources.Disks = append(out.Resources.Disks, v1alpha1.Disk{Name: disk.Name, Size: disk.Size, VolumeMount: v1alpha1.VolumeMount(disk.VolumeMount)})
+		}
+	}
+	if in.Environments != nil {
+		out.Environments = make(map[string]v1alpha1.Environment, len(in.Environments))
+		for name, env := range in.Environments {
+			out.Environments[name] = v1alpha1.Environment{
+				ImageTag:                 env.ImageTag,
+				AppendCurrentEnvironment: env.AppendCurrentEnvironment,
+				Packages:                 env.Packages,
+				PrivateRegistry:          env.PrivateRegistry,
+				Credentials:              v1alpha1.RepositoryCredentials(env.Credentials),
+				EnvFiles:                 env.EnvFiles,
+				Resources:                v1alpha1.StepResources(env.Resources),
+			}
+		}
+	}
+	if in.DataSets != nil {
+		out.DataSets = make([]v1alpha1.DataSet, 0, len(in.DataSets))
+		for _, dataSet := range in.DataSets {
+			out.DataSets = append(out.DataSets, v1alpha1.DataSet(dataSet))
+		}
+	}
+	if in.Parameters != nil {
+		out.Parameters = make(map[string]v1alpha1.Parameter, len(in.Parameters))
+		for name, parameter := range in.Parameters {
+			out.Parameters[name] = v1alpha1.Parameter(parameter)
+		}
+	}
+	if in.Runs != nil {
+		out.Runs = make(map[string]v1alpha1.Run, len(in.Runs))
+		for name, run := range in.Runs {
+			out.Runs[name] = v1alpha1.Run(run)
+		}
+	}
+	return out
+}
+
+// remarshal round trips an unmarshalled yaml document into a typed struct.
+func remarshal(in interface{}, out interface{}) error {
+	bytes, err := yaml.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("could not marshal input file into bytes: %v", err)
+	}
+	if err := yaml.Unmarshal(bytes, out); err != nil {
+		return fmt.Errorf("could not unpack same configuration file: %v", err)
+	}
+	return nil
+}
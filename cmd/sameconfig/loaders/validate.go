@@ -0,0 +1,355 @@
+package loaders
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// ValidationError is a single problem found in a SAME file, with the position it was found at.
+type ValidationError struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	location := fmt.Sprintf("%v:%v", e.Line, e.Column)
+	if e.File != "" {
+		location = fmt.Sprintf("%v:%v", e.File, location)
+	}
+	if e.Path == "" {
+		return fmt.Sprintf("%v: %v", location, e.Message)
+	}
+	return fmt.Sprintf("%v: %v: %v", location, e.Path, e.Message)
+}
+
+// ValidationErrors is every problem found in a SAME file, in file order.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// ValidateSAME reads the SAME file at configFilePath, along with any bases it layers over, and checks each of
+// them against the schema for its apiVersion. Since a base or an overlay is rarely complete on its own,
+// required fields are only checked once the layers have been merged. It returns ValidationErrors if the
+// files are readable but invalid. params and profile are used to interpolate ${param:...} references, as in
+// ComposeSAME.
+func ValidateSAME(configFilePath string, params map[string]string, profile string) error {
+	log.Trace("- In Root.ValidateSAME")
+	layers, err := loadInterpolatedLayers(configFilePath, params, profile)
+	if err != nil {
+		return err
+	}
+
+	errs := ValidationErrors{}
+	for _, layer := range layers {
+		fileName := layer.Location
+		if len(layers) == 1 {
+			fileName = configFilePath
+		}
+		errs = append(errs, validateNode(fileName, layer.Document, len(layers) == 1)...)
+	}
+
+	if len(errs) == 0 && len(layers) > 1 {
+		composed, err := mergeLayers(layers)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, validateComposedRequired(configFilePath, composed)...)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateSAMEBytes checks the contents of a SAME file against the schema for its apiVersion. fileName is
+// only used to label the errors.
+func ValidateSAMEBytes(fileName string, configFileBytes []byte) ValidationErrors {
+	return validateDocument(fileName, configFileBytes, true)
+}
+
+// validateComposedRequired checks the required fields of a SAME file after its bases have been merged in.
+func validateComposedRequired(fileName string, composed map[string]interface{}) ValidationErrors {
+	apiVersion := DefaultAPIVersion
+	if value, ok := composed["apiVersion"].(string); ok && value != "" {
+		apiVersion = value
+	}
+	schema, ok := SchemaForAPIVersion(apiVersion)
+	if !ok {
+		return ValidationErrors{{File: fileName, Line: 1, Column: 1, Path: "apiVersion", Message: fmt.Sprintf("unsupported apiVersion '%v', expected one of: %v", apiVersion, strings.Join(SupportedAPIVersions(), ", "))}}
+	}
+
+	errs := ValidationErrors{}
+	for _, required := range requiredPaths(schema, "") {
+		var value interface{} = composed
+		for _, segment := range strings.Split(required, ".") {
+			parent, _ := value.(map[string]interface{})
+			value = parent[segment]
+		}
+		if value == nil {
+			errs = append(errs, ValidationError{File: fileName, Line: 1, Column: 1, Path: required, Message: "required field is missing from the file and all of its bases"})
+		}
+	}
+	return errs
+}
+
+func validateDocument(fileName string, configFileBytes []byte, checkRequired bool) ValidationErrors {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(configFileBytes, &doc); err != nil {
+		return ValidationErrors{{File: fileName, Line: 1, Column: 1, Message: fmt.Sprintf("invalid yaml: %v", err)}}
+	}
+	return validateNode(fileName, &doc, checkRequired)
+}
+
+// validateNode checks a parsed SAME file against the schema for its apiVersion, reporting errors at the
+// positions the nodes were parsed from.
+func validateNode(fileName string, doc *yamlv3.Node, checkRequired bool) ValidationErrors {
+	v := &validator{file: fileName, checkRequired: checkRequired}
+	if len(doc.Content) == 0 {
+		v.errorAt(doc, "", "file is empty")
+		return v.errs
+	}
+
+	root := resolveAlias(doc.Content[0])
+	if root.Kind != yamlv3.MappingNode {
+		v.errorAt(root, "", "expected a mapping at the top level of the file")
+		return v.errs
+	}
+
+	apiVersion := DefaultAPIVersion
+	if apiVersionNode := mappingValue(root, "apiVersion"); apiVersionNode != nil {
+		apiVersion = apiVersionNode.Value
+	}
+
+	schema, ok := SchemaForAPIVersion(apiVersion)
+	if !ok {
+		v.errorAt(mappingValue(root, "apiVersion"), "apiVersion", fmt.Sprintf("unsupported apiVersion '%v', expected one of: %v", apiVersion, strings.Join(SupportedAPIVersions(), ", ")))
+		return v.errs
+	}
+
+	v.validate(root, schema, "")
+	sort.SliceStable(v.errs, func(i, j int) bool {
+		if v.errs[i].Line != v.errs[j].Line {
+			return v.errs[i].Line < v.errs[j].Line
+		}
+		return v.errs[i].Column < v.errs[j].Column
+	})
+	return v.errs
+}
+
+type validator struct {
+	file          string
+	checkRequired bool
+	errs          ValidationErrors
+}
+
+func (v *validator) errorAt(node *yamlv3.Node, path string, message string) {
+	line, column := 1, 1
+	if node != nil {
+		line, column = node.Line, node.Column
+	}
+	v.errs = append(v.errs, ValidationError{File: v.file, Line: line, Column: column, Path: path, Message: message})
+}
+
+func (v *validator) validate(node *yamlv3.Node, schema *Schema, path string) {
+	node = resolveAlias(node)
+	if schema.Kind == KindAny || isNull(node) {
+		return
+	}
+
+	switch schema.Kind {
+	case KindObject:
+		if node.Kind != yamlv3.MappingNode {
+			v.errorAt(node, path, fmt.Sprintf("expected an object, found %v", describeNode(node)))
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldSchema, ok := schema.Fields[key.Value]
+			if !ok {
+				v.errorAt(key, joinPath(path, key.Value), unknownFieldMessage(key.Value, schema.Fields))
+				continue
+			}
+			v.validate(value, fieldSchema, joinPath(path, key.Value))
+		}
+		for _, required := range requiredPaths(schema, "") {
+			if v.checkRequired && !hasPath(node, required) {
+				v.errorAt(node, joinPath(path, required), "required field is missing")
+			}
+		}
+	case KindMap:
+		if node.Kind != yamlv3.MappingNode {
+			v.errorAt(node, path, fmt.Sprintf("expected a map, found %v", describeNode(node)))
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v.validate(node.Content[i+1], schema.Elem, joinPath(path, node.Content[i].Value))
+		}
+	case KindList:
+		if node.Kind != yamlv3.SequenceNode {
+			v.errorAt(node, path, fmt.Sprintf("expected a list, found %v", describeNode(node)))
+			return
+		}
+		for i, item := range node.Content {
+			v.validate(item, schema.Elem, fmt.Sprintf("%v[%v]", path, i))
+		}
+	default:
+		if node.Kind != yamlv3.ScalarNode {
+			v.errorAt(node, path, fmt.Sprintf("expected a %v, found %v", schema.Kind, describeNode(node)))
+			return
+		}
+		if !scalarMatches(node, schema.Kind) {
+			v.errorAt(node, path, fmt.Sprintf("expected a %v, found '%v'", schema.Kind, node.Value))
+		}
+	}
+}
+
+func scalarMatches(node *yamlv3.Node, kind SchemaKind) bool {
+	tag := node.ShortTag()
+	switch kind {
+	case KindString:
+		// The loader accepts any scalar into a string field (e.g. 'version: 1.2')
+		return true
+	case KindInt:
+		return tag == "!!int"
+	case KindFloat:
+		return tag == "!!int" || tag == "!!float"
+	case KindBool:
+		if tag == "!!bool" {
+			return true
+		}
+		// yaml.v2 (used by the loader) still treats the YAML 1.1 booleans as bools
+		switch strings.ToLower(node.Value) {
+		case "yes", "no", "on", "off", "y", "n":
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+// requiredPaths returns the required fields of an object that have to be checked from its own node. Required
+// fields of child objects are included so that they are still reported when the whole child is missing.
+func requiredPaths(schema *Schema, prefix string) []string {
+	paths := make([]string, 0)
+	for _, required := range schema.Required {
+		paths = append(paths, joinPath(prefix, required))
+	}
+	for _, name := range sortedKeys(schema.Fields) {
+		if child := schema.Fields[name]; child.Kind == KindObject {
+			for _, childPath := range requiredPaths(child, name) {
+				paths = append(paths, joinPath(prefix, childPath))
+			}
+		}
+	}
+	return paths
+}
+
+// hasPath reports whether the first segment of a dotted path is set in a mapping node. Anything
+// deeper is checked when the child node itself is validated.
+func hasPath(node *yamlv3.Node, path string) bool {
+	value := mappingValue(node, strings.SplitN(path, ".", 2)[0])
+	return value != nil && !isNull(value)
+}
+
+func resolveAlias(node *yamlv3.Node) *yamlv3.Node {
+	for node != nil && node.Kind == yamlv3.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+func isNull(node *yamlv3.Node) bool {
+	return node.Kind == yamlv3.ScalarNode && node.ShortTag() == "!!null"
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return resolveAlias(node.Content[i+1])
+		}
+	}
+	return nil
+}
+
+func describeNode(node *yamlv3.Node) string {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		return "an object"
+	case yamlv3.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("'%v'", node.Value)
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// unknownFieldMessage suggests the closest known field name, since most unknown fields are typos.
+func unknownFieldMessage(key string, fields map[string]*Schema) string {
+	closest, closestDistance := "", -1
+	for _, candidate := range sortedKeys(fields) {
+		distance := editDistance(strings.ToLower(key), strings.ToLower(candidate))
+		if closestDistance == -1 || distance < closestDistance {
+			closest, closestDistance = candidate, distance
+		}
+	}
+	if closestDistance >= 0 && closestDistance <= 2 {
+		return fmt.Sprintf("unknown field, did you mean '%v'?", closest)
+	}
+	return fmt.Sprintf("unknown field, expected one of: %v", strings.Join(sortedKeys(fields), ", "))
+}
+
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,9 +2,13 @@ package box
 
 // Code generated by go generate; DO NOT EDIT.
 func init() {
-	box.Add("/aml/root.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 105, 109, 112, 111, 114, 116, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 10, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 87, 111, 114, 107, 115, 112, 97, 99, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 97, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 32, 105, 109, 112, 111, 114, 116, 32, 83, 101, 114, 118, 105, 99, 101, 80, 114, 105, 110, 99, 105, 112, 97, 108, 65, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 99, 111, 109, 112, 117, 116, 101, 32, 105, 109, 112, 111, 114, 116, 32, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 44, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 114, 117, 110, 99, 111, 110, 102, 105, 103, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 67, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 99, 111, 110, 100, 97, 95, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 67, 111, 110, 100, 97, 68, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 80, 105, 112, 101, 108, 105, 110, 101, 44, 32, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 44, 32, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 115, 116, 101, 112, 115, 32, 105, 109, 112, 111, 114, 116, 32, 80, 121, 116, 104, 111, 110, 83, 99, 114, 105, 112, 116, 83, 116, 101, 112, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 44, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 44, 32, 68, 97, 116, 97, 115, 116, 111, 114, 101, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 40, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 41, 58, 10, 9, 115, 118, 99, 95, 112, 114, 95, 112, 97, 115, 115, 119, 111, 114, 100, 32, 61, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 41, 10, 10, 9, 115, 118, 99, 95, 112, 114, 32, 61, 32, 83, 101, 114, 118, 105, 99, 101, 80, 114, 105, 110, 99, 105, 112, 97, 108, 65, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 40, 10, 9, 9, 116, 101, 110, 97, 110, 116, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 41, 44, 10, 9, 9, 115, 101, 114, 118, 105, 99, 101, 95, 112, 114, 105, 110, 99, 105, 112, 97, 108, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 41, 44, 10, 9, 9, 115, 101, 114, 118, 105, 99, 101, 95, 112, 114, 105, 110, 99, 105, 112, 97, 108, 95, 112, 97, 115, 115, 119, 111, 114, 100, 61, 115, 118, 99, 95, 112, 114, 95, 112, 97, 115, 115, 119, 111, 114, 100, 44, 10, 9, 41, 10, 10, 9, 114, 101, 116, 117, 114, 110, 32, 87, 111, 114, 107, 115, 112, 97, 99, 101, 40, 10, 9, 9, 115, 117, 98, 115, 99, 114, 105, 112, 116, 105, 111, 110, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 41, 44, 10, 9, 9, 114, 101, 115, 111, 117, 114, 99, 101, 95, 103, 114, 111, 117, 112, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 41, 44, 10, 9, 9, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 110, 97, 109, 101, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 9, 97, 117, 116, 104, 61, 115, 118, 99, 95, 112, 114, 44, 10, 9, 41, 10, 10, 100, 101, 102, 32, 114, 111, 111, 116, 40, 10, 9, 123, 123, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 125, 125, 44, 10, 9, 99, 111, 110, 116, 101, 120, 116, 61, 34, 34, 44, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 10, 9, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 61, 123, 125, 44, 10, 41, 58, 10, 9, 35, 32, 84, 104, 101, 32, 98, 101, 108, 111, 119, 32, 105, 115, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 105, 110, 103, 32, 111, 102, 32, 97, 110, 32, 101, 109, 112, 116, 121, 32, 108, 111, 99, 97, 108, 115, 40, 41, 32, 111, 117, 116, 112, 117, 116, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 34, 10, 9, 105, 102, 32, 99, 111, 110, 116, 101, 120, 116, 32, 61, 61, 32, 39, 39, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 101, 108, 115, 101, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 10, 10, 10, 9, 101, 120, 112, 101, 99, 116, 101, 100, 95, 102, 105, 101, 108, 100, 115, 32, 61, 32, 91, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 44, 10, 9, 93, 10, 10, 9, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 32, 61, 32, 91, 10, 9, 9, 102, 105, 101, 108, 100, 10, 9, 9, 102, 111, 114, 32, 102, 105, 101, 108, 100, 32, 105, 110, 32, 101, 120, 112, 101, 99, 116, 101, 100, 95, 102, 105, 101, 108, 100, 115, 10, 9, 9, 105, 102, 32, 110, 111, 116, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 102, 105, 101, 108, 100, 44, 32, 78, 111, 110, 101, 41, 10, 9, 93, 10, 9, 105, 102, 32, 108, 101, 110, 40, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 41, 32, 62, 32, 48, 58, 10, 9, 9, 114, 97, 105, 115, 101, 32, 86, 97, 108, 117, 101, 69, 114, 114, 111, 114, 40, 10, 9, 9, 9, 102, 34, 77, 105, 115, 115, 105, 110, 103, 32, 101, 120, 112, 101, 99, 116, 101, 100, 32, 102, 105, 101, 108, 100, 115, 32, 105, 110, 32, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 32, 100, 105, 99, 116, 105, 111, 110, 97, 114, 121, 58, 32, 123, 39, 44, 39, 46, 106, 111, 105, 110, 40, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 41, 125, 34, 10, 9, 9, 41, 10, 10, 9, 119, 115, 32, 61, 32, 103, 101, 116, 95, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 40, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 41, 10, 9, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 32, 61, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 40, 119, 115, 44, 32, 34, 123, 123, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 78, 97, 109, 101, 32, 125, 125, 34, 41, 10, 10, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 46, 105, 100, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 115, 97, 109, 101, 95, 115, 116, 101, 112, 95, 48, 34, 44, 10, 9, 125, 10, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 123, 125, 10, 9, 111, 117, 116, 112, 117, 116, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 32, 61, 32, 115, 116, 114, 40, 10, 9, 9, 98, 97, 115, 101, 54, 52, 46, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 10, 9, 41, 10, 10, 123, 37, 32, 102, 111, 114, 32, 101, 110, 118, 95, 110, 97, 109, 101, 44, 32, 101, 110, 118, 32, 105, 110, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 115, 32, 37, 125, 10, 9, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 32, 61, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 41, 10, 9, 118, 109, 95, 115, 105, 122, 101, 32, 61, 32, 34, 83, 84, 65, 78, 68, 65, 82, 68, 95, 78, 67, 54, 34, 10, 9, 105, 102, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 32, 105, 110, 32, 119, 115, 46, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 115, 58, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 61, 32, 119, 115, 46, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 115, 91, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 93, 10, 9, 9, 105, 102, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 97, 110, 100, 32, 116, 121, 112, 101, 40, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 41, 32, 105, 115, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 58, 32, 34, 32, 43, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 41, 10, 9, 101, 108, 115, 101, 58, 10, 9, 9, 112, 114, 105, 110, 116, 40, 34, 67, 114, 101, 97, 116, 105, 110, 103, 32, 97, 32, 110, 101, 119, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 46, 46, 46, 34, 41, 10, 9, 9, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 32, 61, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 46, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 40, 10, 9, 9, 9, 118, 109, 95, 115, 105, 122, 101, 61, 118, 109, 95, 115, 105, 122, 101, 44, 32, 109, 105, 110, 95, 110, 111, 100, 101, 115, 61, 48, 44, 32, 109, 97, 120, 95, 110, 111, 100, 101, 115, 61, 52, 32, 32, 35, 32, 83, 84, 65, 78, 68, 65, 82, 68, 95, 78, 67, 54, 32, 105, 115, 32, 71, 80, 85, 45, 101, 110, 97, 98, 108, 101, 100, 10, 9, 9, 41, 10, 9, 9, 35, 32, 99, 114, 101, 97, 116, 101, 32, 116, 104, 101, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 61, 32, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 46, 99, 114, 101, 97, 116, 101, 40, 119, 115, 44, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 44, 32, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 41, 10, 10, 9, 9, 35, 32, 67, 97, 110, 32, 112, 111, 108, 108, 32, 102, 111, 114, 32, 97, 32, 109, 105, 110, 105, 109, 117, 109, 32, 110, 117, 109, 98, 101, 114, 32, 111, 102, 32, 110, 111, 100, 101, 115, 32, 97, 110, 100, 32, 102, 111, 114, 32, 97, 32, 115, 112, 101, 99, 105, 102, 105, 99, 32, 116, 105, 109, 101, 111, 117, 116, 46, 10, 9, 9, 35, 32, 73, 102, 32, 110, 111, 32, 109, 105, 110, 32, 110, 111, 100, 101, 32, 99, 111, 117, 110, 116, 32, 105, 115, 32, 112, 114, 111, 118, 105, 100, 101, 100, 32, 105, 116, 32, 119, 105, 108, 108, 32, 117, 115, 101, 32, 116, 104, 101, 32, 115, 99, 97, 108, 101, 32, 115, 101, 116, 116, 105, 110, 103, 115, 32, 102, 111, 114, 32, 116, 104, 101, 32, 99, 108, 117, 115, 116, 101, 114, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 46, 119, 97, 105, 116, 95, 102, 111, 114, 95, 99, 111, 109, 112, 108, 101, 116, 105, 111, 110, 40, 10, 9, 9, 9, 115, 104, 111, 119, 95, 111, 117, 116, 112, 117, 116, 61, 84, 114, 117, 101, 44, 32, 109, 105, 110, 95, 110, 111, 100, 101, 95, 99, 111, 117, 110, 116, 61, 78, 111, 110, 101, 44, 32, 116, 105, 109, 101, 111, 117, 116, 95, 105, 110, 95, 109, 105, 110, 117, 116, 101, 115, 61, 50, 48, 10, 9, 9, 41, 10, 10, 9, 9, 35, 32, 70, 111, 114, 32, 97, 32, 109, 111, 114, 101, 32, 100, 101, 116, 97, 105, 108, 101, 100, 32, 118, 105, 101, 119, 32, 111, 102, 32, 99, 117, 114, 114, 101, 110, 116, 32, 99, 108, 117, 115, 116, 101, 114, 32, 115, 116, 97, 116, 117, 115, 44, 32, 117, 115, 101, 32, 116, 104, 101, 32, 39, 115, 116, 97, 116, 117, 115, 39, 32, 112, 114, 111, 112, 101, 114, 116, 121, 10, 9, 9, 112, 114, 105, 110, 116, 40, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 46, 115, 116, 97, 116, 117, 115, 46, 115, 101, 114, 105, 97, 108, 105, 122, 101, 40, 41, 41, 10, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 32, 61, 32, 82, 117, 110, 67, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 40, 41, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 116, 97, 114, 103, 101, 116, 32, 61, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 32, 61, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 40, 110, 97, 109, 101, 61, 34, 67, 79, 77, 80, 85, 84, 69, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 34, 41, 10, 10, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 32, 61, 32, 67, 111, 110, 100, 97, 68, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 40, 41, 10, 10, 9, 97, 108, 108, 95, 112, 97, 99, 107, 97, 103, 101, 115, 32, 61, 32, 91, 34, 100, 105, 108, 108, 34, 44, 34, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 34, 44, 34, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 34, 44, 123, 123, 80, 97, 99, 107, 97, 103, 101, 83, 116, 114, 105, 110, 103, 125, 125, 93, 10, 9, 102, 111, 114, 32, 112, 97, 99, 107, 97, 103, 101, 32, 105, 110, 32, 97, 108, 108, 95, 112, 97, 99, 107, 97, 103, 101, 115, 58, 10, 9, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 46, 97, 100, 100, 95, 112, 105, 112, 95, 112, 97, 99, 107, 97, 103, 101, 40, 112, 97, 99, 107, 97, 103, 101, 41, 10, 10, 123, 37, 32, 105, 102, 32, 101, 110, 118, 46, 80, 114, 105, 118, 97, 116, 101, 82, 101, 103, 105, 115, 116, 114, 121, 32, 37, 125, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 101, 110, 97, 98, 108, 101, 100, 32, 61, 32, 84, 114, 117, 101, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 73, 109, 97, 103, 101, 84, 97, 103, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 97, 100, 100, 114, 101, 115, 115, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 83, 101, 114, 118, 101, 114, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 117, 115, 101, 114, 110, 97, 109, 101, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 85, 115, 101, 114, 110, 97, 109, 101, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 112, 97, 115, 115, 119, 111, 114, 100, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 80, 97, 115, 115, 119, 111, 114, 100, 32, 125, 125, 34, 10, 10, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 46, 97, 100, 100, 95, 112, 105, 112, 95, 112, 97, 99, 107, 97, 103, 101, 40, 34, 97, 122, 117, 114, 101, 109, 108, 45, 100, 101, 102, 97, 117, 108, 116, 115, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 10, 10, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 112, 121, 116, 104, 111, 110, 46, 99, 111, 110, 100, 97, 95, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 32, 61, 32, 99, 111, 110, 100, 97, 95, 100, 101, 112, 10, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 114, 97, 109, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 40, 10, 9, 9, 110, 97, 109, 101, 61, 34, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 100, 101, 102, 97, 117, 108, 116, 95, 118, 97, 108, 117, 101, 61, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 10, 9, 41, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 9, 101, 110, 116, 114, 121, 95, 112, 111, 105, 110, 116, 32, 61, 32, 34, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 112, 121, 34, 10, 9, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 40, 10, 9, 9, 34, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 111, 117, 116, 112, 117, 116, 95, 109, 111, 100, 101, 61, 34, 109, 111, 117, 110, 116, 34, 10, 9, 41, 10, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 115, 116, 101, 112, 32, 61, 32, 80, 121, 116, 104, 111, 110, 83, 99, 114, 105, 112, 116, 83, 116, 101, 112, 40, 10, 9, 9, 115, 111, 117, 114, 99, 101, 95, 100, 105, 114, 101, 99, 116, 111, 114, 121, 61, 34, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 115, 99, 114, 105, 112, 116, 95, 110, 97, 109, 101, 61, 101, 110, 116, 114, 121, 95, 112, 111, 105, 110, 116, 44, 10, 9, 9, 97, 114, 103, 117, 109, 101, 110, 116, 115, 61, 91, 10, 9, 9, 9, 34, 45, 45, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 10, 9, 9, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 32, 37, 125, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 125, 125, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 114, 97, 109, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 44, 10, 9, 9, 9, 34, 45, 45, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 10, 9, 9, 9, 111, 117, 116, 112, 117, 116, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 44, 10, 9, 9, 9, 34, 45, 45, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 34, 44, 10, 9, 9, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 9, 9, 34, 45, 45, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 10, 9, 9, 9, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 44, 10, 9, 9, 93, 44, 10, 10, 9, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 32, 37, 125, 105, 110, 112, 117, 116, 115, 61, 91, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 125, 125, 93, 44, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 9, 111, 117, 116, 112, 117, 116, 115, 61, 91, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 93, 44, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 61, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 44, 10, 9, 9, 114, 117, 110, 99, 111, 110, 102, 105, 103, 61, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 125, 125, 44, 10, 9, 9, 97, 108, 108, 111, 119, 95, 114, 101, 117, 115, 101, 61, 70, 97, 108, 115, 101, 44, 10, 9, 9, 41, 10, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 9, 114, 117, 110, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 100, 101, 102, 105, 110, 105, 116, 105, 111, 110, 32, 61, 32, 91, 123, 123, 83, 116, 101, 112, 83, 116, 114, 105, 110, 103, 125, 125, 93, 10, 10, 9, 98, 117, 105, 108, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 40, 119, 111, 114, 107, 115, 112, 97, 99, 101, 61, 119, 115, 44, 32, 115, 116, 101, 112, 115, 61, 91, 114, 117, 110, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 100, 101, 102, 105, 110, 105, 116, 105, 111, 110, 93, 41, 10, 9, 112, 105, 112, 101, 108, 105, 110, 101, 95, 114, 117, 110, 32, 61, 32, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 46, 115, 117, 98, 109, 105, 116, 40, 98, 117, 105, 108, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 41, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 9, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 125, 10, 10, 9, 35, 32, 101, 120, 101, 99, 117, 116, 101, 32, 111, 110, 108, 121, 32, 105, 102, 32, 114, 117, 110, 32, 97, 115, 32, 97, 32, 115, 99, 114, 105, 112, 116, 10, 9, 114, 111, 111, 116, 40, 10, 9, 9, 99, 111, 110, 116, 101, 120, 116, 61, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 61, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 95, 100, 105, 99, 116, 10, 9, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
-	box.Add("/aml/step.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 32, 97, 115, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 10, 102, 114, 111, 109, 32, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 110, 116, 101, 120, 116, 10, 105, 109, 112, 111, 114, 116, 32, 112, 97, 116, 104, 108, 105, 98, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 10, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 32, 97, 115, 32, 95, 95, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 44, 10, 9, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 32, 97, 115, 32, 95, 95, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 44, 10, 41, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 44, 10, 41, 10, 10, 100, 101, 102, 32, 109, 97, 105, 110, 40, 123, 123, 32, 80, 97, 114, 97, 109, 101, 116, 101, 114, 95, 83, 116, 114, 105, 110, 103, 32, 125, 125, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 39, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 39, 44, 91, 40, 39, 99, 111, 110, 116, 101, 120, 116, 39, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 9, 102, 114, 111, 109, 32, 99, 111, 112, 121, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 112, 121, 32, 97, 115, 32, 95, 95, 99, 111, 112, 121, 10, 9, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 32, 97, 115, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 105, 109, 112, 111, 114, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 10, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 32, 61, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 41, 10, 10, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 32, 61, 32, 123, 125, 10, 9, 95, 95, 108, 111, 99, 32, 61, 32, 123, 125, 10, 10, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 95, 95, 107, 93, 41, 10, 10, 9, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 105, 110, 112, 117, 116, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 125, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 95, 95, 101, 114, 114, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 95, 95, 101, 114, 114, 125, 34, 41, 10, 10, 9, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 32, 61, 32, 34, 34, 34, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 10, 123, 123, 32, 73, 110, 110, 101, 114, 95, 67, 111, 100, 101, 32, 125, 125, 10, 10, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 108, 111, 99, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 123, 125, 10, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 32, 97, 110, 100, 32, 110, 111, 116, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 118, 97, 108, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 35, 32, 76, 111, 99, 97, 108, 115, 32, 110, 101, 101, 100, 115, 32, 116, 111, 32, 99, 111, 109, 101, 32, 97, 102, 116, 101, 114, 32, 103, 108, 111, 98, 97, 108, 115, 32, 105, 110, 32, 99, 97, 115, 101, 32, 119, 101, 32, 109, 97, 100, 101, 32, 99, 104, 97, 110, 103, 101, 115, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 32, 97, 110, 100, 32, 110, 111, 116, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 118, 97, 108, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 115, 116, 114, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 34, 34, 34, 10, 9, 101, 120, 101, 99, 40, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 44, 32, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 44, 32, 95, 95, 108, 111, 99, 41, 10, 10, 9, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 37, 118, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 111, 117, 116, 112, 117, 116, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 108, 111, 99, 91, 34, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 34, 93, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 125, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 101, 114, 114, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 101, 114, 114, 125, 34, 41, 10, 10, 9, 102, 114, 111, 109, 32, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 32, 105, 109, 112, 111, 114, 116, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 40, 34, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 34, 99, 111, 110, 116, 101, 120, 116, 34, 93, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 111, 117, 116, 112, 117, 116, 40, 95, 95, 108, 111, 99, 91, 34, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 34, 93, 41, 10, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 9, 95, 95, 114, 117, 110, 32, 61, 32, 82, 117, 110, 46, 103, 101, 116, 95, 99, 111, 110, 116, 101, 120, 116, 40, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 32, 61, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 46, 65, 114, 103, 117, 109, 101, 110, 116, 80, 97, 114, 115, 101, 114, 40, 34, 99, 108, 101, 97, 110, 115, 101, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 67, 111, 110, 116, 101, 120, 116, 32, 116, 111, 32, 114, 117, 110, 32, 97, 115, 32, 115, 116, 114, 105, 110, 103, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 82, 117, 110, 32, 105, 110, 102, 111, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 79, 117, 116, 112, 117, 116, 32, 99, 111, 110, 116, 101, 120, 116, 32, 112, 97, 116, 104, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 34, 41, 10, 10, 9, 95, 95, 97, 114, 103, 115, 32, 61, 32, 95, 95, 112, 97, 114, 115, 101, 114, 46, 112, 97, 114, 115, 101, 95, 97, 114, 103, 115, 40, 41, 10, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 32, 61, 32, 34, 99, 111, 110, 116, 101, 120, 116, 46, 116, 120, 116, 34, 10, 9, 105, 102, 32, 34, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 34, 32, 105, 110, 32, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 58, 10, 9, 9, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 41, 32, 47, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 10, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 114, 101, 97, 100, 105, 110, 103, 32, 102, 105, 108, 101, 58, 32, 123, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 125, 34, 41, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 46, 114, 101, 97, 100, 95, 116, 101, 120, 116, 40, 41, 10, 9, 101, 108, 105, 102, 32, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 32, 97, 110, 100, 32, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 46, 115, 116, 114, 105, 112, 40, 41, 58, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 46, 115, 116, 114, 105, 112, 40, 41, 10, 10, 9, 35, 32, 78, 101, 101, 100, 32, 116, 111, 32, 117, 110, 112, 97, 99, 107, 32, 97, 110, 100, 32, 100, 111, 32, 116, 104, 105, 115, 32, 104, 101, 114, 101, 44, 32, 98, 101, 99, 97, 117, 115, 101, 32, 65, 77, 76, 32, 111, 110, 108, 121, 32, 103, 105, 118, 101, 115, 10, 9, 35, 32, 117, 115, 32, 116, 104, 101, 32, 114, 117, 110, 32, 105, 100, 32, 105, 110, 115, 105, 100, 101, 32, 116, 104, 101, 32, 99, 111, 110, 116, 97, 105, 110, 101, 114, 46, 32, 85, 110, 112, 97, 99, 107, 105, 110, 103, 32, 97, 110, 100, 32, 114, 101, 112, 97, 99, 107, 105, 110, 103, 32, 115, 111, 10, 9, 35, 32, 98, 117, 108, 107, 32, 111, 102, 32, 116, 104, 101, 32, 99, 111, 100, 101, 32, 105, 115, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 46, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 97, 114, 103, 115, 46, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 32, 61, 32, 95, 95, 114, 117, 110, 46, 103, 101, 116, 95, 100, 101, 116, 97, 105, 108, 115, 40, 41, 91, 34, 114, 117, 110, 73, 100, 34, 93, 10, 10, 9, 35, 32, 82, 101, 116, 117, 114, 110, 115, 32, 97, 32, 116, 117, 112, 108, 101, 44, 32, 119, 104, 101, 114, 101, 32, 116, 104, 101, 32, 122, 101, 114, 111, 116, 104, 32, 105, 110, 100, 101, 120, 32, 105, 115, 32, 116, 104, 101, 32, 115, 116, 114, 105, 110, 103, 10, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 116, 117, 112, 108, 101, 32, 61, 32, 109, 97, 105, 110, 40, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 61, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 61, 115, 116, 114, 40, 10, 9, 9, 9, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 10, 9, 9, 41, 44, 10, 9, 9, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 95, 95, 97, 114, 103, 115, 46, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 41, 10, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 95, 95, 97, 114, 103, 115, 46, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 95, 95, 112, 46, 109, 107, 100, 105, 114, 40, 112, 97, 114, 101, 110, 116, 115, 61, 84, 114, 117, 101, 44, 32, 101, 120, 105, 115, 116, 95, 111, 107, 61, 84, 114, 117, 101, 41, 10, 9, 95, 95, 102, 105, 108, 101, 112, 97, 116, 104, 32, 61, 32, 95, 95, 112, 32, 47, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 10, 9, 119, 105, 116, 104, 32, 95, 95, 102, 105, 108, 101, 112, 97, 116, 104, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 58, 10, 9, 9, 95, 95, 102, 46, 119, 114, 105, 116, 101, 40, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 116, 117, 112, 108, 101, 91, 48, 93, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
+	box.Add("/aml/root.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 105, 109, 112, 111, 114, 116, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 10, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 87, 111, 114, 107, 115, 112, 97, 99, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 97, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 32, 105, 109, 112, 111, 114, 116, 32, 83, 101, 114, 118, 105, 99, 101, 80, 114, 105, 110, 99, 105, 112, 97, 108, 65, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 99, 111, 109, 112, 117, 116, 101, 32, 105, 109, 112, 111, 114, 116, 32, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 44, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 114, 117, 110, 99, 111, 110, 102, 105, 103, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 67, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 46, 99, 111, 110, 100, 97, 95, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 67, 111, 110, 100, 97, 68, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 80, 105, 112, 101, 108, 105, 110, 101, 44, 32, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 44, 32, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 115, 116, 101, 112, 115, 32, 105, 109, 112, 111, 114, 116, 32, 80, 121, 116, 104, 111, 110, 83, 99, 114, 105, 112, 116, 83, 116, 101, 112, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 44, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 44, 32, 68, 97, 116, 97, 115, 116, 111, 114, 101, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 40, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 41, 58, 10, 9, 115, 118, 99, 95, 112, 114, 95, 112, 97, 115, 115, 119, 111, 114, 100, 32, 61, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 41, 10, 10, 9, 115, 118, 99, 95, 112, 114, 32, 61, 32, 83, 101, 114, 118, 105, 99, 101, 80, 114, 105, 110, 99, 105, 112, 97, 108, 65, 117, 116, 104, 101, 110, 116, 105, 99, 97, 116, 105, 111, 110, 40, 10, 9, 9, 116, 101, 110, 97, 110, 116, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 41, 44, 10, 9, 9, 115, 101, 114, 118, 105, 99, 101, 95, 112, 114, 105, 110, 99, 105, 112, 97, 108, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 41, 44, 10, 9, 9, 115, 101, 114, 118, 105, 99, 101, 95, 112, 114, 105, 110, 99, 105, 112, 97, 108, 95, 112, 97, 115, 115, 119, 111, 114, 100, 61, 115, 118, 99, 95, 112, 114, 95, 112, 97, 115, 115, 119, 111, 114, 100, 44, 10, 9, 41, 10, 10, 9, 114, 101, 116, 117, 114, 110, 32, 87, 111, 114, 107, 115, 112, 97, 99, 101, 40, 10, 9, 9, 115, 117, 98, 115, 99, 114, 105, 112, 116, 105, 111, 110, 95, 105, 100, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 41, 44, 10, 9, 9, 114, 101, 115, 111, 117, 114, 99, 101, 95, 103, 114, 111, 117, 112, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 41, 44, 10, 9, 9, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 110, 97, 109, 101, 61, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 9, 97, 117, 116, 104, 61, 115, 118, 99, 95, 112, 114, 44, 10, 9, 41, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 40, 119, 115, 44, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 44, 32, 118, 109, 95, 115, 105, 122, 101, 41, 58, 10, 9, 105, 102, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 32, 105, 110, 32, 119, 115, 46, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 115, 58, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 61, 32, 119, 115, 46, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 115, 91, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 93, 10, 9, 9, 105, 102, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 97, 110, 100, 32, 116, 121, 112, 101, 40, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 41, 32, 105, 115, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 58, 32, 34, 32, 43, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 41, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 10, 10, 9, 112, 114, 105, 110, 116, 40, 34, 67, 114, 101, 97, 116, 105, 110, 103, 32, 97, 32, 110, 101, 119, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 46, 46, 46, 34, 41, 10, 9, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 32, 61, 32, 65, 109, 108, 67, 111, 109, 112, 117, 116, 101, 46, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 40, 10, 9, 9, 118, 109, 95, 115, 105, 122, 101, 61, 118, 109, 95, 115, 105, 122, 101, 44, 32, 109, 105, 110, 95, 110, 111, 100, 101, 115, 61, 48, 44, 32, 109, 97, 120, 95, 110, 111, 100, 101, 115, 61, 52, 10, 9, 41, 10, 9, 35, 32, 99, 114, 101, 97, 116, 101, 32, 116, 104, 101, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 10, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 61, 32, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 46, 99, 114, 101, 97, 116, 101, 40, 119, 115, 44, 32, 99, 111, 109, 112, 117, 116, 101, 95, 110, 97, 109, 101, 44, 32, 112, 114, 111, 118, 105, 115, 105, 111, 110, 105, 110, 103, 95, 99, 111, 110, 102, 105, 103, 41, 10, 10, 9, 35, 32, 67, 97, 110, 32, 112, 111, 108, 108, 32, 102, 111, 114, 32, 97, 32, 109, 105, 110, 105, 109, 117, 109, 32, 110, 117, 109, 98, 101, 114, 32, 111, 102, 32, 110, 111, 100, 101, 115, 32, 97, 110, 100, 32, 102, 111, 114, 32, 97, 32, 115, 112, 101, 99, 105, 102, 105, 99, 32, 116, 105, 109, 101, 111, 117, 116, 46, 10, 9, 35, 32, 73, 102, 32, 110, 111, 32, 109, 105, 110, 32, 110, 111, 100, 101, 32, 99, 111, 117, 110, 116, 32, 105, 115, 32, 112, 114, 111, 118, 105, 100, 101, 100, 32, 105, 116, 32, 119, 105, 108, 108, 32, 117, 115, 101, 32, 116, 104, 101, 32, 115, 99, 97, 108, 101, 32, 115, 101, 116, 116, 105, 110, 103, 115, 32, 102, 111, 114, 32, 116, 104, 101, 32, 99, 108, 117, 115, 116, 101, 114, 10, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 46, 119, 97, 105, 116, 95, 102, 111, 114, 95, 99, 111, 109, 112, 108, 101, 116, 105, 111, 110, 40, 10, 9, 9, 115, 104, 111, 119, 95, 111, 117, 116, 112, 117, 116, 61, 84, 114, 117, 101, 44, 32, 109, 105, 110, 95, 110, 111, 100, 101, 95, 99, 111, 117, 110, 116, 61, 78, 111, 110, 101, 44, 32, 116, 105, 109, 101, 111, 117, 116, 95, 105, 110, 95, 109, 105, 110, 117, 116, 101, 115, 61, 50, 48, 10, 9, 41, 10, 10, 9, 35, 32, 70, 111, 114, 32, 97, 32, 109, 111, 114, 101, 32, 100, 101, 116, 97, 105, 108, 101, 100, 32, 118, 105, 101, 119, 32, 111, 102, 32, 99, 117, 114, 114, 101, 110, 116, 32, 99, 108, 117, 115, 116, 101, 114, 32, 115, 116, 97, 116, 117, 115, 44, 32, 117, 115, 101, 32, 116, 104, 101, 32, 39, 115, 116, 97, 116, 117, 115, 39, 32, 112, 114, 111, 112, 101, 114, 116, 121, 10, 9, 112, 114, 105, 110, 116, 40, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 46, 115, 116, 97, 116, 117, 115, 46, 115, 101, 114, 105, 97, 108, 105, 122, 101, 40, 41, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 10, 10, 100, 101, 102, 32, 114, 111, 111, 116, 40, 10, 9, 123, 37, 32, 105, 102, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 37, 125, 123, 123, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 125, 125, 44, 10, 9, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 99, 111, 110, 116, 101, 120, 116, 61, 34, 34, 44, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 10, 9, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 61, 123, 125, 44, 10, 41, 58, 10, 9, 35, 32, 84, 104, 101, 32, 98, 101, 108, 111, 119, 32, 105, 115, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 105, 110, 103, 32, 111, 102, 32, 97, 110, 32, 101, 109, 112, 116, 121, 32, 108, 111, 99, 97, 108, 115, 40, 41, 32, 111, 117, 116, 112, 117, 116, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 34, 10, 9, 105, 102, 32, 99, 111, 110, 116, 101, 120, 116, 32, 61, 61, 32, 39, 39, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 101, 108, 115, 101, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 10, 10, 10, 9, 101, 120, 112, 101, 99, 116, 101, 100, 95, 102, 105, 101, 108, 100, 115, 32, 61, 32, 91, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 44, 10, 9, 9, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 44, 10, 9, 93, 10, 10, 9, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 32, 61, 32, 91, 10, 9, 9, 102, 105, 101, 108, 100, 10, 9, 9, 102, 111, 114, 32, 102, 105, 101, 108, 100, 32, 105, 110, 32, 101, 120, 112, 101, 99, 116, 101, 100, 95, 102, 105, 101, 108, 100, 115, 10, 9, 9, 105, 102, 32, 110, 111, 116, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 102, 105, 101, 108, 100, 44, 32, 78, 111, 110, 101, 41, 10, 9, 93, 10, 9, 105, 102, 32, 108, 101, 110, 40, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 41, 32, 62, 32, 48, 58, 10, 9, 9, 114, 97, 105, 115, 101, 32, 86, 97, 108, 117, 101, 69, 114, 114, 111, 114, 40, 10, 9, 9, 9, 102, 34, 77, 105, 115, 115, 105, 110, 103, 32, 101, 120, 112, 101, 99, 116, 101, 100, 32, 102, 105, 101, 108, 100, 115, 32, 105, 110, 32, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 32, 100, 105, 99, 116, 105, 111, 110, 97, 114, 121, 58, 32, 123, 39, 44, 39, 46, 106, 111, 105, 110, 40, 109, 105, 115, 115, 105, 110, 103, 95, 102, 105, 101, 108, 100, 115, 41, 125, 34, 10, 9, 9, 41, 10, 10, 9, 119, 115, 32, 61, 32, 103, 101, 116, 95, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 40, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 41, 10, 9, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 32, 61, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 40, 119, 115, 44, 32, 34, 123, 123, 32, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 78, 97, 109, 101, 32, 125, 125, 34, 41, 10, 10, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 46, 105, 100, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 115, 97, 109, 101, 95, 115, 116, 101, 112, 95, 48, 34, 44, 10, 9, 125, 10, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 123, 125, 10, 9, 111, 117, 116, 112, 117, 116, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 32, 61, 32, 115, 116, 114, 40, 10, 9, 9, 98, 97, 115, 101, 54, 52, 46, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 10, 9, 41, 10, 10, 9, 35, 32, 83, 84, 65, 78, 68, 65, 82, 68, 95, 78, 67, 54, 32, 105, 115, 32, 71, 80, 85, 45, 101, 110, 97, 98, 108, 101, 100, 10, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 32, 61, 32, 103, 101, 116, 95, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 40, 119, 115, 44, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 41, 44, 32, 34, 83, 84, 65, 78, 68, 65, 82, 68, 95, 78, 67, 54, 34, 41, 10, 10, 9, 35, 32, 83, 116, 101, 112, 115, 32, 116, 104, 97, 116, 32, 97, 115, 107, 32, 102, 111, 114, 32, 114, 101, 115, 111, 117, 114, 99, 101, 115, 32, 114, 117, 110, 32, 111, 110, 32, 97, 32, 99, 111, 109, 112, 117, 116, 101, 32, 116, 97, 114, 103, 101, 116, 32, 119, 105, 116, 104, 32, 97, 32, 86, 77, 32, 115, 105, 122, 101, 32, 116, 104, 97, 116, 32, 104, 97, 115, 32, 116, 104, 101, 109, 10, 123, 37, 32, 102, 111, 114, 32, 99, 111, 109, 112, 117, 116, 101, 95, 107, 101, 121, 44, 32, 99, 111, 109, 112, 117, 116, 101, 32, 105, 110, 32, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 115, 32, 115, 111, 114, 116, 101, 100, 32, 37, 125, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 95, 123, 123, 32, 99, 111, 109, 112, 117, 116, 101, 95, 107, 101, 121, 32, 125, 125, 32, 61, 32, 103, 101, 116, 95, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 40, 119, 115, 44, 32, 34, 123, 123, 32, 99, 111, 109, 112, 117, 116, 101, 46, 78, 97, 109, 101, 32, 125, 125, 34, 44, 32, 34, 123, 123, 32, 99, 111, 109, 112, 117, 116, 101, 46, 86, 77, 83, 105, 122, 101, 32, 125, 125, 34, 41, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 123, 37, 32, 102, 111, 114, 32, 101, 110, 118, 95, 110, 97, 109, 101, 44, 32, 101, 110, 118, 32, 105, 110, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 115, 32, 115, 111, 114, 116, 101, 100, 32, 37, 125, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 32, 61, 32, 82, 117, 110, 67, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 40, 41, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 116, 97, 114, 103, 101, 116, 32, 61, 32, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 32, 61, 32, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 40, 110, 97, 109, 101, 61, 34, 67, 79, 77, 80, 85, 84, 69, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 34, 41, 10, 10, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 32, 61, 32, 67, 111, 110, 100, 97, 68, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 40, 41, 10, 10, 9, 97, 108, 108, 95, 112, 97, 99, 107, 97, 103, 101, 115, 32, 61, 32, 91, 34, 100, 105, 108, 108, 34, 44, 34, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 34, 44, 34, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 34, 44, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 34, 98, 111, 116, 111, 51, 34, 44, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 93, 10, 9, 102, 111, 114, 32, 112, 97, 99, 107, 97, 103, 101, 32, 105, 110, 32, 97, 108, 108, 95, 112, 97, 99, 107, 97, 103, 101, 115, 58, 10, 9, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 46, 97, 100, 100, 95, 112, 105, 112, 95, 112, 97, 99, 107, 97, 103, 101, 40, 112, 97, 99, 107, 97, 103, 101, 41, 10, 10, 123, 37, 32, 105, 102, 32, 101, 110, 118, 46, 80, 114, 105, 118, 97, 116, 101, 82, 101, 103, 105, 115, 116, 114, 121, 32, 37, 125, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 101, 110, 97, 98, 108, 101, 100, 32, 61, 32, 84, 114, 117, 101, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 73, 109, 97, 103, 101, 84, 97, 103, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 97, 100, 100, 114, 101, 115, 115, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 83, 101, 114, 118, 101, 114, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 117, 115, 101, 114, 110, 97, 109, 101, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 85, 115, 101, 114, 110, 97, 109, 101, 32, 125, 125, 34, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 100, 111, 99, 107, 101, 114, 46, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 95, 114, 101, 103, 105, 115, 116, 114, 121, 46, 112, 97, 115, 115, 119, 111, 114, 100, 32, 61, 32, 34, 123, 123, 32, 101, 110, 118, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 46, 80, 97, 115, 115, 119, 111, 114, 100, 32, 125, 125, 34, 10, 10, 9, 99, 111, 110, 100, 97, 95, 100, 101, 112, 46, 97, 100, 100, 95, 112, 105, 112, 95, 112, 97, 99, 107, 97, 103, 101, 40, 34, 97, 122, 117, 114, 101, 109, 108, 45, 100, 101, 102, 97, 117, 108, 116, 115, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 10, 10, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 112, 121, 116, 104, 111, 110, 46, 99, 111, 110, 100, 97, 95, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 32, 61, 32, 99, 111, 110, 100, 97, 95, 100, 101, 112, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 10, 9, 35, 32, 83, 116, 101, 112, 115, 32, 115, 112, 105, 108, 108, 105, 110, 103, 32, 108, 97, 114, 103, 101, 32, 99, 111, 110, 116, 101, 120, 116, 115, 32, 116, 111, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 32, 117, 115, 101, 32, 116, 104, 101, 32, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 32, 111, 102, 32, 119, 104, 111, 101, 118, 101, 114, 32, 115, 117, 98, 109, 105, 116, 115, 32, 116, 104, 101, 32, 112, 105, 112, 101, 108, 105, 110, 101, 10, 9, 102, 111, 114, 32, 95, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 32, 105, 110, 32, 91, 34, 65, 87, 83, 95, 65, 67, 67, 69, 83, 83, 95, 75, 69, 89, 95, 73, 68, 34, 44, 32, 34, 65, 87, 83, 95, 83, 69, 67, 82, 69, 84, 95, 65, 67, 67, 69, 83, 83, 95, 75, 69, 89, 34, 93, 58, 10, 9, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 110, 97, 109, 101, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 91, 95, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 93, 32, 61, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 91, 95, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 93, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 123, 37, 32, 102, 111, 114, 32, 101, 110, 118, 95, 118, 97, 114, 32, 105, 110, 32, 69, 110, 118, 86, 97, 114, 115, 32, 37, 125, 10, 9, 123, 37, 32, 105, 102, 32, 101, 110, 118, 95, 118, 97, 114, 46, 83, 101, 99, 114, 101, 116, 78, 97, 109, 101, 32, 37, 125, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 91, 34, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 93, 32, 61, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 91, 34, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 93, 10, 9, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 99, 111, 110, 102, 105, 103, 95, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 32, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 91, 34, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 93, 32, 61, 32, 123, 123, 32, 101, 110, 118, 95, 118, 97, 114, 46, 86, 97, 108, 117, 101, 32, 125, 125, 10, 9, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 114, 97, 109, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 40, 10, 9, 9, 110, 97, 109, 101, 61, 34, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 100, 101, 102, 97, 117, 108, 116, 95, 118, 97, 108, 117, 101, 61, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 10, 9, 41, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 9, 101, 110, 116, 114, 121, 95, 112, 111, 105, 110, 116, 32, 61, 32, 34, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 112, 121, 34, 10, 9, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 40, 10, 9, 9, 34, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 111, 117, 116, 112, 117, 116, 95, 109, 111, 100, 101, 61, 34, 109, 111, 117, 110, 116, 34, 10, 9, 41, 10, 10, 9, 35, 32, 69, 97, 99, 104, 32, 115, 116, 101, 112, 32, 105, 110, 115, 116, 97, 108, 108, 115, 32, 111, 110, 108, 121, 32, 116, 104, 101, 32, 112, 97, 99, 107, 97, 103, 101, 115, 32, 105, 116, 32, 110, 101, 101, 100, 115, 44, 32, 111, 110, 32, 116, 111, 112, 32, 111, 102, 32, 105, 116, 115, 32, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 32, 61, 32, 82, 117, 110, 67, 111, 110, 102, 105, 103, 117, 114, 97, 116, 105, 111, 110, 40, 41, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 116, 97, 114, 103, 101, 116, 32, 61, 32, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 125, 125, 46, 116, 97, 114, 103, 101, 116, 10, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 32, 61, 32, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 69, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 99, 108, 111, 110, 101, 40, 34, 67, 79, 77, 80, 85, 84, 69, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 102, 111, 114, 32, 112, 97, 99, 107, 97, 103, 101, 32, 105, 110, 32, 91, 123, 123, 115, 116, 101, 112, 46, 80, 97, 99, 107, 97, 103, 101, 83, 116, 114, 105, 110, 103, 125, 125, 93, 58, 10, 9, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 101, 110, 118, 105, 114, 111, 110, 109, 101, 110, 116, 46, 112, 121, 116, 104, 111, 110, 46, 99, 111, 110, 100, 97, 95, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 46, 97, 100, 100, 95, 112, 105, 112, 95, 112, 97, 99, 107, 97, 103, 101, 40, 112, 97, 99, 107, 97, 103, 101, 41, 10, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 109, 97, 120, 95, 114, 117, 110, 95, 100, 117, 114, 97, 116, 105, 111, 110, 95, 115, 101, 99, 111, 110, 100, 115, 32, 61, 32, 123, 123, 115, 116, 101, 112, 46, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 125, 125, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 115, 116, 101, 112, 32, 61, 32, 80, 121, 116, 104, 111, 110, 83, 99, 114, 105, 112, 116, 83, 116, 101, 112, 40, 10, 9, 9, 110, 97, 109, 101, 61, 34, 123, 123, 115, 116, 101, 112, 46, 68, 105, 115, 112, 108, 97, 121, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 115, 111, 117, 114, 99, 101, 95, 100, 105, 114, 101, 99, 116, 111, 114, 121, 61, 34, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 115, 99, 114, 105, 112, 116, 95, 110, 97, 109, 101, 61, 101, 110, 116, 114, 121, 95, 112, 111, 105, 110, 116, 44, 10, 9, 9, 97, 114, 103, 117, 109, 101, 110, 116, 115, 61, 91, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 9, 9, 9, 34, 45, 45, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 10, 9, 9, 9, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 44, 10, 123, 37, 32, 101, 109, 112, 116, 121, 32, 37, 125, 9, 9, 9, 34, 45, 45, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 10, 9, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 114, 97, 109, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 9, 9, 34, 45, 45, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 10, 9, 9, 9, 111, 117, 116, 112, 117, 116, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 44, 10, 9, 9, 9, 34, 45, 45, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 34, 44, 10, 9, 9, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 9, 9, 34, 45, 45, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 10, 9, 9, 9, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 44, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 9, 9, 34, 45, 45, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 9, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 9, 93, 44, 10, 10, 9, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 105, 110, 112, 117, 116, 115, 61, 91, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 123, 37, 32, 105, 102, 32, 110, 111, 116, 32, 102, 111, 114, 108, 111, 111, 112, 46, 76, 97, 115, 116, 32, 37, 125, 44, 32, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 44, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 9, 111, 117, 116, 112, 117, 116, 115, 61, 91, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 93, 44, 10, 9, 9, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 61, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 32, 37, 125, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 95, 123, 123, 32, 115, 116, 101, 112, 46, 67, 111, 109, 112, 117, 116, 101, 84, 97, 114, 103, 101, 116, 32, 125, 125, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 99, 111, 109, 112, 117, 116, 101, 95, 116, 97, 114, 103, 101, 116, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 44, 10, 9, 9, 114, 117, 110, 99, 111, 110, 102, 105, 103, 61, 99, 111, 110, 102, 105, 103, 95, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 44, 10, 9, 9, 97, 108, 108, 111, 119, 95, 114, 101, 117, 115, 101, 61, 70, 97, 108, 115, 101, 44, 10, 9, 9, 41, 10, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 9, 114, 117, 110, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 100, 101, 102, 105, 110, 105, 116, 105, 111, 110, 32, 61, 32, 91, 123, 123, 83, 116, 101, 112, 83, 116, 114, 105, 110, 103, 125, 125, 93, 10, 10, 9, 98, 117, 105, 108, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 32, 61, 32, 80, 105, 112, 101, 108, 105, 110, 101, 40, 119, 111, 114, 107, 115, 112, 97, 99, 101, 61, 119, 115, 44, 32, 115, 116, 101, 112, 115, 61, 91, 114, 117, 110, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 100, 101, 102, 105, 110, 105, 116, 105, 111, 110, 93, 41, 10, 9, 112, 105, 112, 101, 108, 105, 110, 101, 95, 114, 117, 110, 32, 61, 32, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 46, 115, 117, 98, 109, 105, 116, 40, 98, 117, 105, 108, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 41, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 9, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 80, 65, 83, 83, 87, 79, 82, 68, 95, 86, 65, 76, 85, 69, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 84, 69, 78, 65, 78, 84, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 83, 80, 95, 65, 80, 80, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 83, 85, 66, 83, 67, 82, 73, 80, 84, 73, 79, 78, 95, 73, 68, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 82, 69, 83, 79, 85, 82, 67, 69, 95, 71, 82, 79, 85, 80, 34, 41, 44, 10, 9, 9, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 87, 79, 82, 75, 83, 80, 65, 67, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 9, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 58, 32, 111, 115, 46, 101, 110, 118, 105, 114, 111, 110, 46, 103, 101, 116, 40, 34, 65, 77, 76, 95, 67, 79, 77, 80, 85, 84, 69, 95, 78, 65, 77, 69, 34, 41, 44, 10, 9, 125, 10, 10, 9, 35, 32, 101, 120, 101, 99, 117, 116, 101, 32, 111, 110, 108, 121, 32, 105, 102, 32, 114, 117, 110, 32, 97, 115, 32, 97, 32, 115, 99, 114, 105, 112, 116, 10, 9, 114, 111, 111, 116, 40, 10, 9, 9, 99, 111, 110, 116, 101, 120, 116, 61, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 32, 97, 109, 108, 95, 119, 111, 114, 107, 115, 112, 97, 99, 101, 95, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 61, 99, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 95, 100, 105, 99, 116, 10, 9, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
+	box.Add("/aml/step.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 123, 123, 32, 83, 111, 117, 114, 99, 101, 77, 97, 112, 32, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 32, 97, 115, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 10, 102, 114, 111, 109, 32, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 110, 116, 101, 120, 116, 10, 105, 109, 112, 111, 114, 116, 32, 112, 97, 116, 104, 108, 105, 98, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 82, 117, 110, 10, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 102, 114, 111, 109, 32, 97, 122, 117, 114, 101, 109, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 46, 99, 111, 114, 101, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 32, 97, 115, 32, 95, 95, 80, 105, 112, 101, 108, 105, 110, 101, 68, 97, 116, 97, 44, 10, 9, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 32, 97, 115, 32, 95, 95, 80, 105, 112, 101, 108, 105, 110, 101, 80, 97, 114, 97, 109, 101, 116, 101, 114, 44, 10, 41, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 44, 10, 41, 10, 10, 35, 32, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 97, 114, 114, 105, 118, 101, 32, 97, 115, 32, 115, 116, 114, 105, 110, 103, 115, 32, 45, 32, 97, 110, 121, 116, 104, 105, 110, 103, 32, 116, 104, 97, 116, 32, 105, 115, 110, 39, 116, 32, 97, 32, 115, 116, 114, 105, 110, 103, 32, 119, 97, 115, 32, 74, 83, 79, 78, 32, 101, 110, 99, 111, 100, 101, 100, 32, 98, 121, 32, 83, 65, 77, 69, 10, 100, 101, 102, 32, 95, 95, 100, 101, 99, 111, 100, 101, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 40, 95, 95, 118, 97, 108, 117, 101, 44, 32, 95, 95, 116, 121, 112, 101, 41, 58, 10, 9, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 10, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 115, 116, 114, 105, 110, 103, 34, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 118, 97, 108, 117, 101, 10, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 98, 111, 111, 108, 34, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 115, 116, 114, 40, 95, 95, 118, 97, 108, 117, 101, 41, 46, 115, 116, 114, 105, 112, 40, 41, 46, 108, 111, 119, 101, 114, 40, 41, 32, 105, 110, 32, 40, 34, 116, 114, 117, 101, 34, 44, 32, 34, 49, 34, 44, 32, 34, 121, 101, 115, 34, 41, 10, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 105, 110, 116, 34, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 105, 110, 116, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 102, 108, 111, 97, 116, 34, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 108, 111, 97, 116, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 106, 115, 111, 110, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 10, 35, 32, 83, 116, 101, 112, 115, 32, 119, 105, 116, 104, 32, 115, 101, 118, 101, 114, 97, 108, 32, 112, 97, 114, 101, 110, 116, 115, 32, 103, 101, 116, 32, 97, 32, 99, 111, 110, 116, 101, 120, 116, 32, 102, 114, 111, 109, 32, 101, 97, 99, 104, 32, 111, 102, 32, 116, 104, 101, 109, 44, 32, 119, 104, 105, 99, 104, 32, 97, 114, 101, 32, 109, 101, 114, 103, 101, 100, 32, 105, 110, 32, 116, 104, 101, 32, 111, 114, 100, 101, 114, 32, 116, 104, 101, 10, 35, 32, 112, 97, 114, 101, 110, 116, 115, 32, 97, 114, 101, 32, 108, 105, 115, 116, 101, 100, 32, 105, 110, 32, 39, 100, 101, 112, 101, 110, 100, 115, 95, 111, 110, 39, 46, 32, 73, 102, 32, 112, 97, 114, 101, 110, 116, 115, 32, 100, 105, 115, 97, 103, 114, 101, 101, 32, 111, 110, 32, 97, 32, 118, 97, 114, 105, 97, 98, 108, 101, 44, 32, 116, 104, 101, 32, 108, 97, 115, 116, 32, 112, 97, 114, 101, 110, 116, 32, 108, 105, 115, 116, 101, 100, 32, 119, 105, 110, 115, 46, 10, 100, 101, 102, 32, 95, 95, 109, 101, 114, 103, 101, 95, 99, 111, 110, 116, 101, 120, 116, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 115, 41, 58, 10, 9, 105, 102, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 41, 32, 61, 61, 32, 49, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 91, 48, 93, 10, 10, 9, 95, 95, 109, 101, 114, 103, 101, 100, 32, 61, 32, 123, 125, 10, 9, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 32, 61, 32, 123, 125, 10, 9, 95, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 123, 125, 10, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 123, 125, 10, 9, 102, 111, 114, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 32, 105, 110, 32, 122, 105, 112, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 115, 41, 58, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 44, 32, 95, 95, 118, 32, 105, 110, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 41, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 61, 61, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 58, 10, 9, 9, 9, 9, 95, 95, 109, 111, 100, 117, 108, 101, 115, 46, 117, 112, 100, 97, 116, 101, 40, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 41, 41, 10, 9, 9, 9, 9, 99, 111, 110, 116, 105, 110, 117, 101, 10, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 61, 61, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 58, 10, 9, 9, 9, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 115, 112, 105, 108, 108, 101, 100, 32, 116, 111, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 32, 97, 114, 101, 32, 109, 101, 114, 103, 101, 100, 32, 116, 104, 101, 32, 115, 97, 109, 101, 32, 119, 97, 121, 44, 32, 98, 121, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 10, 9, 9, 9, 9, 102, 111, 114, 32, 95, 95, 110, 97, 109, 101, 44, 32, 95, 95, 117, 114, 105, 32, 105, 110, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 41, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 46, 112, 111, 112, 40, 95, 95, 110, 97, 109, 101, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 91, 95, 95, 110, 97, 109, 101, 93, 32, 61, 32, 95, 95, 117, 114, 105, 10, 9, 9, 9, 9, 99, 111, 110, 116, 105, 110, 117, 101, 10, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 109, 101, 114, 103, 101, 100, 32, 97, 110, 100, 32, 95, 95, 109, 101, 114, 103, 101, 100, 91, 95, 95, 107, 93, 32, 33, 61, 32, 95, 95, 118, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 86, 97, 114, 105, 97, 98, 108, 101, 32, 39, 123, 95, 95, 107, 125, 39, 32, 100, 105, 102, 102, 101, 114, 115, 32, 98, 101, 116, 119, 101, 101, 110, 32, 123, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 91, 95, 95, 107, 93, 125, 32, 97, 110, 100, 32, 123, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 125, 44, 32, 117, 115, 105, 110, 103, 32, 116, 104, 101, 32, 118, 97, 108, 117, 101, 32, 102, 114, 111, 109, 32, 123, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 125, 34, 41, 10, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 46, 112, 111, 112, 40, 95, 95, 107, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 118, 10, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 10, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 9, 105, 102, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 58, 10, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 115, 112, 105, 108, 108, 101, 100, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 115, 116, 114, 40, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 109, 101, 114, 103, 101, 100, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 35, 32, 67, 111, 110, 116, 101, 120, 116, 115, 32, 108, 97, 114, 103, 101, 114, 32, 116, 104, 97, 110, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 32, 98, 121, 116, 101, 115, 32, 97, 114, 101, 32, 116, 111, 111, 32, 108, 97, 114, 103, 101, 32, 116, 111, 32, 112, 97, 115, 115, 32, 98, 101, 116, 119, 101, 101, 110, 32, 115, 116, 101, 112, 115, 32, 105, 110, 108, 105, 110, 101, 44, 32, 115, 111, 10, 35, 32, 116, 104, 101, 105, 114, 32, 108, 97, 114, 103, 101, 115, 116, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 97, 114, 101, 32, 119, 114, 105, 116, 116, 101, 110, 32, 116, 111, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 32, 97, 110, 100, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 97, 115, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 32, 45, 32, 115, 101, 101, 32, 39, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 39, 10, 35, 32, 105, 110, 32, 116, 104, 101, 32, 83, 65, 77, 69, 32, 102, 105, 108, 101, 10, 100, 101, 102, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 58, 10, 9, 105, 109, 112, 111, 114, 116, 32, 98, 111, 116, 111, 51, 10, 10, 9, 114, 101, 116, 117, 114, 110, 32, 98, 111, 116, 111, 51, 46, 99, 108, 105, 101, 110, 116, 40, 34, 115, 51, 34, 44, 32, 101, 110, 100, 112, 111, 105, 110, 116, 95, 117, 114, 108, 61, 34, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 100, 112, 111, 105, 110, 116, 32, 125, 125, 34, 41, 10, 10, 100, 101, 102, 32, 95, 95, 114, 101, 97, 100, 95, 115, 112, 105, 108, 108, 101, 100, 40, 95, 95, 117, 114, 105, 41, 58, 10, 9, 95, 95, 98, 117, 99, 107, 101, 116, 44, 32, 95, 95, 107, 101, 121, 32, 61, 32, 95, 95, 117, 114, 105, 91, 108, 101, 110, 40, 34, 115, 51, 58, 47, 47, 34, 41, 58, 93, 46, 115, 112, 108, 105, 116, 40, 34, 47, 34, 44, 32, 49, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 46, 103, 101, 116, 95, 111, 98, 106, 101, 99, 116, 40, 66, 117, 99, 107, 101, 116, 61, 95, 95, 98, 117, 99, 107, 101, 116, 44, 32, 75, 101, 121, 61, 95, 95, 107, 101, 121, 41, 91, 34, 66, 111, 100, 121, 34, 93, 46, 114, 101, 97, 100, 40, 41, 10, 10, 100, 101, 102, 32, 95, 95, 115, 112, 105, 108, 108, 95, 99, 111, 110, 116, 101, 120, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 44, 32, 95, 95, 114, 117, 110, 95, 105, 100, 41, 58, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 10, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 32, 107, 101, 101, 112, 32, 116, 104, 101, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 32, 116, 104, 101, 121, 32, 99, 97, 109, 101, 32, 119, 105, 116, 104, 10, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 32, 61, 32, 123, 125, 10, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 108, 105, 115, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 58, 10, 9, 9, 105, 102, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 32, 97, 110, 100, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 32, 61, 61, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 58, 10, 9, 9, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 91, 95, 95, 107, 93, 10, 9, 9, 9, 100, 101, 108, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 10, 10, 9, 95, 95, 115, 105, 122, 101, 32, 61, 32, 115, 117, 109, 40, 108, 101, 110, 40, 95, 95, 118, 41, 32, 102, 111, 114, 32, 95, 95, 118, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 46, 118, 97, 108, 117, 101, 115, 40, 41, 41, 10, 9, 105, 102, 32, 95, 95, 115, 105, 122, 101, 32, 62, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 58, 10, 9, 9, 95, 95, 99, 108, 105, 101, 110, 116, 32, 61, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 10, 9, 9, 95, 95, 108, 97, 114, 103, 101, 115, 116, 95, 102, 105, 114, 115, 116, 32, 61, 32, 115, 111, 114, 116, 101, 100, 40, 10, 9, 9, 9, 91, 95, 95, 107, 32, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 105, 102, 32, 95, 95, 107, 32, 33, 61, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 44, 10, 9, 9, 9, 107, 101, 121, 61, 108, 97, 109, 98, 100, 97, 32, 95, 95, 107, 58, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 44, 10, 9, 9, 9, 114, 101, 118, 101, 114, 115, 101, 61, 84, 114, 117, 101, 44, 10, 9, 9, 41, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 97, 114, 103, 101, 115, 116, 95, 102, 105, 114, 115, 116, 58, 10, 9, 9, 9, 105, 102, 32, 95, 95, 115, 105, 122, 101, 32, 60, 61, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 58, 10, 9, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 9, 95, 95, 107, 101, 121, 32, 61, 32, 34, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 80, 97, 116, 104, 32, 37, 125, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 80, 97, 116, 104, 32, 125, 125, 47, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 34, 32, 43, 32, 102, 34, 123, 95, 95, 114, 117, 110, 95, 105, 100, 125, 47, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 47, 123, 95, 95, 107, 125, 34, 10, 9, 9, 9, 95, 95, 99, 108, 105, 101, 110, 116, 46, 112, 117, 116, 95, 111, 98, 106, 101, 99, 116, 40, 66, 117, 99, 107, 101, 116, 61, 34, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 34, 44, 32, 75, 101, 121, 61, 95, 95, 107, 101, 121, 44, 32, 66, 111, 100, 121, 61, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 83, 112, 105, 108, 108, 101, 100, 32, 39, 123, 95, 95, 107, 125, 39, 32, 40, 123, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 125, 32, 98, 121, 116, 101, 115, 41, 32, 116, 111, 32, 115, 51, 58, 47, 47, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 47, 123, 95, 95, 107, 101, 121, 125, 34, 41, 10, 9, 9, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 102, 34, 115, 51, 58, 47, 47, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 47, 123, 95, 95, 107, 101, 121, 125, 34, 10, 9, 9, 9, 95, 95, 115, 105, 122, 101, 32, 45, 61, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 46, 112, 111, 112, 40, 95, 95, 107, 41, 41, 10, 10, 9, 105, 102, 32, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 58, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 10, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 100, 101, 102, 32, 109, 97, 105, 110, 40, 123, 123, 32, 80, 97, 114, 97, 109, 101, 116, 101, 114, 95, 83, 116, 114, 105, 110, 103, 32, 125, 125, 44, 32, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 61, 78, 111, 110, 101, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 39, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 39, 44, 91, 40, 39, 99, 111, 110, 116, 101, 120, 116, 39, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 9, 102, 114, 111, 109, 32, 99, 111, 112, 121, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 112, 121, 32, 97, 115, 32, 95, 95, 99, 111, 112, 121, 10, 9, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 9, 105, 109, 112, 111, 114, 116, 32, 105, 109, 112, 111, 114, 116, 108, 105, 98, 32, 97, 115, 32, 95, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 10, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 32, 97, 115, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 105, 109, 112, 111, 114, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 10, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 32, 61, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 41, 10, 10, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 32, 61, 32, 123, 125, 10, 9, 95, 95, 108, 111, 99, 32, 61, 32, 123, 125, 10, 10, 9, 35, 32, 77, 111, 100, 117, 108, 101, 115, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 101, 97, 114, 108, 105, 101, 114, 32, 115, 116, 101, 112, 115, 32, 97, 114, 101, 32, 105, 109, 112, 111, 114, 116, 101, 100, 32, 105, 102, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 104, 97, 115, 32, 116, 104, 101, 105, 114, 32, 112, 97, 99, 107, 97, 103, 101, 115, 32, 45, 32, 105, 116, 32, 104, 97, 115, 32, 116, 104, 101, 109, 32, 102, 111, 114, 10, 9, 35, 32, 101, 118, 101, 114, 121, 32, 109, 111, 100, 117, 108, 101, 32, 105, 116, 32, 117, 115, 101, 115, 10, 9, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 123, 125, 10, 9, 105, 102, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 41, 10, 9, 102, 111, 114, 32, 95, 95, 110, 97, 109, 101, 44, 32, 95, 95, 109, 111, 100, 117, 108, 101, 32, 105, 110, 32, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 110, 97, 109, 101, 93, 32, 61, 32, 95, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 46, 105, 109, 112, 111, 114, 116, 95, 109, 111, 100, 117, 108, 101, 40, 95, 95, 109, 111, 100, 117, 108, 101, 41, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 73, 109, 112, 111, 114, 116, 69, 114, 114, 111, 114, 58, 10, 9, 9, 9, 112, 97, 115, 115, 10, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 34, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 34, 93, 32, 61, 32, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 10, 10, 9, 35, 32, 79, 110, 108, 121, 32, 116, 104, 101, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 117, 115, 101, 115, 44, 32, 111, 114, 32, 112, 97, 115, 115, 101, 115, 32, 111, 110, 32, 116, 111, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 105, 116, 44, 32, 97, 114, 101, 32, 108, 111, 97, 100, 101, 100, 10, 9, 95, 95, 108, 111, 97, 100, 115, 32, 61, 32, 123, 37, 32, 105, 102, 32, 76, 111, 97, 100, 115, 65, 108, 108, 32, 37, 125, 78, 111, 110, 101, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 91, 123, 37, 32, 102, 111, 114, 32, 110, 97, 109, 101, 32, 105, 110, 32, 76, 111, 97, 100, 115, 32, 37, 125, 34, 123, 123, 32, 110, 97, 109, 101, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 105, 102, 32, 95, 95, 107, 32, 110, 111, 116, 32, 105, 110, 32, 40, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 44, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 41, 32, 97, 110, 100, 32, 40, 95, 95, 108, 111, 97, 100, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 111, 97, 100, 115, 41, 58, 10, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 95, 95, 107, 93, 41, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 10, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 115, 112, 105, 108, 108, 101, 100, 32, 98, 121, 32, 101, 97, 114, 108, 105, 101, 114, 32, 115, 116, 101, 112, 115, 32, 97, 114, 101, 32, 114, 101, 97, 100, 32, 102, 114, 111, 109, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 46, 32, 84, 104, 101, 105, 114, 32, 98, 121, 116, 101, 115, 32, 97, 114, 101, 32, 107, 101, 112, 116, 44, 32, 115, 111, 32, 116, 104, 101, 32, 111, 110, 101, 115, 32, 112, 97, 115, 115, 101, 100, 10, 9, 35, 32, 111, 110, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 32, 114, 97, 116, 104, 101, 114, 32, 116, 104, 97, 110, 32, 119, 114, 105, 116, 116, 101, 110, 32, 97, 103, 97, 105, 110, 46, 10, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 123, 125, 10, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 32, 61, 32, 123, 125, 10, 9, 105, 102, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 41, 10, 9, 102, 111, 114, 32, 95, 95, 107, 44, 32, 95, 95, 117, 114, 105, 32, 105, 110, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 105, 102, 32, 95, 95, 108, 111, 97, 100, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 111, 97, 100, 115, 58, 10, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 114, 101, 97, 100, 95, 115, 112, 105, 108, 108, 101, 100, 40, 95, 95, 117, 114, 105, 41, 10, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 105, 102, 32, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 58, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 46, 117, 112, 100, 97, 116, 101, 40, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 41, 10, 10, 9, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 105, 110, 112, 117, 116, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 125, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 95, 95, 101, 114, 114, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 95, 95, 101, 114, 114, 125, 34, 41, 10, 10, 9, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 32, 61, 32, 34, 34, 34, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 10, 123, 123, 32, 73, 110, 110, 101, 114, 95, 67, 111, 100, 101, 32, 125, 125, 10, 10, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 108, 111, 99, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 123, 125, 10, 10, 35, 32, 77, 111, 100, 117, 108, 101, 115, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 110, 97, 109, 101, 32, 114, 97, 116, 104, 101, 114, 32, 116, 104, 97, 110, 32, 112, 105, 99, 107, 108, 101, 100, 44, 32, 115, 111, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 111, 110, 108, 121, 32, 110, 101, 101, 100, 32, 116, 104, 101, 32, 112, 97, 99, 107, 97, 103, 101, 115, 10, 35, 32, 102, 111, 114, 32, 116, 104, 101, 32, 109, 111, 100, 117, 108, 101, 115, 32, 116, 104, 101, 121, 32, 117, 115, 101, 46, 32, 77, 111, 100, 117, 108, 101, 115, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 103, 111, 116, 32, 98, 117, 116, 32, 99, 111, 117, 108, 100, 110, 39, 116, 32, 105, 109, 112, 111, 114, 116, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 97, 115, 32, 116, 104, 101, 121, 32, 99, 97, 109, 101, 46, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 100, 105, 99, 116, 40, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 10, 35, 32, 79, 110, 108, 121, 32, 116, 104, 101, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 117, 115, 101, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 10, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 61, 32, 123, 37, 32, 105, 102, 32, 69, 120, 112, 111, 114, 116, 115, 65, 108, 108, 32, 37, 125, 78, 111, 110, 101, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 91, 123, 37, 32, 102, 111, 114, 32, 110, 97, 109, 101, 32, 105, 110, 32, 69, 120, 112, 111, 114, 116, 115, 32, 37, 125, 34, 123, 123, 32, 110, 97, 109, 101, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 58, 10, 9, 9, 105, 102, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 91, 118, 97, 108, 93, 32, 61, 32, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 46, 95, 95, 110, 97, 109, 101, 95, 95, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 46, 112, 111, 112, 40, 118, 97, 108, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 105, 102, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 58, 10, 9, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 35, 32, 76, 111, 99, 97, 108, 115, 32, 110, 101, 101, 100, 115, 32, 116, 111, 32, 99, 111, 109, 101, 32, 97, 102, 116, 101, 114, 32, 103, 108, 111, 98, 97, 108, 115, 32, 105, 110, 32, 99, 97, 115, 101, 32, 119, 101, 32, 109, 97, 100, 101, 32, 99, 104, 97, 110, 103, 101, 115, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 58, 10, 9, 9, 105, 102, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 91, 118, 97, 108, 93, 32, 61, 32, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 46, 95, 95, 110, 97, 109, 101, 95, 95, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 46, 112, 111, 112, 40, 118, 97, 108, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 105, 102, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 58, 10, 9, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 10, 34, 34, 34, 10, 9, 35, 32, 84, 104, 101, 32, 99, 111, 100, 101, 32, 114, 117, 110, 115, 32, 97, 115, 32, 97, 32, 102, 105, 108, 101, 32, 110, 97, 109, 101, 100, 32, 97, 102, 116, 101, 114, 32, 116, 104, 101, 32, 115, 116, 101, 112, 44, 32, 115, 111, 32, 105, 116, 115, 32, 116, 114, 97, 99, 101, 98, 97, 99, 107, 115, 32, 99, 97, 110, 32, 98, 101, 32, 109, 97, 112, 112, 101, 100, 32, 98, 97, 99, 107, 32, 116, 111, 32, 116, 104, 101, 32, 110, 111, 116, 101, 98, 111, 111, 107, 10, 9, 101, 120, 101, 99, 40, 99, 111, 109, 112, 105, 108, 101, 40, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 44, 32, 34, 60, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 62, 34, 44, 32, 34, 101, 120, 101, 99, 34, 41, 44, 32, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 44, 32, 95, 95, 108, 111, 99, 41, 10, 10, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 95, 95, 108, 111, 99, 91, 34, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 34, 93, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 95, 95, 115, 112, 105, 108, 108, 95, 99, 111, 110, 116, 101, 120, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 44, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 115, 116, 114, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 9, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 37, 118, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 111, 117, 116, 112, 117, 116, 34, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 125, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 101, 114, 114, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 101, 114, 114, 125, 34, 41, 10, 10, 9, 102, 114, 111, 109, 32, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 32, 105, 109, 112, 111, 114, 116, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 40, 34, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 34, 99, 111, 110, 116, 101, 120, 116, 34, 93, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 111, 117, 116, 112, 117, 116, 40, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 9, 95, 95, 114, 117, 110, 32, 61, 32, 82, 117, 110, 46, 103, 101, 116, 95, 99, 111, 110, 116, 101, 120, 116, 40, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 32, 61, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 46, 65, 114, 103, 117, 109, 101, 110, 116, 80, 97, 114, 115, 101, 114, 40, 34, 99, 108, 101, 97, 110, 115, 101, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 97, 99, 116, 105, 111, 110, 61, 34, 97, 112, 112, 101, 110, 100, 34, 44, 32, 104, 101, 108, 112, 61, 34, 67, 111, 110, 116, 101, 120, 116, 32, 116, 111, 32, 114, 117, 110, 32, 97, 115, 32, 115, 116, 114, 105, 110, 103, 44, 32, 103, 105, 118, 101, 110, 32, 111, 110, 99, 101, 32, 102, 111, 114, 32, 101, 97, 99, 104, 32, 112, 97, 114, 101, 110, 116, 32, 115, 116, 101, 112, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 82, 117, 110, 32, 105, 110, 102, 111, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 79, 117, 116, 112, 117, 116, 32, 99, 111, 110, 116, 101, 120, 116, 32, 112, 97, 116, 104, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 104, 101, 108, 112, 61, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 34, 41, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 68, 101, 102, 97, 117, 108, 116, 32, 125, 125, 44, 32, 104, 101, 108, 112, 61, 34, 82, 117, 110, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 34, 41, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 9, 95, 95, 97, 114, 103, 115, 32, 61, 32, 95, 95, 112, 97, 114, 115, 101, 114, 46, 112, 97, 114, 115, 101, 95, 97, 114, 103, 115, 40, 41, 10, 10, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 32, 61, 32, 34, 99, 111, 110, 116, 101, 120, 116, 46, 116, 120, 116, 34, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 32, 61, 32, 91, 93, 10, 9, 102, 111, 114, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 32, 105, 110, 32, 95, 95, 97, 114, 103, 115, 46, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 32, 111, 114, 32, 91, 34, 34, 93, 58, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 9, 105, 102, 32, 34, 95, 95, 112, 105, 112, 101, 108, 105, 110, 101, 100, 97, 116, 97, 95, 99, 111, 110, 116, 101, 120, 116, 34, 32, 105, 110, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 58, 10, 9, 9, 9, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 41, 32, 47, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 114, 101, 97, 100, 105, 110, 103, 32, 102, 105, 108, 101, 58, 32, 123, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 125, 34, 41, 10, 9, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 95, 102, 117, 108, 108, 95, 112, 97, 116, 104, 46, 114, 101, 97, 100, 95, 116, 101, 120, 116, 40, 41, 10, 9, 9, 101, 108, 105, 102, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 32, 97, 110, 100, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 46, 115, 116, 114, 105, 112, 40, 41, 58, 10, 9, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 46, 115, 116, 114, 105, 112, 40, 41, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 46, 97, 112, 112, 101, 110, 100, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 109, 101, 114, 103, 101, 95, 99, 111, 110, 116, 101, 120, 116, 115, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 44, 32, 91, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 34, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 10, 10, 9, 35, 32, 78, 101, 101, 100, 32, 116, 111, 32, 117, 110, 112, 97, 99, 107, 32, 97, 110, 100, 32, 100, 111, 32, 116, 104, 105, 115, 32, 104, 101, 114, 101, 44, 32, 98, 101, 99, 97, 117, 115, 101, 32, 65, 77, 76, 32, 111, 110, 108, 121, 32, 103, 105, 118, 101, 115, 10, 9, 35, 32, 117, 115, 32, 116, 104, 101, 32, 114, 117, 110, 32, 105, 100, 32, 105, 110, 115, 105, 100, 101, 32, 116, 104, 101, 32, 99, 111, 110, 116, 97, 105, 110, 101, 114, 46, 32, 85, 110, 112, 97, 99, 107, 105, 110, 103, 32, 97, 110, 100, 32, 114, 101, 112, 97, 99, 107, 105, 110, 103, 32, 115, 111, 10, 9, 35, 32, 98, 117, 108, 107, 32, 111, 102, 32, 116, 104, 101, 32, 99, 111, 100, 101, 32, 105, 115, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 46, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 97, 114, 103, 115, 46, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 32, 61, 32, 95, 95, 114, 117, 110, 46, 103, 101, 116, 95, 100, 101, 116, 97, 105, 108, 115, 40, 41, 91, 34, 114, 117, 110, 73, 100, 34, 93, 10, 10, 9, 100, 101, 102, 32, 95, 95, 114, 117, 110, 95, 115, 116, 101, 112, 40, 41, 58, 10, 9, 9, 35, 32, 82, 101, 116, 117, 114, 110, 115, 32, 97, 32, 116, 117, 112, 108, 101, 44, 32, 119, 104, 101, 114, 101, 32, 116, 104, 101, 32, 122, 101, 114, 111, 116, 104, 32, 105, 110, 100, 101, 120, 32, 105, 115, 32, 116, 104, 101, 32, 115, 116, 114, 105, 110, 103, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 109, 97, 105, 110, 40, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 61, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 61, 115, 116, 114, 40, 10, 9, 9, 9, 9, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 10, 9, 9, 9, 41, 44, 10, 9, 9, 9, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 95, 95, 97, 114, 103, 115, 46, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 9, 9, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 61, 123, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 9, 9, 9, 34, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 58, 32, 95, 95, 100, 101, 99, 111, 100, 101, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 40, 95, 95, 97, 114, 103, 115, 46, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 44, 32, 34, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 84, 121, 112, 101, 32, 125, 125, 34, 41, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 9, 9, 125, 44, 10, 9, 9, 41, 91, 48, 93, 10, 10, 9, 35, 32, 65, 77, 76, 32, 100, 111, 101, 115, 110, 39, 116, 32, 114, 101, 116, 114, 121, 32, 115, 116, 101, 112, 115, 44, 32, 115, 111, 32, 116, 104, 101, 32, 115, 116, 101, 112, 32, 114, 101, 116, 114, 105, 101, 115, 32, 105, 116, 115, 101, 108, 102, 10, 9, 105, 109, 112, 111, 114, 116, 32, 116, 114, 97, 99, 101, 98, 97, 99, 107, 32, 97, 115, 32, 95, 95, 116, 114, 97, 99, 101, 98, 97, 99, 107, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 105, 109, 112, 111, 114, 116, 32, 115, 105, 103, 110, 97, 108, 32, 97, 115, 32, 95, 95, 115, 105, 103, 110, 97, 108, 10, 10, 9, 35, 32, 65, 116, 116, 101, 109, 112, 116, 115, 32, 97, 114, 101, 32, 116, 105, 109, 101, 100, 32, 111, 117, 116, 32, 104, 101, 114, 101, 32, 116, 111, 111, 44, 32, 97, 115, 32, 65, 77, 76, 32, 115, 116, 111, 112, 112, 105, 110, 103, 32, 116, 104, 101, 32, 115, 116, 101, 112, 32, 119, 111, 117, 108, 100, 32, 102, 97, 105, 108, 32, 116, 104, 101, 32, 112, 105, 112, 101, 108, 105, 110, 101, 10, 9, 100, 101, 102, 32, 95, 95, 116, 105, 109, 101, 100, 95, 111, 117, 116, 40, 95, 95, 115, 105, 103, 110, 117, 109, 44, 32, 95, 95, 102, 114, 97, 109, 101, 41, 58, 10, 9, 9, 114, 97, 105, 115, 101, 32, 84, 105, 109, 101, 111, 117, 116, 69, 114, 114, 111, 114, 40, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 32, 114, 97, 110, 32, 102, 111, 114, 32, 108, 111, 110, 103, 101, 114, 32, 116, 104, 97, 110, 32, 105, 116, 115, 32, 116, 105, 109, 101, 111, 117, 116, 32, 111, 102, 32, 123, 123, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 125, 125, 32, 115, 101, 99, 111, 110, 100, 115, 34, 41, 10, 10, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 115, 105, 103, 110, 97, 108, 40, 95, 95, 115, 105, 103, 110, 97, 108, 46, 83, 73, 71, 65, 76, 82, 77, 44, 32, 95, 95, 116, 105, 109, 101, 100, 95, 111, 117, 116, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 32, 61, 32, 123, 123, 32, 82, 101, 116, 114, 105, 101, 115, 32, 125, 125, 32, 43, 32, 49, 10, 9, 102, 111, 114, 32, 95, 95, 97, 116, 116, 101, 109, 112, 116, 32, 105, 110, 32, 114, 97, 110, 103, 101, 40, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 41, 58, 10, 9, 9, 116, 114, 121, 58, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 123, 123, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 125, 125, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 114, 117, 110, 95, 115, 116, 101, 112, 40, 41, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 48, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 69, 120, 99, 101, 112, 116, 105, 111, 110, 58, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 48, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 95, 95, 116, 114, 97, 99, 101, 98, 97, 99, 107, 46, 112, 114, 105, 110, 116, 95, 101, 120, 99, 40, 41, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 65, 116, 116, 101, 109, 112, 116, 32, 123, 95, 95, 97, 116, 116, 101, 109, 112, 116, 32, 43, 32, 49, 125, 32, 111, 102, 32, 123, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 125, 32, 111, 102, 32, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 32, 102, 97, 105, 108, 101, 100, 34, 41, 10, 9, 9, 9, 105, 102, 32, 95, 95, 97, 116, 116, 101, 109, 112, 116, 32, 43, 32, 49, 32, 60, 32, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 58, 10, 9, 9, 9, 9, 99, 111, 110, 116, 105, 110, 117, 101, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 105, 110, 117, 101, 79, 110, 70, 97, 105, 108, 117, 114, 101, 32, 37, 125, 9, 9, 9, 35, 32, 84, 104, 101, 32, 112, 105, 112, 101, 108, 105, 110, 101, 32, 99, 97, 114, 114, 105, 101, 115, 32, 111, 110, 44, 32, 119, 105, 116, 104, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 115, 116, 97, 114, 116, 105, 110, 103, 32, 102, 114, 111, 109, 32, 116, 104, 101, 32, 99, 111, 110, 116, 101, 120, 116, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 115, 116, 97, 114, 116, 101, 100, 32, 102, 114, 111, 109, 10, 9, 9, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 10, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 9, 9, 9, 114, 97, 105, 115, 101, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 95, 95, 97, 114, 103, 115, 46, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 95, 95, 112, 46, 109, 107, 100, 105, 114, 40, 112, 97, 114, 101, 110, 116, 115, 61, 84, 114, 117, 101, 44, 32, 101, 120, 105, 115, 116, 95, 111, 107, 61, 84, 114, 117, 101, 41, 10, 9, 95, 95, 102, 105, 108, 101, 112, 97, 116, 104, 32, 61, 32, 95, 95, 112, 32, 47, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 110, 97, 109, 101, 10, 9, 119, 105, 116, 104, 32, 95, 95, 102, 105, 108, 101, 112, 97, 116, 104, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 58, 10, 9, 9, 95, 95, 102, 46, 119, 114, 105, 116, 101, 40, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
 	box.Add("/amlv2/.keep", []byte{})
-	box.Add("/kfp/root.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 46, 100, 115, 108, 32, 97, 115, 32, 100, 115, 108, 10, 102, 114, 111, 109, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 32, 105, 109, 112, 111, 114, 116, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 44, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 44, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 46, 99, 111, 109, 112, 105, 108, 101, 114, 32, 97, 115, 32, 99, 111, 109, 112, 105, 108, 101, 114, 10, 102, 114, 111, 109, 32, 107, 102, 112, 46, 100, 115, 108, 46, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 68, 105, 99, 116, 32, 97, 115, 32, 75, 70, 80, 68, 105, 99, 116, 44, 32, 76, 105, 115, 116, 32, 97, 115, 32, 75, 70, 80, 76, 105, 115, 116, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 105, 109, 112, 111, 114, 116, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 10, 102, 114, 111, 109, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 99, 108, 105, 101, 110, 116, 44, 32, 99, 111, 110, 102, 105, 103, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 105, 109, 112, 111, 114, 116, 32, 123, 123, 32, 115, 116, 101, 112, 46, 78, 97, 109, 101, 32, 125, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 40, 10, 9, 114, 117, 110, 95, 105, 100, 58, 32, 115, 116, 114, 44, 10, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 34, 82, 117, 110, 73, 110, 102, 111, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 40, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 34, 34, 34, 69, 120, 97, 109, 112, 108, 101, 32, 111, 102, 32, 103, 101, 116, 116, 105, 110, 103, 32, 114, 117, 110, 32, 105, 110, 102, 111, 32, 102, 111, 114, 32, 99, 117, 114, 114, 101, 110, 116, 32, 112, 105, 112, 101, 108, 105, 110, 101, 32, 114, 117, 110, 34, 34, 34, 10, 9, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 10, 9, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 102, 114, 111, 109, 32, 100, 97, 116, 101, 117, 116, 105, 108, 46, 116, 122, 32, 105, 109, 112, 111, 114, 116, 32, 116, 122, 108, 111, 99, 97, 108, 10, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 112, 112, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 67, 117, 114, 114, 101, 110, 116, 32, 114, 117, 110, 32, 73, 68, 32, 105, 115, 32, 123, 114, 117, 110, 95, 105, 100, 125, 46, 34, 41, 10, 9, 99, 108, 105, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 67, 108, 105, 101, 110, 116, 40, 104, 111, 115, 116, 61, 34, 104, 116, 116, 112, 58, 47, 47, 109, 108, 45, 112, 105, 112, 101, 108, 105, 110, 101, 58, 56, 56, 56, 56, 34, 41, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 103, 101, 116, 95, 114, 117, 110, 40, 114, 117, 110, 95, 105, 100, 61, 114, 117, 110, 95, 105, 100, 41, 10, 9, 35, 32, 72, 105, 100, 101, 32, 118, 101, 114, 98, 111, 115, 101, 32, 105, 110, 102, 111, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 119, 111, 114, 107, 102, 108, 111, 119, 95, 109, 97, 110, 105, 102, 101, 115, 116, 32, 61, 32, 78, 111, 110, 101, 10, 10, 9, 102, 114, 111, 109, 32, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 32, 105, 109, 112, 111, 114, 116, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 10, 10, 9, 112, 112, 40, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 41, 10, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 105, 100, 44, 10, 9, 9, 34, 110, 97, 109, 101, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 110, 97, 109, 101, 44, 10, 9, 9, 34, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 34, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 44, 10, 9, 125, 10, 9, 102, 111, 114, 32, 114, 32, 105, 110, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 114, 101, 115, 111, 117, 114, 99, 101, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 58, 10, 9, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 102, 34, 123, 114, 46, 107, 101, 121, 46, 116, 121, 112, 101, 46, 108, 111, 119, 101, 114, 40, 41, 125, 95, 105, 100, 34, 93, 32, 61, 32, 114, 46, 107, 101, 121, 46, 105, 100, 10, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 40, 34, 82, 117, 110, 73, 110, 102, 111, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 111, 117, 116, 112, 117, 116, 40, 10, 9, 9, 115, 116, 114, 40, 98, 97, 115, 101, 54, 52, 46, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 9, 41, 10, 10, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 46, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 102, 117, 110, 99, 61, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 44, 10, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 10, 9, 9, 34, 107, 102, 112, 34, 44, 10, 9, 9, 34, 100, 105, 108, 108, 34, 44, 10, 9, 93, 44, 10, 41, 10, 10, 100, 101, 102, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 40, 10, 9, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 41, 58, 10, 9, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 119, 105, 116, 104, 32, 95, 95, 112, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 9, 9, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 10, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 46, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 102, 117, 110, 99, 61, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 44, 10, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 10, 9, 9, 34, 107, 102, 112, 34, 44, 10, 9, 9, 34, 100, 105, 108, 108, 34, 44, 10, 9, 93, 44, 10, 41, 10, 10, 64, 100, 115, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 40, 110, 97, 109, 101, 61, 34, 67, 111, 109, 112, 105, 108, 97, 116, 105, 111, 110, 32, 111, 102, 32, 112, 105, 112, 101, 108, 105, 110, 101, 115, 34, 44, 41, 10, 100, 101, 102, 32, 114, 111, 111, 116, 40, 123, 123, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 125, 125, 123, 37, 32, 105, 102, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 37, 125, 44, 32, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 99, 111, 110, 116, 101, 120, 116, 61, 39, 39, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 39, 39, 41, 58, 10, 10, 9, 35, 32, 84, 104, 101, 32, 98, 101, 108, 111, 119, 32, 105, 115, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 105, 110, 103, 32, 111, 102, 32, 97, 110, 32, 101, 109, 112, 116, 121, 32, 108, 111, 99, 97, 108, 115, 40, 41, 32, 111, 117, 116, 112, 117, 116, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 34, 10, 9, 105, 102, 32, 99, 111, 110, 116, 101, 120, 116, 32, 61, 61, 32, 39, 39, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 101, 108, 115, 101, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 10, 10, 9, 115, 101, 99, 114, 101, 116, 115, 95, 98, 121, 95, 101, 110, 118, 32, 61, 32, 123, 125, 10, 10, 35, 32, 71, 101, 110, 101, 114, 97, 116, 101, 32, 115, 101, 99, 114, 101, 116, 115, 32, 40, 105, 102, 32, 110, 111, 116, 32, 97, 108, 114, 101, 97, 100, 121, 32, 99, 114, 101, 97, 116, 101, 100, 41, 10, 123, 37, 32, 102, 111, 114, 32, 115, 101, 99, 114, 101, 116, 32, 105, 110, 32, 83, 101, 99, 114, 101, 116, 115, 84, 111, 67, 114, 101, 97, 116, 101, 32, 37, 125, 10, 9, 99, 111, 110, 102, 105, 103, 46, 108, 111, 97, 100, 95, 107, 117, 98, 101, 95, 99, 111, 110, 102, 105, 103, 40, 41, 10, 9, 118, 49, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 67, 111, 114, 101, 86, 49, 65, 112, 105, 40, 41, 10, 9, 110, 97, 109, 101, 115, 112, 97, 99, 101, 32, 61, 32, 34, 107, 117, 98, 101, 102, 108, 111, 119, 34, 10, 9, 110, 97, 109, 101, 32, 61, 32, 34, 123, 123, 32, 83, 97, 102, 101, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 78, 97, 109, 101, 32, 125, 125, 34, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 32, 61, 32, 123, 34, 110, 97, 109, 101, 34, 58, 32, 110, 97, 109, 101, 44, 32, 34, 110, 97, 109, 101, 115, 112, 97, 99, 101, 34, 58, 32, 34, 107, 117, 98, 101, 102, 108, 111, 119, 34, 125, 10, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 32, 61, 32, 34, 118, 49, 34, 10, 9, 107, 105, 110, 100, 32, 61, 32, 34, 83, 101, 99, 114, 101, 116, 34, 10, 9, 116, 121, 112, 101, 32, 61, 32, 34, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 105, 111, 47, 100, 111, 99, 107, 101, 114, 99, 111, 110, 102, 105, 103, 106, 115, 111, 110, 34, 10, 10, 9, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 32, 61, 32, 123, 10, 9, 9, 34, 97, 117, 116, 104, 115, 34, 58, 32, 123, 10, 9, 9, 9, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 58, 32, 123, 10, 9, 9, 9, 9, 34, 117, 115, 101, 114, 110, 97, 109, 101, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 85, 115, 101, 114, 110, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 112, 97, 115, 115, 119, 111, 114, 100, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 80, 97, 115, 115, 119, 111, 114, 100, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 101, 109, 97, 105, 108, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 69, 109, 97, 105, 108, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 97, 117, 116, 104, 34, 58, 32, 98, 97, 115, 101, 54, 52, 46, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 10, 9, 9, 9, 9, 9, 102, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 85, 115, 101, 114, 110, 97, 109, 101, 125, 125, 58, 123, 123, 115, 101, 99, 114, 101, 116, 46, 80, 97, 115, 115, 119, 111, 114, 100, 125, 125, 34, 46, 101, 110, 99, 111, 100, 101, 40, 41, 10, 9, 9, 9, 9, 41, 46, 100, 101, 99, 111, 100, 101, 40, 41, 44, 10, 9, 9, 9, 125, 10, 9, 9, 125, 10, 9, 125, 10, 10, 9, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 46, 100, 111, 99, 107, 101, 114, 99, 111, 110, 102, 105, 103, 106, 115, 111, 110, 34, 58, 32, 98, 97, 115, 101, 54, 52, 46, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 106, 115, 111, 110, 46, 100, 117, 109, 112, 115, 40, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 41, 46, 101, 110, 99, 111, 100, 101, 40, 41, 41, 46, 100, 101, 99, 111, 100, 101, 40, 41, 10, 9, 125, 10, 10, 9, 115, 101, 99, 114, 101, 116, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 40, 10, 9, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 61, 34, 118, 49, 34, 44, 10, 9, 9, 100, 97, 116, 97, 61, 100, 97, 116, 97, 44, 10, 9, 9, 107, 105, 110, 100, 61, 34, 83, 101, 99, 114, 101, 116, 34, 44, 10, 9, 9, 109, 101, 116, 97, 100, 97, 116, 97, 61, 109, 101, 116, 97, 100, 97, 116, 97, 44, 10, 9, 9, 116, 121, 112, 101, 61, 116, 121, 112, 101, 44, 10, 9, 41, 10, 9, 98, 111, 100, 121, 32, 61, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 40, 10, 9, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 44, 32, 100, 97, 116, 97, 44, 32, 107, 105, 110, 100, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 44, 32, 116, 121, 112, 101, 61, 116, 121, 112, 101, 10, 9, 41, 10, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 78, 111, 110, 101, 10, 9, 116, 114, 121, 58, 10, 9, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 118, 49, 46, 99, 114, 101, 97, 116, 101, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 100, 95, 115, 101, 99, 114, 101, 116, 40, 110, 97, 109, 101, 115, 112, 97, 99, 101, 44, 32, 98, 111, 100, 121, 41, 10, 9, 101, 120, 99, 101, 112, 116, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 46, 114, 101, 115, 116, 46, 65, 112, 105, 69, 120, 99, 101, 112, 116, 105, 111, 110, 32, 97, 115, 32, 101, 58, 10, 9, 9, 105, 102, 32, 101, 46, 115, 116, 97, 116, 117, 115, 32, 61, 61, 32, 52, 48, 57, 58, 10, 9, 9, 9, 105, 102, 32, 40, 10, 9, 9, 9, 9, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 117, 115, 101, 114, 110, 97, 109, 101, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 112, 97, 115, 115, 119, 111, 114, 100, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 101, 109, 97, 105, 108, 34, 93, 10, 9, 9, 9, 41, 58, 10, 9, 9, 9, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 118, 49, 46, 114, 101, 112, 108, 97, 99, 101, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 100, 95, 115, 101, 99, 114, 101, 116, 40, 110, 97, 109, 101, 44, 32, 110, 97, 109, 101, 115, 112, 97, 99, 101, 44, 32, 98, 111, 100, 121, 41, 10, 9, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 105, 115, 115, 105, 110, 103, 32, 118, 97, 108, 117, 101, 34, 41, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 114, 97, 105, 115, 101, 32, 101, 10, 10, 9, 100, 115, 108, 46, 103, 101, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 99, 111, 110, 102, 40, 41, 46, 115, 101, 116, 95, 105, 109, 97, 103, 101, 95, 112, 117, 108, 108, 95, 115, 101, 99, 114, 101, 116, 115, 40, 91, 99, 108, 105, 101, 110, 116, 46, 86, 49, 76, 111, 99, 97, 108, 79, 98, 106, 101, 99, 116, 82, 101, 102, 101, 114, 101, 110, 99, 101, 40, 110, 97, 109, 101, 61, 110, 97, 109, 101, 41, 93, 41, 10, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 9, 39, 39, 39, 107, 102, 112, 46, 100, 115, 108, 46, 82, 85, 78, 95, 73, 68, 95, 80, 76, 65, 67, 69, 72, 79, 79, 76, 68, 69, 82, 32, 105, 110, 115, 105, 100, 101, 32, 97, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 119, 105, 108, 108, 32, 98, 101, 32, 112, 111, 112, 117, 108, 97, 116, 101, 100, 32, 119, 105, 116, 104, 32, 75, 70, 80, 32, 82, 117, 110, 32, 73, 68, 32, 97, 116, 32, 114, 117, 110, 116, 105, 109, 101, 46, 39, 39, 39, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 111, 112, 32, 61, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 40, 114, 117, 110, 95, 105, 100, 61, 107, 102, 112, 46, 100, 115, 108, 46, 82, 85, 78, 95, 73, 68, 95, 80, 76, 65, 67, 69, 72, 79, 76, 68, 69, 82, 41, 10, 10, 9, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 111, 112, 32, 61, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 40, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 61, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 111, 112, 32, 61, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 9, 102, 117, 110, 99, 61, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 103, 101, 110, 101, 114, 97, 116, 101, 100, 95, 109, 97, 105, 110, 44, 10, 9, 9, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 61, 34, 123, 123, 115, 116, 101, 112, 46, 73, 109, 97, 103, 101, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 34, 100, 105, 108, 108, 34, 44, 32, 34, 114, 101, 113, 117, 101, 115, 116, 115, 34, 44, 32, 123, 123, 115, 116, 101, 112, 46, 80, 97, 99, 107, 97, 103, 101, 83, 116, 114, 105, 110, 103, 125, 125, 93, 44, 10, 9, 41, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 32, 61, 32, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 111, 112, 40, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 61, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 125, 125, 95, 116, 97, 115, 107, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 111, 112, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 46, 111, 117, 116, 112, 117, 116, 115, 91, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 93, 44, 32, 114, 117, 110, 95, 105, 110, 102, 111, 61, 114, 117, 110, 95, 105, 110, 102, 111, 95, 111, 112, 46, 111, 117, 116, 112, 117, 116, 115, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 41, 10, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 67, 97, 99, 104, 101, 86, 97, 108, 117, 101, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 101, 120, 101, 99, 117, 116, 105, 111, 110, 95, 111, 112, 116, 105, 111, 110, 115, 46, 99, 97, 99, 104, 105, 110, 103, 95, 115, 116, 114, 97, 116, 101, 103, 121, 46, 109, 97, 120, 95, 99, 97, 99, 104, 101, 95, 115, 116, 97, 108, 101, 110, 101, 115, 115, 32, 61, 32, 34, 123, 123, 115, 116, 101, 112, 46, 67, 97, 99, 104, 101, 86, 97, 108, 117, 101, 125, 125, 34, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 10, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 32, 37, 125, 10, 9, 123, 123, 32, 115, 116, 101, 112, 46, 78, 97, 109, 101, 32, 125, 125, 95, 116, 97, 115, 107, 46, 97, 102, 116, 101, 114, 40, 123, 123, 115, 116, 101, 112, 46, 80, 114, 101, 118, 105, 111, 117, 115, 83, 116, 101, 112, 125, 125, 95, 116, 97, 115, 107, 41, 10, 9, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
-	box.Add("/kfp/step.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 32, 97, 115, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 10, 102, 114, 111, 109, 32, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 110, 116, 101, 120, 116, 10, 105, 109, 112, 111, 114, 116, 32, 112, 97, 116, 104, 108, 105, 98, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 44, 10, 41, 10, 102, 114, 111, 109, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 32, 105, 109, 112, 111, 114, 116, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 44, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 10, 10, 100, 101, 102, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 95, 109, 97, 105, 110, 40, 10, 9, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 9, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 61, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 44, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 10, 41, 58, 10, 9, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 9, 100, 101, 102, 32, 95, 95, 105, 110, 110, 101, 114, 95, 109, 97, 105, 110, 40, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 44, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 10, 9, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 34, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 40, 34, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 9, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 9, 9, 102, 114, 111, 109, 32, 99, 111, 112, 121, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 112, 121, 32, 97, 115, 32, 95, 95, 99, 111, 112, 121, 10, 9, 9, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 9, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 32, 97, 115, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 10, 10, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 9, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 32, 61, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 41, 10, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 108, 111, 99, 32, 61, 32, 123, 125, 10, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 95, 95, 107, 93, 41, 10, 10, 9, 9, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 105, 110, 112, 117, 116, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 125, 10, 10, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 95, 95, 101, 114, 114, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 95, 95, 101, 114, 114, 125, 34, 41, 10, 10, 9, 9, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 32, 61, 32, 34, 34, 34, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 10, 123, 123, 32, 73, 110, 110, 101, 114, 95, 67, 111, 100, 101, 32, 125, 125, 10, 10, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 108, 111, 99, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 123, 125, 10, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 32, 97, 110, 100, 32, 110, 111, 116, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 118, 97, 108, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 35, 32, 76, 111, 99, 97, 108, 115, 32, 110, 101, 101, 100, 115, 32, 116, 111, 32, 99, 111, 109, 101, 32, 97, 102, 116, 101, 114, 32, 103, 108, 111, 98, 97, 108, 115, 32, 105, 110, 32, 99, 97, 115, 101, 32, 119, 101, 32, 109, 97, 100, 101, 32, 99, 104, 97, 110, 103, 101, 115, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 32, 97, 110, 100, 32, 110, 111, 116, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 118, 97, 108, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 115, 116, 114, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 9, 34, 34, 34, 10, 9, 9, 101, 120, 101, 99, 40, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 44, 32, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 44, 32, 95, 95, 108, 111, 99, 41, 10, 10, 9, 9, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 111, 117, 116, 112, 117, 116, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 108, 111, 99, 91, 34, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 34, 93, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 125, 10, 10, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 101, 114, 114, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 101, 114, 114, 125, 34, 41, 10, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 108, 111, 99, 91, 34, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 34, 93, 10, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 105, 102, 32, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 32, 33, 61, 32, 78, 111, 110, 101, 58, 10, 9, 9, 119, 105, 116, 104, 32, 111, 112, 101, 110, 40, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 44, 32, 39, 114, 39, 41, 32, 97, 115, 32, 114, 101, 97, 100, 101, 114, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 114, 101, 97, 100, 105, 110, 103, 32, 102, 105, 108, 101, 58, 32, 123, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 125, 34, 41, 10, 9, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 114, 101, 97, 100, 101, 114, 46, 114, 101, 97, 100, 40, 41, 10, 10, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 105, 110, 110, 101, 114, 95, 109, 97, 105, 110, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 61, 114, 117, 110, 95, 105, 110, 102, 111, 44, 10, 9, 9, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 41, 10, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 119, 105, 116, 104, 32, 95, 95, 112, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 9, 9, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
+	box.Add("/kfp/create_context_file.py", []byte{105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 10, 35, 32, 84, 104, 101, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 105, 110, 103, 32, 111, 102, 32, 97, 110, 32, 101, 109, 112, 116, 121, 32, 108, 111, 99, 97, 108, 115, 40, 41, 32, 111, 117, 116, 112, 117, 116, 44, 32, 116, 104, 101, 32, 99, 111, 110, 116, 101, 120, 116, 32, 97, 32, 114, 117, 110, 32, 115, 116, 97, 114, 116, 115, 32, 102, 114, 111, 109, 32, 117, 110, 108, 101, 115, 115, 32, 105, 116, 32, 105, 115, 32, 103, 105, 118, 101, 110, 32, 111, 110, 101, 10, 69, 77, 80, 84, 89, 95, 67, 79, 78, 84, 69, 88, 84, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 32, 61, 32, 97, 114, 103, 112, 97, 114, 115, 101, 46, 65, 114, 103, 117, 109, 101, 110, 116, 80, 97, 114, 115, 101, 114, 40, 112, 114, 111, 103, 61, 34, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 34, 41, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 99, 111, 110, 116, 101, 120, 116, 45, 115, 116, 114, 105, 110, 103, 34, 44, 32, 100, 101, 115, 116, 61, 34, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 34, 34, 41, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 111, 117, 116, 112, 117, 116, 45, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 100, 101, 115, 116, 61, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 114, 101, 113, 117, 105, 114, 101, 100, 61, 84, 114, 117, 101, 41, 10, 32, 32, 32, 32, 97, 114, 103, 115, 32, 61, 32, 112, 97, 114, 115, 101, 114, 46, 112, 97, 114, 115, 101, 95, 97, 114, 103, 115, 40, 41, 10, 10, 32, 32, 32, 32, 111, 115, 46, 109, 97, 107, 101, 100, 105, 114, 115, 40, 111, 115, 46, 112, 97, 116, 104, 46, 100, 105, 114, 110, 97, 109, 101, 40, 97, 114, 103, 115, 46, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 44, 32, 101, 120, 105, 115, 116, 95, 111, 107, 61, 84, 114, 117, 101, 41, 10, 32, 32, 32, 32, 119, 105, 116, 104, 32, 111, 112, 101, 110, 40, 97, 114, 103, 115, 46, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 44, 32, 34, 119, 43, 34, 41, 32, 97, 115, 32, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 97, 114, 103, 115, 46, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 111, 114, 32, 69, 77, 80, 84, 89, 95, 67, 79, 78, 84, 69, 88, 84, 41, 10})
+	box.Add("/kfp/get_run_info.py", []byte{105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 112, 112, 10, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 10, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 40, 114, 117, 110, 95, 105, 100, 41, 58, 10, 32, 32, 32, 32, 34, 34, 34, 82, 101, 116, 117, 114, 110, 115, 32, 116, 104, 101, 32, 105, 110, 102, 111, 32, 111, 102, 32, 116, 104, 101, 32, 99, 117, 114, 114, 101, 110, 116, 32, 112, 105, 112, 101, 108, 105, 110, 101, 32, 114, 117, 110, 44, 32, 112, 105, 99, 107, 108, 101, 100, 32, 97, 110, 100, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 101, 100, 32, 102, 111, 114, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 34, 34, 34, 10, 32, 32, 32, 32, 112, 114, 105, 110, 116, 40, 102, 34, 67, 117, 114, 114, 101, 110, 116, 32, 114, 117, 110, 32, 73, 68, 32, 105, 115, 32, 123, 114, 117, 110, 95, 105, 100, 125, 46, 34, 41, 10, 32, 32, 32, 32, 99, 108, 105, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 67, 108, 105, 101, 110, 116, 40, 104, 111, 115, 116, 61, 34, 104, 116, 116, 112, 58, 47, 47, 109, 108, 45, 112, 105, 112, 101, 108, 105, 110, 101, 58, 56, 56, 56, 56, 34, 41, 10, 32, 32, 32, 32, 114, 117, 110, 95, 105, 110, 102, 111, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 103, 101, 116, 95, 114, 117, 110, 40, 114, 117, 110, 95, 105, 100, 61, 114, 117, 110, 95, 105, 100, 41, 10, 32, 32, 32, 32, 35, 32, 72, 105, 100, 101, 32, 118, 101, 114, 98, 111, 115, 101, 32, 105, 110, 102, 111, 10, 32, 32, 32, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 119, 111, 114, 107, 102, 108, 111, 119, 95, 109, 97, 110, 105, 102, 101, 115, 116, 32, 61, 32, 78, 111, 110, 101, 10, 10, 32, 32, 32, 32, 112, 112, 40, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 41, 10, 10, 32, 32, 32, 32, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 105, 100, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 110, 97, 109, 101, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 110, 97, 109, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 44, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 111, 114, 32, 114, 32, 105, 110, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 114, 101, 115, 111, 117, 114, 99, 101, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 58, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 102, 34, 123, 114, 46, 107, 101, 121, 46, 116, 121, 112, 101, 46, 108, 111, 119, 101, 114, 40, 41, 125, 95, 105, 100, 34, 93, 32, 61, 32, 114, 46, 107, 101, 121, 46, 105, 100, 10, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 115, 116, 114, 40, 98, 97, 115, 101, 54, 52, 46, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 32, 61, 32, 97, 114, 103, 112, 97, 114, 115, 101, 46, 65, 114, 103, 117, 109, 101, 110, 116, 80, 97, 114, 115, 101, 114, 40, 112, 114, 111, 103, 61, 34, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 34, 41, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 114, 117, 110, 45, 105, 100, 34, 44, 32, 100, 101, 115, 116, 61, 34, 114, 117, 110, 95, 105, 100, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 114, 101, 113, 117, 105, 114, 101, 100, 61, 84, 114, 117, 101, 41, 10, 32, 32, 32, 32, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 114, 117, 110, 45, 105, 110, 102, 111, 34, 44, 32, 100, 101, 115, 116, 61, 34, 114, 117, 110, 95, 105, 110, 102, 111, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 114, 101, 113, 117, 105, 114, 101, 100, 61, 84, 114, 117, 101, 41, 10, 32, 32, 32, 32, 97, 114, 103, 115, 32, 61, 32, 112, 97, 114, 115, 101, 114, 46, 112, 97, 114, 115, 101, 95, 97, 114, 103, 115, 40, 41, 10, 10, 32, 32, 32, 32, 114, 117, 110, 95, 105, 110, 102, 111, 32, 61, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 40, 97, 114, 103, 115, 46, 114, 117, 110, 95, 105, 100, 41, 10, 32, 32, 32, 32, 111, 115, 46, 109, 97, 107, 101, 100, 105, 114, 115, 40, 111, 115, 46, 112, 97, 116, 104, 46, 100, 105, 114, 110, 97, 109, 101, 40, 97, 114, 103, 115, 46, 114, 117, 110, 95, 105, 110, 102, 111, 95, 112, 97, 116, 104, 41, 44, 32, 101, 120, 105, 115, 116, 95, 111, 107, 61, 84, 114, 117, 101, 41, 10, 32, 32, 32, 32, 119, 105, 116, 104, 32, 111, 112, 101, 110, 40, 97, 114, 103, 115, 46, 114, 117, 110, 95, 105, 110, 102, 111, 95, 112, 97, 116, 104, 44, 32, 34, 119, 34, 41, 32, 97, 115, 32, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 114, 117, 110, 95, 105, 110, 102, 111, 41, 10})
+	box.Add("/kfp/root.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 46, 100, 115, 108, 32, 97, 115, 32, 100, 115, 108, 10, 102, 114, 111, 109, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 32, 105, 109, 112, 111, 114, 116, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 44, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 44, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 10, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 46, 99, 111, 109, 112, 105, 108, 101, 114, 32, 97, 115, 32, 99, 111, 109, 112, 105, 108, 101, 114, 10, 102, 114, 111, 109, 32, 107, 102, 112, 46, 100, 115, 108, 46, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 68, 105, 99, 116, 32, 97, 115, 32, 75, 70, 80, 68, 105, 99, 116, 44, 32, 76, 105, 115, 116, 32, 97, 115, 32, 75, 70, 80, 76, 105, 115, 116, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 105, 109, 112, 111, 114, 116, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 10, 102, 114, 111, 109, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 99, 108, 105, 101, 110, 116, 44, 32, 99, 111, 110, 102, 105, 103, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 105, 109, 112, 111, 114, 116, 32, 123, 123, 32, 115, 116, 101, 112, 46, 78, 97, 109, 101, 32, 125, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 100, 101, 102, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 40, 10, 9, 114, 117, 110, 95, 105, 100, 58, 32, 115, 116, 114, 44, 10, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 34, 82, 117, 110, 73, 110, 102, 111, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 40, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 34, 34, 34, 69, 120, 97, 109, 112, 108, 101, 32, 111, 102, 32, 103, 101, 116, 116, 105, 110, 103, 32, 114, 117, 110, 32, 105, 110, 102, 111, 32, 102, 111, 114, 32, 99, 117, 114, 114, 101, 110, 116, 32, 112, 105, 112, 101, 108, 105, 110, 101, 32, 114, 117, 110, 34, 34, 34, 10, 9, 105, 109, 112, 111, 114, 116, 32, 107, 102, 112, 10, 9, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 102, 114, 111, 109, 32, 100, 97, 116, 101, 117, 116, 105, 108, 46, 116, 122, 32, 105, 109, 112, 111, 114, 116, 32, 116, 122, 108, 111, 99, 97, 108, 10, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 112, 112, 10, 10, 9, 112, 114, 105, 110, 116, 40, 102, 34, 67, 117, 114, 114, 101, 110, 116, 32, 114, 117, 110, 32, 73, 68, 32, 105, 115, 32, 123, 114, 117, 110, 95, 105, 100, 125, 46, 34, 41, 10, 9, 99, 108, 105, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 67, 108, 105, 101, 110, 116, 40, 104, 111, 115, 116, 61, 34, 104, 116, 116, 112, 58, 47, 47, 109, 108, 45, 112, 105, 112, 101, 108, 105, 110, 101, 58, 56, 56, 56, 56, 34, 41, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 103, 101, 116, 95, 114, 117, 110, 40, 114, 117, 110, 95, 105, 100, 61, 114, 117, 110, 95, 105, 100, 41, 10, 9, 35, 32, 72, 105, 100, 101, 32, 118, 101, 114, 98, 111, 115, 101, 32, 105, 110, 102, 111, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 119, 111, 114, 107, 102, 108, 111, 119, 95, 109, 97, 110, 105, 102, 101, 115, 116, 32, 61, 32, 78, 111, 110, 101, 10, 10, 9, 102, 114, 111, 109, 32, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 32, 105, 109, 112, 111, 114, 116, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 10, 10, 9, 112, 112, 40, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 41, 10, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 123, 10, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 105, 100, 44, 10, 9, 9, 34, 110, 97, 109, 101, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 110, 97, 109, 101, 44, 10, 9, 9, 34, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 99, 114, 101, 97, 116, 101, 100, 95, 97, 116, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 34, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 34, 58, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 115, 112, 101, 99, 46, 112, 105, 112, 101, 108, 105, 110, 101, 95, 105, 100, 44, 10, 9, 125, 10, 9, 102, 111, 114, 32, 114, 32, 105, 110, 32, 114, 117, 110, 95, 105, 110, 102, 111, 46, 114, 117, 110, 46, 114, 101, 115, 111, 117, 114, 99, 101, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 58, 10, 9, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 102, 34, 123, 114, 46, 107, 101, 121, 46, 116, 121, 112, 101, 46, 108, 111, 119, 101, 114, 40, 41, 125, 95, 105, 100, 34, 93, 32, 61, 32, 114, 46, 107, 101, 121, 46, 105, 100, 10, 10, 9, 111, 117, 116, 112, 117, 116, 32, 61, 32, 110, 97, 109, 101, 100, 116, 117, 112, 108, 101, 40, 34, 82, 117, 110, 73, 110, 102, 111, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 41, 10, 9, 114, 101, 116, 117, 114, 110, 32, 111, 117, 116, 112, 117, 116, 40, 10, 9, 9, 115, 116, 114, 40, 98, 97, 115, 101, 54, 52, 46, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 9, 41, 10, 10, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 46, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 102, 117, 110, 99, 61, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 44, 10, 9, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 61, 34, 123, 123, 72, 101, 108, 112, 101, 114, 73, 109, 97, 103, 101, 125, 125, 34, 44, 10, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 10, 9, 9, 34, 107, 102, 112, 34, 44, 10, 9, 9, 34, 100, 105, 108, 108, 34, 44, 10, 9, 93, 44, 10, 41, 10, 10, 100, 101, 102, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 40, 10, 9, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 41, 58, 10, 9, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 119, 105, 116, 104, 32, 95, 95, 112, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 9, 9, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 10, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 32, 61, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 46, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 102, 117, 110, 99, 61, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 44, 10, 9, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 61, 34, 123, 123, 72, 101, 108, 112, 101, 114, 73, 109, 97, 103, 101, 125, 125, 34, 44, 10, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 10, 9, 9, 34, 107, 102, 112, 34, 44, 10, 9, 9, 34, 100, 105, 108, 108, 34, 44, 10, 9, 93, 44, 10, 41, 10, 10, 64, 100, 115, 108, 46, 112, 105, 112, 101, 108, 105, 110, 101, 40, 110, 97, 109, 101, 61, 34, 67, 111, 109, 112, 105, 108, 97, 116, 105, 111, 110, 32, 111, 102, 32, 112, 105, 112, 101, 108, 105, 110, 101, 115, 34, 44, 41, 10, 100, 101, 102, 32, 114, 111, 111, 116, 40, 123, 123, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 125, 125, 123, 37, 32, 105, 102, 32, 82, 111, 111, 116, 80, 97, 114, 97, 109, 101, 116, 101, 114, 83, 116, 114, 105, 110, 103, 32, 37, 125, 44, 32, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 99, 111, 110, 116, 101, 120, 116, 61, 39, 39, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 39, 39, 41, 58, 10, 10, 9, 35, 32, 84, 104, 101, 32, 98, 101, 108, 111, 119, 32, 105, 115, 32, 98, 97, 115, 101, 54, 52, 32, 101, 110, 99, 111, 100, 105, 110, 103, 32, 111, 102, 32, 97, 110, 32, 101, 109, 112, 116, 121, 32, 108, 111, 99, 97, 108, 115, 40, 41, 32, 111, 117, 116, 112, 117, 116, 10, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 34, 10, 9, 105, 102, 32, 99, 111, 110, 116, 101, 120, 116, 32, 61, 61, 32, 39, 39, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 101, 108, 115, 101, 58, 10, 9, 9, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 32, 61, 32, 99, 111, 110, 116, 101, 120, 116, 10, 10, 9, 115, 101, 99, 114, 101, 116, 115, 95, 98, 121, 95, 101, 110, 118, 32, 61, 32, 123, 125, 10, 10, 35, 32, 71, 101, 110, 101, 114, 97, 116, 101, 32, 115, 101, 99, 114, 101, 116, 115, 32, 40, 105, 102, 32, 110, 111, 116, 32, 97, 108, 114, 101, 97, 100, 121, 32, 99, 114, 101, 97, 116, 101, 100, 41, 10, 123, 37, 32, 102, 111, 114, 32, 115, 101, 99, 114, 101, 116, 32, 105, 110, 32, 83, 101, 99, 114, 101, 116, 115, 84, 111, 67, 114, 101, 97, 116, 101, 32, 37, 125, 10, 9, 99, 111, 110, 102, 105, 103, 46, 108, 111, 97, 100, 95, 107, 117, 98, 101, 95, 99, 111, 110, 102, 105, 103, 40, 41, 10, 9, 118, 49, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 67, 111, 114, 101, 86, 49, 65, 112, 105, 40, 41, 10, 9, 110, 97, 109, 101, 115, 112, 97, 99, 101, 32, 61, 32, 34, 107, 117, 98, 101, 102, 108, 111, 119, 34, 10, 9, 110, 97, 109, 101, 32, 61, 32, 34, 123, 123, 32, 83, 97, 102, 101, 69, 120, 112, 101, 114, 105, 109, 101, 110, 116, 78, 97, 109, 101, 32, 125, 125, 34, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 32, 61, 32, 123, 34, 110, 97, 109, 101, 34, 58, 32, 110, 97, 109, 101, 44, 32, 34, 110, 97, 109, 101, 115, 112, 97, 99, 101, 34, 58, 32, 34, 107, 117, 98, 101, 102, 108, 111, 119, 34, 125, 10, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 32, 61, 32, 34, 118, 49, 34, 10, 9, 107, 105, 110, 100, 32, 61, 32, 34, 83, 101, 99, 114, 101, 116, 34, 10, 9, 116, 121, 112, 101, 32, 61, 32, 34, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 105, 111, 47, 100, 111, 99, 107, 101, 114, 99, 111, 110, 102, 105, 103, 106, 115, 111, 110, 34, 10, 10, 9, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 32, 61, 32, 123, 10, 9, 9, 34, 97, 117, 116, 104, 115, 34, 58, 32, 123, 10, 9, 9, 9, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 58, 32, 123, 10, 9, 9, 9, 9, 34, 117, 115, 101, 114, 110, 97, 109, 101, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 85, 115, 101, 114, 110, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 112, 97, 115, 115, 119, 111, 114, 100, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 80, 97, 115, 115, 119, 111, 114, 100, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 101, 109, 97, 105, 108, 34, 58, 32, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 69, 109, 97, 105, 108, 125, 125, 34, 44, 10, 9, 9, 9, 9, 34, 97, 117, 116, 104, 34, 58, 32, 98, 97, 115, 101, 54, 52, 46, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 10, 9, 9, 9, 9, 9, 102, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 85, 115, 101, 114, 110, 97, 109, 101, 125, 125, 58, 123, 123, 115, 101, 99, 114, 101, 116, 46, 80, 97, 115, 115, 119, 111, 114, 100, 125, 125, 34, 46, 101, 110, 99, 111, 100, 101, 40, 41, 10, 9, 9, 9, 9, 41, 46, 100, 101, 99, 111, 100, 101, 40, 41, 44, 10, 9, 9, 9, 125, 10, 9, 9, 125, 10, 9, 125, 10, 10, 9, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 34, 46, 100, 111, 99, 107, 101, 114, 99, 111, 110, 102, 105, 103, 106, 115, 111, 110, 34, 58, 32, 98, 97, 115, 101, 54, 52, 46, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 106, 115, 111, 110, 46, 100, 117, 109, 112, 115, 40, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 41, 46, 101, 110, 99, 111, 100, 101, 40, 41, 41, 46, 100, 101, 99, 111, 100, 101, 40, 41, 10, 9, 125, 10, 10, 9, 115, 101, 99, 114, 101, 116, 32, 61, 32, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 40, 10, 9, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 61, 34, 118, 49, 34, 44, 10, 9, 9, 100, 97, 116, 97, 61, 100, 97, 116, 97, 44, 10, 9, 9, 107, 105, 110, 100, 61, 34, 83, 101, 99, 114, 101, 116, 34, 44, 10, 9, 9, 109, 101, 116, 97, 100, 97, 116, 97, 61, 109, 101, 116, 97, 100, 97, 116, 97, 44, 10, 9, 9, 116, 121, 112, 101, 61, 116, 121, 112, 101, 44, 10, 9, 41, 10, 9, 98, 111, 100, 121, 32, 61, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 40, 10, 9, 9, 97, 112, 105, 95, 118, 101, 114, 115, 105, 111, 110, 44, 32, 100, 97, 116, 97, 44, 32, 107, 105, 110, 100, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 44, 32, 116, 121, 112, 101, 61, 116, 121, 112, 101, 10, 9, 41, 10, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 78, 111, 110, 101, 10, 9, 116, 114, 121, 58, 10, 9, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 118, 49, 46, 99, 114, 101, 97, 116, 101, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 100, 95, 115, 101, 99, 114, 101, 116, 40, 110, 97, 109, 101, 115, 112, 97, 99, 101, 44, 32, 98, 111, 100, 121, 41, 10, 9, 101, 120, 99, 101, 112, 116, 32, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 46, 99, 108, 105, 101, 110, 116, 46, 114, 101, 115, 116, 46, 65, 112, 105, 69, 120, 99, 101, 112, 116, 105, 111, 110, 32, 97, 115, 32, 101, 58, 10, 9, 9, 105, 102, 32, 101, 46, 115, 116, 97, 116, 117, 115, 32, 61, 61, 32, 52, 48, 57, 58, 10, 9, 9, 9, 105, 102, 32, 40, 10, 9, 9, 9, 9, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 117, 115, 101, 114, 110, 97, 109, 101, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 112, 97, 115, 115, 119, 111, 114, 100, 34, 93, 10, 9, 9, 9, 9, 97, 110, 100, 32, 99, 114, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 91, 34, 97, 117, 116, 104, 115, 34, 93, 91, 34, 123, 123, 115, 101, 99, 114, 101, 116, 46, 83, 101, 114, 118, 101, 114, 125, 125, 34, 93, 91, 34, 101, 109, 97, 105, 108, 34, 93, 10, 9, 9, 9, 41, 58, 10, 9, 9, 9, 9, 97, 112, 105, 95, 114, 101, 115, 112, 111, 110, 115, 101, 32, 61, 32, 118, 49, 46, 114, 101, 112, 108, 97, 99, 101, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 100, 95, 115, 101, 99, 114, 101, 116, 40, 110, 97, 109, 101, 44, 32, 110, 97, 109, 101, 115, 112, 97, 99, 101, 44, 32, 98, 111, 100, 121, 41, 10, 9, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 105, 115, 115, 105, 110, 103, 32, 118, 97, 108, 117, 101, 34, 41, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 114, 97, 105, 115, 101, 32, 101, 10, 10, 9, 100, 115, 108, 46, 103, 101, 116, 95, 112, 105, 112, 101, 108, 105, 110, 101, 95, 99, 111, 110, 102, 40, 41, 46, 115, 101, 116, 95, 105, 109, 97, 103, 101, 95, 112, 117, 108, 108, 95, 115, 101, 99, 114, 101, 116, 115, 40, 91, 99, 108, 105, 101, 110, 116, 46, 86, 49, 76, 111, 99, 97, 108, 79, 98, 106, 101, 99, 116, 82, 101, 102, 101, 114, 101, 110, 99, 101, 40, 110, 97, 109, 101, 61, 110, 97, 109, 101, 41, 93, 41, 10, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 9, 39, 39, 39, 107, 102, 112, 46, 100, 115, 108, 46, 82, 85, 78, 95, 73, 68, 95, 80, 76, 65, 67, 69, 72, 79, 79, 76, 68, 69, 82, 32, 105, 110, 115, 105, 100, 101, 32, 97, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 119, 105, 108, 108, 32, 98, 101, 32, 112, 111, 112, 117, 108, 97, 116, 101, 100, 32, 119, 105, 116, 104, 32, 75, 70, 80, 32, 82, 117, 110, 32, 73, 68, 32, 97, 116, 32, 114, 117, 110, 116, 105, 109, 101, 46, 39, 39, 39, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 95, 111, 112, 32, 61, 32, 103, 101, 116, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 40, 114, 117, 110, 95, 105, 100, 61, 107, 102, 112, 46, 100, 115, 108, 46, 82, 85, 78, 95, 73, 68, 95, 80, 76, 65, 67, 69, 72, 79, 76, 68, 69, 82, 41, 10, 10, 9, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 111, 112, 32, 61, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 40, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 61, 95, 95, 111, 114, 105, 103, 105, 110, 97, 108, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 10, 123, 37, 32, 102, 111, 114, 32, 115, 116, 101, 112, 32, 105, 110, 32, 83, 116, 101, 112, 115, 32, 37, 125, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 111, 112, 32, 61, 32, 99, 114, 101, 97, 116, 101, 95, 99, 111, 109, 112, 111, 110, 101, 110, 116, 95, 102, 114, 111, 109, 95, 102, 117, 110, 99, 40, 10, 9, 9, 102, 117, 110, 99, 61, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 46, 103, 101, 110, 101, 114, 97, 116, 101, 100, 95, 109, 97, 105, 110, 44, 10, 9, 9, 98, 97, 115, 101, 95, 105, 109, 97, 103, 101, 61, 34, 123, 123, 115, 116, 101, 112, 46, 73, 109, 97, 103, 101, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 112, 97, 99, 107, 97, 103, 101, 115, 95, 116, 111, 95, 105, 110, 115, 116, 97, 108, 108, 61, 91, 34, 100, 105, 108, 108, 34, 44, 32, 34, 114, 101, 113, 117, 101, 115, 116, 115, 34, 44, 32, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 34, 98, 111, 116, 111, 51, 34, 44, 32, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 80, 97, 99, 107, 97, 103, 101, 83, 116, 114, 105, 110, 103, 125, 125, 93, 44, 10, 9, 41, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 32, 61, 32, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 111, 112, 40, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 61, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 46, 48, 125, 125, 95, 116, 97, 115, 107, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 99, 114, 101, 97, 116, 101, 95, 99, 111, 110, 116, 101, 120, 116, 95, 102, 105, 108, 101, 95, 111, 112, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 46, 111, 117, 116, 112, 117, 116, 115, 91, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 93, 44, 32, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 115, 116, 101, 112, 46, 69, 120, 116, 114, 97, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 61, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 95, 116, 97, 115, 107, 46, 111, 117, 116, 112, 117, 116, 115, 91, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 34, 93, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 114, 117, 110, 95, 105, 110, 102, 111, 61, 114, 117, 110, 95, 105, 110, 102, 111, 95, 111, 112, 46, 111, 117, 116, 112, 117, 116, 115, 91, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 93, 44, 32, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 44, 32, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 61, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 41, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 100, 105, 115, 112, 108, 97, 121, 95, 110, 97, 109, 101, 40, 34, 123, 123, 115, 116, 101, 112, 46, 68, 105, 115, 112, 108, 97, 121, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 67, 97, 99, 104, 101, 86, 97, 108, 117, 101, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 101, 120, 101, 99, 117, 116, 105, 111, 110, 95, 111, 112, 116, 105, 111, 110, 115, 46, 99, 97, 99, 104, 105, 110, 103, 95, 115, 116, 114, 97, 116, 101, 103, 121, 46, 109, 97, 120, 95, 99, 97, 99, 104, 101, 95, 115, 116, 97, 108, 101, 110, 101, 115, 115, 32, 61, 32, 34, 123, 123, 115, 116, 101, 112, 46, 67, 97, 99, 104, 101, 86, 97, 108, 117, 101, 125, 125, 34, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 67, 80, 85, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 99, 112, 117, 95, 108, 105, 109, 105, 116, 40, 34, 123, 123, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 67, 80, 85, 125, 125, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 77, 101, 109, 111, 114, 121, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 109, 101, 109, 111, 114, 121, 95, 108, 105, 109, 105, 116, 40, 34, 123, 123, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 77, 101, 109, 111, 114, 121, 125, 125, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 71, 80, 85, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 103, 112, 117, 95, 108, 105, 109, 105, 116, 40, 34, 123, 123, 115, 116, 101, 112, 46, 82, 101, 115, 111, 117, 114, 99, 101, 115, 46, 71, 80, 85, 125, 125, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 71, 80, 85, 78, 111, 100, 101, 83, 101, 108, 101, 99, 116, 111, 114, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 97, 100, 100, 95, 110, 111, 100, 101, 95, 115, 101, 108, 101, 99, 116, 111, 114, 95, 99, 111, 110, 115, 116, 114, 97, 105, 110, 116, 40, 34, 123, 123, 115, 116, 101, 112, 46, 71, 80, 85, 78, 111, 100, 101, 83, 101, 108, 101, 99, 116, 111, 114, 75, 101, 121, 125, 125, 34, 44, 32, 34, 123, 123, 115, 116, 101, 112, 46, 71, 80, 85, 78, 111, 100, 101, 83, 101, 108, 101, 99, 116, 111, 114, 125, 125, 34, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 82, 101, 116, 114, 105, 101, 115, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 114, 101, 116, 114, 121, 40, 123, 123, 115, 116, 101, 112, 46, 82, 101, 116, 114, 105, 101, 115, 125, 125, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 105, 102, 32, 115, 116, 101, 112, 46, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 115, 101, 116, 95, 116, 105, 109, 101, 111, 117, 116, 40, 123, 123, 115, 116, 101, 112, 46, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 125, 125, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 102, 111, 114, 32, 101, 110, 118, 95, 118, 97, 114, 32, 105, 110, 32, 115, 116, 101, 112, 46, 69, 110, 118, 86, 97, 114, 115, 32, 37, 125, 10, 9, 123, 37, 32, 105, 102, 32, 101, 110, 118, 95, 118, 97, 114, 46, 83, 101, 99, 114, 101, 116, 78, 97, 109, 101, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 97, 100, 100, 95, 101, 110, 118, 95, 118, 97, 114, 105, 97, 98, 108, 101, 40, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 40, 110, 97, 109, 101, 61, 34, 123, 123, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 118, 97, 108, 117, 101, 95, 102, 114, 111, 109, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 83, 111, 117, 114, 99, 101, 40, 115, 101, 99, 114, 101, 116, 95, 107, 101, 121, 95, 114, 101, 102, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 75, 101, 121, 83, 101, 108, 101, 99, 116, 111, 114, 40, 110, 97, 109, 101, 61, 34, 123, 123, 101, 110, 118, 95, 118, 97, 114, 46, 83, 101, 99, 114, 101, 116, 78, 97, 109, 101, 125, 125, 34, 44, 32, 107, 101, 121, 61, 34, 123, 123, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 125, 125, 34, 41, 41, 41, 41, 10, 9, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 97, 100, 100, 95, 101, 110, 118, 95, 118, 97, 114, 105, 97, 98, 108, 101, 40, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 40, 110, 97, 109, 101, 61, 34, 123, 123, 101, 110, 118, 95, 118, 97, 114, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 118, 97, 108, 117, 101, 61, 123, 123, 101, 110, 118, 95, 118, 97, 114, 46, 86, 97, 108, 117, 101, 125, 125, 41, 41, 10, 9, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 97, 100, 100, 95, 101, 110, 118, 95, 118, 97, 114, 105, 97, 98, 108, 101, 40, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 40, 110, 97, 109, 101, 61, 34, 65, 87, 83, 95, 65, 67, 67, 69, 83, 83, 95, 75, 69, 89, 95, 73, 68, 34, 44, 32, 118, 97, 108, 117, 101, 95, 102, 114, 111, 109, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 83, 111, 117, 114, 99, 101, 40, 115, 101, 99, 114, 101, 116, 95, 107, 101, 121, 95, 114, 101, 102, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 75, 101, 121, 83, 101, 108, 101, 99, 116, 111, 114, 40, 110, 97, 109, 101, 61, 34, 123, 123, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 83, 101, 99, 114, 101, 116, 125, 125, 34, 44, 32, 107, 101, 121, 61, 34, 97, 99, 99, 101, 115, 115, 107, 101, 121, 34, 41, 41, 41, 41, 10, 9, 123, 123, 115, 116, 101, 112, 46, 78, 97, 109, 101, 125, 125, 95, 116, 97, 115, 107, 46, 97, 100, 100, 95, 101, 110, 118, 95, 118, 97, 114, 105, 97, 98, 108, 101, 40, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 40, 110, 97, 109, 101, 61, 34, 65, 87, 83, 95, 83, 69, 67, 82, 69, 84, 95, 65, 67, 67, 69, 83, 83, 95, 75, 69, 89, 34, 44, 32, 118, 97, 108, 117, 101, 95, 102, 114, 111, 109, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 69, 110, 118, 86, 97, 114, 83, 111, 117, 114, 99, 101, 40, 115, 101, 99, 114, 101, 116, 95, 107, 101, 121, 95, 114, 101, 102, 61, 99, 108, 105, 101, 110, 116, 46, 86, 49, 83, 101, 99, 114, 101, 116, 75, 101, 121, 83, 101, 108, 101, 99, 116, 111, 114, 40, 110, 97, 109, 101, 61, 34, 123, 123, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 67, 114, 101, 100, 101, 110, 116, 105, 97, 108, 115, 83, 101, 99, 114, 101, 116, 125, 125, 34, 44, 32, 107, 101, 121, 61, 34, 115, 101, 99, 114, 101, 116, 107, 101, 121, 34, 41, 41, 41, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 115, 116, 101, 112, 46, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 10, 9, 123, 123, 32, 115, 116, 101, 112, 46, 78, 97, 109, 101, 32, 125, 125, 95, 116, 97, 115, 107, 46, 97, 102, 116, 101, 114, 40, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 95, 116, 97, 115, 107, 41, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
+	box.Add("/kfp/step.tmpl", []byte{123, 37, 32, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 111, 102, 102, 32, 37, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 97, 114, 103, 112, 97, 114, 115, 101, 32, 97, 115, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 10, 102, 114, 111, 109, 32, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 110, 116, 101, 120, 116, 10, 105, 109, 112, 111, 114, 116, 32, 112, 97, 116, 104, 108, 105, 98, 10, 102, 114, 111, 109, 32, 116, 121, 112, 105, 110, 103, 32, 105, 109, 112, 111, 114, 116, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 10, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 105, 109, 112, 111, 114, 116, 32, 111, 115, 10, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 10, 9, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 32, 97, 115, 32, 95, 95, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 44, 10, 41, 10, 116, 114, 121, 58, 10, 9, 102, 114, 111, 109, 32, 107, 102, 112, 46, 99, 111, 109, 112, 111, 110, 101, 110, 116, 115, 32, 105, 109, 112, 111, 114, 116, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 44, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 10, 101, 120, 99, 101, 112, 116, 32, 73, 109, 112, 111, 114, 116, 69, 114, 114, 111, 114, 58, 10, 9, 35, 32, 82, 117, 110, 32, 97, 115, 32, 97, 32, 112, 114, 111, 103, 114, 97, 109, 32, 40, 115, 101, 101, 32, 116, 104, 101, 32, 101, 110, 100, 32, 111, 102, 32, 116, 104, 105, 115, 32, 102, 105, 108, 101, 41, 32, 116, 104, 101, 32, 115, 116, 101, 112, 32, 100, 111, 101, 115, 110, 39, 116, 32, 110, 101, 101, 100, 32, 107, 102, 112, 44, 32, 111, 110, 108, 121, 32, 105, 116, 115, 32, 97, 110, 110, 111, 116, 97, 116, 105, 111, 110, 115, 32, 100, 111, 10, 9, 73, 110, 112, 117, 116, 80, 97, 116, 104, 32, 61, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 32, 61, 32, 108, 97, 109, 98, 100, 97, 32, 95, 95, 116, 121, 112, 101, 58, 32, 95, 95, 116, 121, 112, 101, 10, 10, 100, 101, 102, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 95, 109, 97, 105, 110, 40, 10, 9, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 69, 120, 116, 114, 97, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 9, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 95, 112, 97, 116, 104, 58, 32, 73, 110, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 58, 32, 79, 117, 116, 112, 117, 116, 80, 97, 116, 104, 40, 115, 116, 114, 41, 44, 10, 9, 114, 117, 110, 95, 105, 110, 102, 111, 61, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 44, 10, 9, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 34, 34, 44, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 61, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 68, 101, 102, 97, 117, 108, 116, 32, 125, 125, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 41, 58, 10, 9, 123, 123, 32, 83, 111, 117, 114, 99, 101, 77, 97, 112, 32, 125, 125, 10, 9, 102, 114, 111, 109, 32, 112, 97, 116, 104, 108, 105, 98, 32, 105, 109, 112, 111, 114, 116, 32, 80, 97, 116, 104, 32, 97, 115, 32, 95, 95, 80, 97, 116, 104, 10, 10, 9, 35, 32, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 97, 114, 114, 105, 118, 101, 32, 97, 115, 32, 115, 116, 114, 105, 110, 103, 115, 32, 45, 32, 97, 110, 121, 116, 104, 105, 110, 103, 32, 116, 104, 97, 116, 32, 105, 115, 110, 39, 116, 32, 97, 32, 115, 116, 114, 105, 110, 103, 32, 119, 97, 115, 32, 74, 83, 79, 78, 32, 101, 110, 99, 111, 100, 101, 100, 32, 98, 121, 32, 83, 65, 77, 69, 10, 9, 100, 101, 102, 32, 95, 95, 100, 101, 99, 111, 100, 101, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 40, 95, 95, 118, 97, 108, 117, 101, 44, 32, 95, 95, 116, 121, 112, 101, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 106, 115, 111, 110, 10, 10, 9, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 115, 116, 114, 105, 110, 103, 34, 58, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 118, 97, 108, 117, 101, 10, 9, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 98, 111, 111, 108, 34, 58, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 115, 116, 114, 40, 95, 95, 118, 97, 108, 117, 101, 41, 46, 115, 116, 114, 105, 112, 40, 41, 46, 108, 111, 119, 101, 114, 40, 41, 32, 105, 110, 32, 40, 34, 116, 114, 117, 101, 34, 44, 32, 34, 49, 34, 44, 32, 34, 121, 101, 115, 34, 41, 10, 9, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 105, 110, 116, 34, 58, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 105, 110, 116, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 9, 9, 105, 102, 32, 95, 95, 116, 121, 112, 101, 32, 61, 61, 32, 34, 102, 108, 111, 97, 116, 34, 58, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 108, 111, 97, 116, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 106, 115, 111, 110, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 97, 108, 117, 101, 41, 10, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 9, 35, 32, 67, 111, 110, 116, 101, 120, 116, 115, 32, 108, 97, 114, 103, 101, 114, 32, 116, 104, 97, 110, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 32, 98, 121, 116, 101, 115, 32, 97, 114, 101, 32, 116, 111, 111, 32, 108, 97, 114, 103, 101, 32, 116, 111, 32, 112, 97, 115, 115, 32, 98, 101, 116, 119, 101, 101, 110, 32, 115, 116, 101, 112, 115, 32, 105, 110, 108, 105, 110, 101, 44, 32, 115, 111, 10, 9, 35, 32, 116, 104, 101, 105, 114, 32, 108, 97, 114, 103, 101, 115, 116, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 97, 114, 101, 32, 119, 114, 105, 116, 116, 101, 110, 32, 116, 111, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 32, 97, 110, 100, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 97, 115, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 32, 45, 32, 115, 101, 101, 32, 39, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 39, 10, 9, 35, 32, 105, 110, 32, 116, 104, 101, 32, 83, 65, 77, 69, 32, 102, 105, 108, 101, 10, 9, 100, 101, 102, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 98, 111, 116, 111, 51, 10, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 98, 111, 116, 111, 51, 46, 99, 108, 105, 101, 110, 116, 40, 34, 115, 51, 34, 44, 32, 101, 110, 100, 112, 111, 105, 110, 116, 95, 117, 114, 108, 61, 34, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 100, 112, 111, 105, 110, 116, 32, 125, 125, 34, 41, 10, 10, 9, 100, 101, 102, 32, 95, 95, 114, 101, 97, 100, 95, 115, 112, 105, 108, 108, 101, 100, 40, 95, 95, 117, 114, 105, 41, 58, 10, 9, 9, 95, 95, 98, 117, 99, 107, 101, 116, 44, 32, 95, 95, 107, 101, 121, 32, 61, 32, 95, 95, 117, 114, 105, 91, 108, 101, 110, 40, 34, 115, 51, 58, 47, 47, 34, 41, 58, 93, 46, 115, 112, 108, 105, 116, 40, 34, 47, 34, 44, 32, 49, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 46, 103, 101, 116, 95, 111, 98, 106, 101, 99, 116, 40, 66, 117, 99, 107, 101, 116, 61, 95, 95, 98, 117, 99, 107, 101, 116, 44, 32, 75, 101, 121, 61, 95, 95, 107, 101, 121, 41, 91, 34, 66, 111, 100, 121, 34, 93, 46, 114, 101, 97, 100, 40, 41, 10, 10, 9, 100, 101, 102, 32, 95, 95, 115, 112, 105, 108, 108, 95, 99, 111, 110, 116, 101, 120, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 44, 32, 95, 95, 114, 117, 110, 95, 105, 100, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 10, 9, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 32, 107, 101, 101, 112, 32, 116, 104, 101, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 32, 116, 104, 101, 121, 32, 99, 97, 109, 101, 32, 119, 105, 116, 104, 10, 9, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 32, 61, 32, 123, 125, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 108, 105, 115, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 58, 10, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 32, 97, 110, 100, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 32, 61, 61, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 58, 10, 9, 9, 9, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 91, 95, 95, 107, 93, 10, 9, 9, 9, 9, 100, 101, 108, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 10, 10, 9, 9, 95, 95, 115, 105, 122, 101, 32, 61, 32, 115, 117, 109, 40, 108, 101, 110, 40, 95, 95, 118, 41, 32, 102, 111, 114, 32, 95, 95, 118, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 46, 118, 97, 108, 117, 101, 115, 40, 41, 41, 10, 9, 9, 105, 102, 32, 95, 95, 115, 105, 122, 101, 32, 62, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 58, 10, 9, 9, 9, 95, 95, 99, 108, 105, 101, 110, 116, 32, 61, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 111, 114, 97, 103, 101, 40, 41, 10, 9, 9, 9, 95, 95, 108, 97, 114, 103, 101, 115, 116, 95, 102, 105, 114, 115, 116, 32, 61, 32, 115, 111, 114, 116, 101, 100, 40, 10, 9, 9, 9, 9, 91, 95, 95, 107, 32, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 105, 102, 32, 95, 95, 107, 32, 33, 61, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 44, 10, 9, 9, 9, 9, 107, 101, 121, 61, 108, 97, 109, 98, 100, 97, 32, 95, 95, 107, 58, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 44, 10, 9, 9, 9, 9, 114, 101, 118, 101, 114, 115, 101, 61, 84, 114, 117, 101, 44, 10, 9, 9, 9, 41, 10, 9, 9, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 97, 114, 103, 101, 115, 116, 95, 102, 105, 114, 115, 116, 58, 10, 9, 9, 9, 9, 105, 102, 32, 95, 95, 115, 105, 122, 101, 32, 60, 61, 32, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 84, 104, 114, 101, 115, 104, 111, 108, 100, 66, 121, 116, 101, 115, 32, 125, 125, 58, 10, 9, 9, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 9, 9, 95, 95, 107, 101, 121, 32, 61, 32, 34, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 80, 97, 116, 104, 32, 37, 125, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 80, 97, 116, 104, 32, 125, 125, 47, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 34, 32, 43, 32, 102, 34, 123, 95, 95, 114, 117, 110, 95, 105, 100, 125, 47, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 47, 123, 95, 95, 107, 125, 34, 10, 9, 9, 9, 9, 95, 95, 99, 108, 105, 101, 110, 116, 46, 112, 117, 116, 95, 111, 98, 106, 101, 99, 116, 40, 66, 117, 99, 107, 101, 116, 61, 34, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 34, 44, 32, 75, 101, 121, 61, 95, 95, 107, 101, 121, 44, 32, 66, 111, 100, 121, 61, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 83, 112, 105, 108, 108, 101, 100, 32, 39, 123, 95, 95, 107, 125, 39, 32, 40, 123, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 95, 95, 107, 93, 41, 125, 32, 98, 121, 116, 101, 115, 41, 32, 116, 111, 32, 115, 51, 58, 47, 47, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 47, 123, 95, 95, 107, 101, 121, 125, 34, 41, 10, 9, 9, 9, 9, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 102, 34, 115, 51, 58, 47, 47, 123, 123, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 66, 117, 99, 107, 101, 116, 32, 125, 125, 47, 123, 95, 95, 107, 101, 121, 125, 34, 10, 9, 9, 9, 9, 95, 95, 115, 105, 122, 101, 32, 45, 61, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 46, 112, 111, 112, 40, 95, 95, 107, 41, 41, 10, 10, 9, 9, 105, 102, 32, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 114, 101, 102, 101, 114, 101, 110, 99, 101, 115, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 10, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 100, 101, 102, 32, 95, 95, 105, 110, 110, 101, 114, 95, 109, 97, 105, 110, 40, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 44, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 10, 9, 41, 32, 45, 62, 32, 78, 97, 109, 101, 100, 84, 117, 112, 108, 101, 40, 34, 70, 117, 110, 99, 79, 117, 116, 112, 117, 116, 34, 44, 32, 91, 40, 34, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 115, 116, 114, 41, 44, 93, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 9, 9, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 9, 9, 102, 114, 111, 109, 32, 99, 111, 112, 121, 32, 105, 109, 112, 111, 114, 116, 32, 99, 111, 112, 121, 32, 97, 115, 32, 95, 95, 99, 111, 112, 121, 10, 9, 9, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 105, 109, 112, 111, 114, 116, 108, 105, 98, 32, 97, 115, 32, 95, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 10, 9, 9, 102, 114, 111, 109, 32, 112, 112, 114, 105, 110, 116, 32, 105, 109, 112, 111, 114, 116, 32, 112, 112, 114, 105, 110, 116, 32, 97, 115, 32, 95, 95, 112, 112, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 97, 116, 101, 116, 105, 109, 101, 32, 97, 115, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 10, 10, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 41, 41, 10, 9, 9, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 32, 61, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 98, 97, 115, 101, 54, 52, 95, 100, 101, 99, 111, 100, 101, 41, 10, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 108, 111, 99, 32, 61, 32, 123, 125, 10, 10, 9, 9, 35, 32, 77, 111, 100, 117, 108, 101, 115, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 101, 97, 114, 108, 105, 101, 114, 32, 115, 116, 101, 112, 115, 32, 97, 114, 101, 32, 105, 109, 112, 111, 114, 116, 101, 100, 32, 105, 102, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 104, 97, 115, 32, 116, 104, 101, 105, 114, 32, 112, 97, 99, 107, 97, 103, 101, 115, 32, 45, 32, 105, 116, 32, 104, 97, 115, 32, 116, 104, 101, 109, 32, 102, 111, 114, 10, 9, 9, 35, 32, 101, 118, 101, 114, 121, 32, 109, 111, 100, 117, 108, 101, 32, 105, 116, 32, 117, 115, 101, 115, 10, 9, 9, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 123, 125, 10, 9, 9, 105, 102, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 9, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 41, 10, 9, 9, 102, 111, 114, 32, 95, 95, 110, 97, 109, 101, 44, 32, 95, 95, 109, 111, 100, 117, 108, 101, 32, 105, 110, 32, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 110, 97, 109, 101, 93, 32, 61, 32, 95, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 46, 105, 109, 112, 111, 114, 116, 95, 109, 111, 100, 117, 108, 101, 40, 95, 95, 109, 111, 100, 117, 108, 101, 41, 10, 9, 9, 9, 101, 120, 99, 101, 112, 116, 32, 73, 109, 112, 111, 114, 116, 69, 114, 114, 111, 114, 58, 10, 9, 9, 9, 9, 112, 97, 115, 115, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 34, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 34, 93, 32, 61, 32, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 10, 10, 9, 9, 35, 32, 79, 110, 108, 121, 32, 116, 104, 101, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 117, 115, 101, 115, 44, 32, 111, 114, 32, 112, 97, 115, 115, 101, 115, 32, 111, 110, 32, 116, 111, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 105, 116, 44, 32, 97, 114, 101, 32, 108, 111, 97, 100, 101, 100, 10, 9, 9, 95, 95, 108, 111, 97, 100, 115, 32, 61, 32, 123, 37, 32, 105, 102, 32, 76, 111, 97, 100, 115, 65, 108, 108, 32, 37, 125, 78, 111, 110, 101, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 91, 123, 37, 32, 102, 111, 114, 32, 110, 97, 109, 101, 32, 105, 110, 32, 76, 111, 97, 100, 115, 32, 37, 125, 34, 123, 123, 32, 110, 97, 109, 101, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 110, 111, 116, 32, 105, 110, 32, 40, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 44, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 41, 32, 97, 110, 100, 32, 40, 95, 95, 108, 111, 97, 100, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 111, 97, 100, 115, 41, 58, 10, 9, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 95, 95, 107, 93, 41, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 10, 9, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 115, 112, 105, 108, 108, 101, 100, 32, 98, 121, 32, 101, 97, 114, 108, 105, 101, 114, 32, 115, 116, 101, 112, 115, 32, 97, 114, 101, 32, 114, 101, 97, 100, 32, 102, 114, 111, 109, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 46, 32, 84, 104, 101, 105, 114, 32, 98, 121, 116, 101, 115, 32, 97, 114, 101, 32, 107, 101, 112, 116, 44, 32, 115, 111, 32, 116, 104, 101, 32, 111, 110, 101, 115, 32, 112, 97, 115, 115, 101, 100, 10, 9, 9, 35, 32, 111, 110, 32, 117, 110, 99, 104, 97, 110, 103, 101, 100, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 32, 114, 97, 116, 104, 101, 114, 32, 116, 104, 97, 110, 32, 119, 114, 105, 116, 116, 101, 110, 32, 97, 103, 97, 105, 110, 46, 10, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 32, 61, 32, 123, 125, 10, 9, 9, 105, 102, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 32, 105, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 58, 10, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 105, 109, 112, 111, 114, 116, 95, 100, 105, 99, 116, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 41, 10, 9, 9, 102, 111, 114, 32, 95, 95, 107, 44, 32, 95, 95, 117, 114, 105, 32, 105, 110, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 105, 102, 32, 95, 95, 108, 111, 97, 100, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 108, 111, 97, 100, 115, 58, 10, 9, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 114, 101, 97, 100, 95, 115, 112, 105, 108, 108, 101, 100, 40, 95, 95, 117, 114, 105, 41, 10, 9, 9, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 91, 95, 95, 107, 93, 32, 61, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 91, 95, 95, 107, 93, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 9, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 46, 117, 112, 100, 97, 116, 101, 40, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 41, 10, 10, 9, 9, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 105, 110, 112, 117, 116, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 125, 10, 10, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 95, 95, 101, 114, 114, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 95, 95, 101, 114, 114, 125, 34, 41, 10, 10, 9, 9, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 32, 61, 32, 34, 34, 34, 10, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 105, 109, 112, 111, 114, 116, 32, 98, 97, 115, 101, 54, 52, 10, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 102, 114, 111, 109, 32, 116, 121, 112, 101, 115, 32, 105, 109, 112, 111, 114, 116, 32, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 32, 97, 115, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 10, 10, 123, 123, 32, 73, 110, 110, 101, 114, 95, 67, 111, 100, 101, 32, 125, 125, 10, 10, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 108, 111, 99, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 32, 61, 32, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 46, 107, 101, 121, 115, 40, 41, 41, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 123, 125, 10, 10, 35, 32, 77, 111, 100, 117, 108, 101, 115, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 98, 121, 32, 110, 97, 109, 101, 32, 114, 97, 116, 104, 101, 114, 32, 116, 104, 97, 110, 32, 112, 105, 99, 107, 108, 101, 100, 44, 32, 115, 111, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 111, 110, 108, 121, 32, 110, 101, 101, 100, 32, 116, 104, 101, 32, 112, 97, 99, 107, 97, 103, 101, 115, 10, 35, 32, 102, 111, 114, 32, 116, 104, 101, 32, 109, 111, 100, 117, 108, 101, 115, 32, 116, 104, 101, 121, 32, 117, 115, 101, 46, 32, 77, 111, 100, 117, 108, 101, 115, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 103, 111, 116, 32, 98, 117, 116, 32, 99, 111, 117, 108, 100, 110, 39, 116, 32, 105, 109, 112, 111, 114, 116, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 32, 97, 115, 32, 116, 104, 101, 121, 32, 99, 97, 109, 101, 46, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 100, 105, 99, 116, 40, 95, 95, 105, 110, 99, 111, 109, 105, 110, 103, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 10, 35, 32, 79, 110, 108, 121, 32, 116, 104, 101, 32, 118, 97, 114, 105, 97, 98, 108, 101, 115, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 117, 115, 101, 32, 97, 114, 101, 32, 112, 97, 115, 115, 101, 100, 32, 111, 110, 10, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 61, 32, 123, 37, 32, 105, 102, 32, 69, 120, 112, 111, 114, 116, 115, 65, 108, 108, 32, 37, 125, 78, 111, 110, 101, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 102, 114, 111, 122, 101, 110, 115, 101, 116, 40, 91, 123, 37, 32, 102, 111, 114, 32, 110, 97, 109, 101, 32, 105, 110, 32, 69, 120, 112, 111, 114, 116, 115, 32, 37, 125, 34, 123, 123, 32, 110, 97, 109, 101, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 103, 108, 111, 98, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 58, 10, 9, 9, 105, 102, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 91, 118, 97, 108, 93, 32, 61, 32, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 46, 95, 95, 110, 97, 109, 101, 95, 95, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 46, 112, 111, 112, 40, 118, 97, 108, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 105, 102, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 58, 10, 9, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 103, 108, 111, 98, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 35, 32, 76, 111, 99, 97, 108, 115, 32, 110, 101, 101, 100, 115, 32, 116, 111, 32, 99, 111, 109, 101, 32, 97, 102, 116, 101, 114, 32, 103, 108, 111, 98, 97, 108, 115, 32, 105, 110, 32, 99, 97, 115, 101, 32, 119, 101, 32, 109, 97, 100, 101, 32, 99, 104, 97, 110, 103, 101, 115, 10, 102, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 108, 111, 99, 97, 108, 115, 95, 107, 101, 121, 115, 58, 10, 9, 105, 102, 32, 110, 111, 116, 32, 118, 97, 108, 46, 115, 116, 97, 114, 116, 115, 119, 105, 116, 104, 40, 34, 95, 34, 41, 58, 10, 9, 9, 105, 102, 32, 105, 115, 105, 110, 115, 116, 97, 110, 99, 101, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 44, 32, 95, 95, 77, 111, 100, 117, 108, 101, 84, 121, 112, 101, 41, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 91, 118, 97, 108, 93, 32, 61, 32, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 46, 95, 95, 110, 97, 109, 101, 95, 95, 10, 9, 9, 101, 108, 115, 101, 58, 10, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 46, 112, 111, 112, 40, 118, 97, 108, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 105, 102, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 32, 105, 115, 32, 78, 111, 110, 101, 32, 111, 114, 32, 118, 97, 108, 32, 105, 110, 32, 95, 95, 101, 120, 112, 111, 114, 116, 115, 58, 10, 9, 9, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 118, 97, 108, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 108, 111, 99, 97, 108, 115, 40, 41, 91, 118, 97, 108, 93, 41, 10, 10, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 9, 34, 34, 34, 10, 9, 9, 35, 32, 84, 104, 101, 32, 99, 111, 100, 101, 32, 114, 117, 110, 115, 32, 97, 115, 32, 97, 32, 102, 105, 108, 101, 32, 110, 97, 109, 101, 100, 32, 97, 102, 116, 101, 114, 32, 116, 104, 101, 32, 115, 116, 101, 112, 44, 32, 115, 111, 32, 105, 116, 115, 32, 116, 114, 97, 99, 101, 98, 97, 99, 107, 115, 32, 99, 97, 110, 32, 98, 101, 32, 109, 97, 112, 112, 101, 100, 32, 98, 97, 99, 107, 32, 116, 111, 32, 116, 104, 101, 32, 110, 111, 116, 101, 98, 111, 111, 107, 10, 9, 9, 101, 120, 101, 99, 40, 99, 111, 109, 112, 105, 108, 101, 40, 95, 95, 105, 110, 110, 101, 114, 95, 99, 111, 100, 101, 95, 116, 111, 95, 101, 120, 101, 99, 117, 116, 101, 44, 32, 34, 60, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 62, 34, 44, 32, 34, 101, 120, 101, 99, 34, 41, 44, 32, 95, 95, 118, 97, 114, 105, 97, 98, 108, 101, 115, 95, 116, 111, 95, 109, 111, 117, 110, 116, 44, 32, 95, 95, 108, 111, 99, 41, 10, 10, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 95, 95, 108, 111, 99, 91, 34, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 34, 93, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 101, 120, 116, 83, 116, 111, 114, 97, 103, 101, 46, 69, 110, 97, 98, 108, 101, 100, 32, 37, 125, 9, 9, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 32, 61, 32, 95, 95, 115, 112, 105, 108, 108, 95, 99, 111, 110, 116, 101, 120, 116, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 44, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 98, 121, 116, 101, 115, 44, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 115, 116, 114, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 95, 101, 120, 112, 111, 114, 116, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 9, 9, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 32, 61, 32, 123, 10, 9, 9, 9, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 101, 120, 112, 101, 114, 105, 109, 101, 110, 116, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 114, 117, 110, 95, 105, 100, 34, 58, 32, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 95, 100, 105, 99, 116, 91, 34, 114, 117, 110, 95, 105, 100, 34, 93, 44, 10, 9, 9, 9, 34, 115, 116, 101, 112, 95, 105, 100, 34, 58, 32, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 121, 112, 101, 34, 58, 32, 34, 111, 117, 116, 112, 117, 116, 34, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 118, 97, 108, 117, 101, 34, 58, 32, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 9, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 116, 105, 109, 101, 34, 58, 32, 95, 95, 100, 97, 116, 101, 116, 105, 109, 101, 46, 100, 97, 116, 101, 116, 105, 109, 101, 46, 110, 111, 119, 40, 41, 46, 105, 115, 111, 102, 111, 114, 109, 97, 116, 40, 41, 44, 10, 9, 9, 125, 10, 10, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 77, 101, 116, 97, 100, 97, 116, 97, 32, 117, 114, 108, 58, 32, 123, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 125, 34, 41, 10, 9, 9, 105, 102, 32, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 32, 33, 61, 32, 39, 39, 58, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 34, 70, 111, 117, 110, 100, 32, 109, 101, 116, 97, 100, 97, 116, 97, 32, 85, 82, 76, 32, 45, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 46, 34, 41, 10, 9, 9, 9, 95, 95, 112, 112, 40, 95, 95, 106, 115, 111, 110, 95, 100, 97, 116, 97, 41, 10, 9, 9, 9, 116, 114, 121, 58, 10, 9, 9, 9, 9, 95, 95, 114, 32, 61, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 112, 111, 115, 116, 40, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 32, 106, 115, 111, 110, 61, 95, 95, 106, 115, 111, 110, 95, 111, 117, 116, 112, 117, 116, 95, 100, 97, 116, 97, 44, 41, 9, 10, 9, 9, 9, 9, 95, 95, 114, 46, 114, 97, 105, 115, 101, 95, 102, 111, 114, 95, 115, 116, 97, 116, 117, 115, 40, 41, 10, 9, 9, 9, 101, 120, 99, 101, 112, 116, 32, 114, 101, 113, 117, 101, 115, 116, 115, 46, 101, 120, 99, 101, 112, 116, 105, 111, 110, 115, 46, 72, 84, 84, 80, 69, 114, 114, 111, 114, 32, 97, 115, 32, 101, 114, 114, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 69, 114, 114, 111, 114, 58, 32, 123, 101, 114, 114, 125, 34, 41, 10, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 98, 54, 52, 95, 115, 116, 114, 105, 110, 103, 10, 10, 9, 35, 32, 83, 116, 101, 112, 115, 32, 119, 105, 116, 104, 32, 115, 101, 118, 101, 114, 97, 108, 32, 112, 97, 114, 101, 110, 116, 115, 32, 103, 101, 116, 32, 97, 32, 99, 111, 110, 116, 101, 120, 116, 32, 102, 114, 111, 109, 32, 101, 97, 99, 104, 32, 111, 102, 32, 116, 104, 101, 109, 44, 32, 119, 104, 105, 99, 104, 32, 97, 114, 101, 32, 109, 101, 114, 103, 101, 100, 32, 105, 110, 32, 116, 104, 101, 32, 111, 114, 100, 101, 114, 32, 116, 104, 101, 10, 9, 35, 32, 112, 97, 114, 101, 110, 116, 115, 32, 97, 114, 101, 32, 108, 105, 115, 116, 101, 100, 32, 105, 110, 32, 39, 100, 101, 112, 101, 110, 100, 115, 95, 111, 110, 39, 46, 32, 73, 102, 32, 112, 97, 114, 101, 110, 116, 115, 32, 100, 105, 115, 97, 103, 114, 101, 101, 32, 111, 110, 32, 97, 32, 118, 97, 114, 105, 97, 98, 108, 101, 44, 32, 116, 104, 101, 32, 108, 97, 115, 116, 32, 112, 97, 114, 101, 110, 116, 32, 108, 105, 115, 116, 101, 100, 32, 119, 105, 110, 115, 46, 10, 9, 100, 101, 102, 32, 95, 95, 109, 101, 114, 103, 101, 95, 99, 111, 110, 116, 101, 120, 116, 115, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 115, 41, 58, 10, 9, 9, 105, 109, 112, 111, 114, 116, 32, 100, 105, 108, 108, 10, 9, 9, 102, 114, 111, 109, 32, 98, 97, 115, 101, 54, 52, 32, 105, 109, 112, 111, 114, 116, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 44, 32, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 10, 10, 9, 9, 105, 102, 32, 108, 101, 110, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 41, 32, 61, 61, 32, 49, 58, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 91, 48, 93, 10, 10, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 109, 111, 100, 117, 108, 101, 115, 32, 61, 32, 123, 125, 10, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 32, 61, 32, 123, 125, 10, 9, 9, 102, 111, 114, 32, 95, 95, 99, 111, 110, 116, 101, 120, 116, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 32, 105, 110, 32, 122, 105, 112, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 115, 44, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 115, 41, 58, 10, 9, 9, 9, 102, 111, 114, 32, 95, 95, 107, 44, 32, 95, 95, 118, 32, 105, 110, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 100, 101, 99, 111, 100, 101, 40, 95, 95, 99, 111, 110, 116, 101, 120, 116, 41, 41, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 61, 61, 32, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 58, 10, 9, 9, 9, 9, 9, 95, 95, 109, 111, 100, 117, 108, 101, 115, 46, 117, 112, 100, 97, 116, 101, 40, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 41, 41, 10, 9, 9, 9, 9, 9, 99, 111, 110, 116, 105, 110, 117, 101, 10, 9, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 61, 61, 32, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 58, 10, 9, 9, 9, 9, 9, 35, 32, 86, 97, 114, 105, 97, 98, 108, 101, 115, 32, 115, 112, 105, 108, 108, 101, 100, 32, 116, 111, 32, 111, 98, 106, 101, 99, 116, 32, 115, 116, 111, 114, 97, 103, 101, 32, 97, 114, 101, 32, 109, 101, 114, 103, 101, 100, 32, 116, 104, 101, 32, 115, 97, 109, 101, 32, 119, 97, 121, 44, 32, 98, 121, 32, 114, 101, 102, 101, 114, 101, 110, 99, 101, 10, 9, 9, 9, 9, 9, 102, 111, 114, 32, 95, 95, 110, 97, 109, 101, 44, 32, 95, 95, 117, 114, 105, 32, 105, 110, 32, 100, 105, 108, 108, 46, 108, 111, 97, 100, 115, 40, 95, 95, 118, 41, 46, 105, 116, 101, 109, 115, 40, 41, 58, 10, 9, 9, 9, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 46, 112, 111, 112, 40, 95, 95, 110, 97, 109, 101, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 91, 95, 95, 110, 97, 109, 101, 93, 32, 61, 32, 95, 95, 117, 114, 105, 10, 9, 9, 9, 9, 9, 99, 111, 110, 116, 105, 110, 117, 101, 10, 9, 9, 9, 9, 105, 102, 32, 95, 95, 107, 32, 105, 110, 32, 95, 95, 109, 101, 114, 103, 101, 100, 32, 97, 110, 100, 32, 95, 95, 109, 101, 114, 103, 101, 100, 91, 95, 95, 107, 93, 32, 33, 61, 32, 95, 95, 118, 58, 10, 9, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 86, 97, 114, 105, 97, 98, 108, 101, 32, 39, 123, 95, 95, 107, 125, 39, 32, 100, 105, 102, 102, 101, 114, 115, 32, 98, 101, 116, 119, 101, 101, 110, 32, 123, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 91, 95, 95, 107, 93, 125, 32, 97, 110, 100, 32, 123, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 125, 44, 32, 117, 115, 105, 110, 103, 32, 116, 104, 101, 32, 118, 97, 108, 117, 101, 32, 102, 114, 111, 109, 32, 123, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 125, 34, 41, 10, 9, 9, 9, 9, 95, 95, 115, 112, 105, 108, 108, 101, 100, 46, 112, 111, 112, 40, 95, 95, 107, 44, 32, 78, 111, 110, 101, 41, 10, 9, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 118, 10, 9, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 95, 102, 114, 111, 109, 91, 95, 95, 107, 93, 32, 61, 32, 95, 95, 112, 97, 114, 101, 110, 116, 95, 110, 97, 109, 101, 10, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 34, 95, 95, 109, 111, 100, 117, 108, 101, 115, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 109, 111, 100, 117, 108, 101, 115, 41, 10, 9, 9, 105, 102, 32, 95, 95, 115, 112, 105, 108, 108, 101, 100, 58, 10, 9, 9, 9, 95, 95, 109, 101, 114, 103, 101, 100, 91, 34, 95, 95, 115, 112, 105, 108, 108, 101, 100, 95, 95, 34, 93, 32, 61, 32, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 115, 112, 105, 108, 108, 101, 100, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 115, 116, 114, 40, 117, 114, 108, 115, 97, 102, 101, 95, 98, 54, 52, 101, 110, 99, 111, 100, 101, 40, 100, 105, 108, 108, 46, 100, 117, 109, 112, 115, 40, 95, 95, 109, 101, 114, 103, 101, 100, 41, 41, 44, 32, 101, 110, 99, 111, 100, 105, 110, 103, 61, 34, 97, 115, 99, 105, 105, 34, 41, 10, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 32, 61, 32, 91, 93, 10, 9, 102, 111, 114, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 32, 105, 110, 32, 91, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 69, 120, 116, 114, 97, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 44, 32, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 95, 112, 97, 116, 104, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 58, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 10, 9, 9, 105, 102, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 32, 33, 61, 32, 78, 111, 110, 101, 58, 10, 9, 9, 9, 119, 105, 116, 104, 32, 111, 112, 101, 110, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 44, 32, 39, 114, 39, 41, 32, 97, 115, 32, 114, 101, 97, 100, 101, 114, 58, 10, 9, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 114, 101, 97, 100, 105, 110, 103, 32, 102, 105, 108, 101, 58, 32, 123, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 125, 34, 41, 10, 9, 9, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 114, 101, 97, 100, 101, 114, 46, 114, 101, 97, 100, 40, 41, 10, 9, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 46, 97, 112, 112, 101, 110, 100, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 9, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 109, 101, 114, 103, 101, 95, 99, 111, 110, 116, 101, 120, 116, 115, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 115, 44, 32, 91, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 34, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 34, 44, 32, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 93, 41, 10, 10, 9, 100, 101, 102, 32, 95, 95, 114, 117, 110, 95, 115, 116, 101, 112, 40, 41, 58, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 95, 95, 105, 110, 110, 101, 114, 95, 109, 97, 105, 110, 40, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 44, 10, 9, 9, 9, 95, 95, 114, 117, 110, 95, 105, 110, 102, 111, 61, 114, 117, 110, 95, 105, 110, 102, 111, 44, 10, 9, 9, 9, 95, 95, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 61, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 44, 10, 9, 9, 9, 95, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 115, 61, 123, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 9, 9, 9, 34, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 58, 32, 95, 95, 100, 101, 99, 111, 100, 101, 95, 112, 97, 114, 97, 109, 101, 116, 101, 114, 40, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 44, 32, 34, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 84, 121, 112, 101, 32, 125, 125, 34, 41, 44, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 9, 9, 125, 44, 10, 9, 9, 41, 10, 10, 123, 37, 32, 105, 102, 32, 67, 111, 110, 116, 105, 110, 117, 101, 79, 110, 70, 97, 105, 108, 117, 114, 101, 32, 37, 125, 9, 35, 32, 70, 97, 105, 108, 117, 114, 101, 115, 32, 97, 114, 101, 32, 99, 97, 117, 103, 104, 116, 32, 104, 101, 114, 101, 32, 115, 111, 32, 116, 104, 101, 32, 112, 105, 112, 101, 108, 105, 110, 101, 32, 99, 97, 114, 114, 105, 101, 115, 32, 111, 110, 44, 32, 119, 104, 105, 99, 104, 32, 109, 101, 97, 110, 115, 32, 75, 70, 80, 32, 99, 97, 110, 39, 116, 32, 114, 101, 116, 114, 121, 32, 116, 104, 101, 109, 32, 45, 32, 116, 104, 101, 32, 115, 116, 101, 112, 32, 114, 101, 116, 114, 105, 101, 115, 10, 9, 35, 32, 105, 116, 115, 101, 108, 102, 46, 32, 73, 102, 32, 101, 118, 101, 114, 121, 32, 97, 116, 116, 101, 109, 112, 116, 32, 102, 97, 105, 108, 115, 44, 32, 116, 104, 101, 32, 115, 116, 101, 112, 115, 32, 97, 102, 116, 101, 114, 32, 116, 104, 105, 115, 32, 111, 110, 101, 32, 115, 116, 97, 114, 116, 32, 102, 114, 111, 109, 32, 116, 104, 101, 32, 99, 111, 110, 116, 101, 120, 116, 32, 116, 104, 105, 115, 32, 115, 116, 101, 112, 32, 115, 116, 97, 114, 116, 101, 100, 32, 102, 114, 111, 109, 46, 10, 9, 105, 109, 112, 111, 114, 116, 32, 116, 114, 97, 99, 101, 98, 97, 99, 107, 32, 97, 115, 32, 95, 95, 116, 114, 97, 99, 101, 98, 97, 99, 107, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 105, 109, 112, 111, 114, 116, 32, 115, 105, 103, 110, 97, 108, 32, 97, 115, 32, 95, 95, 115, 105, 103, 110, 97, 108, 10, 10, 9, 35, 32, 65, 116, 116, 101, 109, 112, 116, 115, 32, 97, 114, 101, 32, 116, 105, 109, 101, 100, 32, 111, 117, 116, 32, 104, 101, 114, 101, 32, 116, 111, 111, 44, 32, 97, 115, 32, 75, 70, 80, 32, 115, 116, 111, 112, 112, 105, 110, 103, 32, 116, 104, 101, 32, 115, 116, 101, 112, 32, 119, 111, 117, 108, 100, 32, 102, 97, 105, 108, 32, 116, 104, 101, 32, 112, 105, 112, 101, 108, 105, 110, 101, 10, 9, 100, 101, 102, 32, 95, 95, 116, 105, 109, 101, 100, 95, 111, 117, 116, 40, 95, 95, 115, 105, 103, 110, 117, 109, 44, 32, 95, 95, 102, 114, 97, 109, 101, 41, 58, 10, 9, 9, 114, 97, 105, 115, 101, 32, 84, 105, 109, 101, 111, 117, 116, 69, 114, 114, 111, 114, 40, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 32, 114, 97, 110, 32, 102, 111, 114, 32, 108, 111, 110, 103, 101, 114, 32, 116, 104, 97, 110, 32, 105, 116, 115, 32, 116, 105, 109, 101, 111, 117, 116, 32, 111, 102, 32, 123, 123, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 125, 125, 32, 115, 101, 99, 111, 110, 100, 115, 34, 41, 10, 10, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 115, 105, 103, 110, 97, 108, 40, 95, 95, 115, 105, 103, 110, 97, 108, 46, 83, 73, 71, 65, 76, 82, 77, 44, 32, 95, 95, 116, 105, 109, 101, 100, 95, 111, 117, 116, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 10, 9, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 32, 61, 32, 123, 123, 32, 82, 101, 116, 114, 105, 101, 115, 32, 125, 125, 32, 43, 32, 49, 10, 9, 102, 111, 114, 32, 95, 95, 97, 116, 116, 101, 109, 112, 116, 32, 105, 110, 32, 114, 97, 110, 103, 101, 40, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 41, 58, 10, 9, 9, 116, 114, 121, 58, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 123, 123, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 125, 125, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 114, 117, 110, 95, 115, 116, 101, 112, 40, 41, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 48, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 101, 120, 99, 101, 112, 116, 32, 69, 120, 99, 101, 112, 116, 105, 111, 110, 58, 10, 123, 37, 32, 105, 102, 32, 84, 105, 109, 101, 111, 117, 116, 83, 101, 99, 111, 110, 100, 115, 32, 37, 125, 9, 9, 9, 95, 95, 115, 105, 103, 110, 97, 108, 46, 97, 108, 97, 114, 109, 40, 48, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 9, 9, 9, 95, 95, 116, 114, 97, 99, 101, 98, 97, 99, 107, 46, 112, 114, 105, 110, 116, 95, 101, 120, 99, 40, 41, 10, 9, 9, 9, 112, 114, 105, 110, 116, 40, 102, 34, 65, 116, 116, 101, 109, 112, 116, 32, 123, 95, 95, 97, 116, 116, 101, 109, 112, 116, 32, 43, 32, 49, 125, 32, 111, 102, 32, 123, 95, 95, 97, 116, 116, 101, 109, 112, 116, 115, 125, 32, 111, 102, 32, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 32, 102, 97, 105, 108, 101, 100, 34, 41, 10, 123, 37, 32, 101, 108, 115, 101, 32, 37, 125, 9, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 32, 61, 32, 95, 95, 114, 117, 110, 95, 115, 116, 101, 112, 40, 41, 10, 123, 37, 32, 101, 110, 100, 105, 102, 32, 37, 125, 10, 9, 95, 95, 112, 32, 61, 32, 95, 95, 80, 97, 116, 104, 40, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 41, 10, 9, 119, 105, 116, 104, 32, 95, 95, 112, 46, 111, 112, 101, 110, 40, 34, 119, 43, 34, 41, 32, 97, 115, 32, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 58, 10, 9, 9, 95, 95, 102, 105, 108, 101, 95, 104, 97, 110, 100, 108, 101, 46, 119, 114, 105, 116, 101, 40, 95, 95, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 115, 116, 114, 105, 110, 103, 41, 10, 10, 10, 105, 102, 32, 95, 95, 110, 97, 109, 101, 95, 95, 32, 61, 61, 32, 34, 95, 95, 109, 97, 105, 110, 95, 95, 34, 58, 10, 9, 35, 32, 84, 104, 101, 32, 115, 116, 101, 112, 115, 32, 111, 102, 32, 97, 32, 119, 111, 114, 107, 102, 108, 111, 119, 32, 99, 111, 109, 112, 105, 108, 101, 100, 32, 98, 121, 32, 83, 65, 77, 69, 32, 114, 117, 110, 32, 116, 104, 105, 115, 32, 102, 105, 108, 101, 32, 97, 115, 32, 97, 32, 112, 114, 111, 103, 114, 97, 109, 44, 32, 119, 105, 116, 104, 32, 116, 104, 101, 105, 114, 32, 105, 110, 112, 117, 116, 115, 32, 97, 110, 100, 32, 111, 117, 116, 112, 117, 116, 115, 32, 97, 115, 10, 9, 35, 32, 97, 114, 103, 117, 109, 101, 110, 116, 115, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 32, 61, 32, 95, 95, 97, 114, 103, 112, 97, 114, 115, 101, 46, 65, 114, 103, 117, 109, 101, 110, 116, 80, 97, 114, 115, 101, 114, 40, 112, 114, 111, 103, 61, 34, 123, 123, 32, 78, 97, 109, 101, 32, 125, 125, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 105, 110, 112, 117, 116, 45, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 100, 101, 115, 116, 61, 34, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 78, 111, 110, 101, 41, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 101, 110, 116, 32, 105, 110, 32, 69, 120, 116, 114, 97, 80, 97, 114, 101, 110, 116, 115, 32, 37, 125, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 105, 110, 112, 117, 116, 45, 99, 111, 110, 116, 101, 120, 116, 45, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 34, 44, 32, 100, 101, 115, 116, 61, 34, 105, 110, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 123, 123, 32, 112, 97, 114, 101, 110, 116, 32, 125, 125, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 78, 111, 110, 101, 41, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 111, 117, 116, 112, 117, 116, 45, 99, 111, 110, 116, 101, 120, 116, 34, 44, 32, 100, 101, 115, 116, 61, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 114, 101, 113, 117, 105, 114, 101, 100, 61, 84, 114, 117, 101, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 114, 117, 110, 45, 105, 110, 102, 111, 34, 44, 32, 100, 101, 115, 116, 61, 34, 114, 117, 110, 95, 105, 110, 102, 111, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 34, 103, 65, 82, 57, 108, 67, 52, 61, 34, 41, 10, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 109, 101, 116, 97, 100, 97, 116, 97, 45, 117, 114, 108, 34, 44, 32, 100, 101, 115, 116, 61, 34, 109, 101, 116, 97, 100, 97, 116, 97, 95, 117, 114, 108, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 34, 34, 41, 10, 123, 37, 32, 102, 111, 114, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 32, 105, 110, 32, 82, 117, 110, 80, 97, 114, 97, 109, 101, 116, 101, 114, 115, 32, 37, 125, 9, 95, 95, 112, 97, 114, 115, 101, 114, 46, 97, 100, 100, 95, 97, 114, 103, 117, 109, 101, 110, 116, 40, 34, 45, 45, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 44, 32, 100, 101, 115, 116, 61, 34, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 78, 97, 109, 101, 32, 125, 125, 34, 44, 32, 116, 121, 112, 101, 61, 115, 116, 114, 44, 32, 100, 101, 102, 97, 117, 108, 116, 61, 123, 123, 32, 112, 97, 114, 97, 109, 101, 116, 101, 114, 46, 68, 101, 102, 97, 117, 108, 116, 32, 125, 125, 41, 10, 123, 37, 32, 101, 110, 100, 102, 111, 114, 32, 37, 125, 9, 95, 95, 97, 114, 103, 115, 32, 61, 32, 118, 97, 114, 115, 40, 95, 95, 112, 97, 114, 115, 101, 114, 46, 112, 97, 114, 115, 101, 95, 97, 114, 103, 115, 40, 41, 41, 10, 9, 111, 115, 46, 109, 97, 107, 101, 100, 105, 114, 115, 40, 111, 115, 46, 112, 97, 116, 104, 46, 100, 105, 114, 110, 97, 109, 101, 40, 95, 95, 97, 114, 103, 115, 91, 34, 111, 117, 116, 112, 117, 116, 95, 99, 111, 110, 116, 101, 120, 116, 95, 112, 97, 116, 104, 34, 93, 41, 44, 32, 101, 120, 105, 115, 116, 95, 111, 107, 61, 84, 114, 117, 101, 41, 10, 9, 103, 101, 110, 101, 114, 97, 116, 101, 100, 95, 109, 97, 105, 110, 40, 42, 42, 95, 95, 97, 114, 103, 115, 41, 10, 10, 123, 37, 32, 101, 110, 100, 97, 117, 116, 111, 101, 115, 99, 97, 112, 101, 32, 37, 125})
+	box.Add("/python/import_map.yaml", []byte{35, 32, 77, 97, 112, 115, 32, 116, 104, 101, 32, 116, 111, 112, 32, 108, 101, 118, 101, 108, 32, 109, 111, 100, 117, 108, 101, 115, 32, 80, 121, 116, 104, 111, 110, 32, 99, 111, 100, 101, 32, 105, 109, 112, 111, 114, 116, 115, 32, 116, 111, 32, 116, 104, 101, 32, 100, 105, 115, 116, 114, 105, 98, 117, 116, 105, 111, 110, 115, 32, 111, 110, 32, 80, 121, 80, 73, 32, 116, 104, 97, 116, 32, 112, 114, 111, 118, 105, 100, 101, 32, 116, 104, 101, 109, 46, 32, 77, 111, 100, 117, 108, 101, 115, 10, 35, 32, 109, 105, 115, 115, 105, 110, 103, 32, 102, 114, 111, 109, 32, 116, 104, 105, 115, 32, 116, 97, 98, 108, 101, 32, 40, 97, 110, 100, 32, 102, 114, 111, 109, 32, 116, 104, 101, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 108, 105, 98, 114, 97, 114, 121, 41, 32, 97, 114, 101, 32, 114, 101, 112, 111, 114, 116, 101, 100, 32, 114, 97, 116, 104, 101, 114, 32, 116, 104, 97, 110, 32, 103, 117, 101, 115, 115, 101, 100, 32, 45, 32, 97, 100, 100, 32, 116, 104, 101, 109, 32, 119, 105, 116, 104, 10, 35, 32, 39, 105, 109, 112, 111, 114, 116, 95, 109, 97, 112, 39, 32, 105, 110, 32, 116, 104, 101, 32, 83, 65, 77, 69, 32, 102, 105, 108, 101, 46, 32, 65, 32, 109, 111, 100, 117, 108, 101, 32, 109, 97, 112, 112, 101, 100, 32, 116, 111, 32, 34, 34, 32, 105, 115, 32, 110, 101, 118, 101, 114, 32, 105, 110, 115, 116, 97, 108, 108, 101, 100, 46, 10, 10, 97, 99, 99, 101, 108, 101, 114, 97, 116, 101, 58, 32, 34, 97, 99, 99, 101, 108, 101, 114, 97, 116, 101, 34, 10, 97, 105, 111, 104, 116, 116, 112, 58, 32, 34, 97, 105, 111, 104, 116, 116, 112, 34, 10, 97, 108, 98, 117, 109, 101, 110, 116, 97, 116, 105, 111, 110, 115, 58, 32, 34, 97, 108, 98, 117, 109, 101, 110, 116, 97, 116, 105, 111, 110, 115, 34, 10, 97, 108, 116, 97, 105, 114, 58, 32, 34, 97, 108, 116, 97, 105, 114, 34, 10, 97, 110, 110, 111, 121, 58, 32, 34, 97, 110, 110, 111, 121, 34, 10, 97, 114, 114, 111, 119, 58, 32, 34, 97, 114, 114, 111, 119, 34, 10, 97, 114, 118, 105, 122, 58, 32, 34, 97, 114, 118, 105, 122, 34, 10, 97, 116, 116, 114, 58, 32, 34, 97, 116, 116, 114, 115, 34, 10, 97, 122, 117, 114, 101, 109, 108, 58, 32, 34, 97, 122, 117, 114, 101, 109, 108, 45, 115, 100, 107, 34, 10, 66, 105, 111, 58, 32, 34, 98, 105, 111, 112, 121, 116, 104, 111, 110, 34, 10, 98, 111, 107, 101, 104, 58, 32, 34, 98, 111, 107, 101, 104, 34, 10, 98, 111, 116, 111, 51, 58, 32, 34, 98, 111, 116, 111, 51, 34, 10, 98, 111, 116, 111, 99, 111, 114, 101, 58, 32, 34, 98, 111, 116, 111, 99, 111, 114, 101, 34, 10, 98, 115, 52, 58, 32, 34, 98, 101, 97, 117, 116, 105, 102, 117, 108, 115, 111, 117, 112, 52, 34, 10, 98, 115, 111, 110, 58, 32, 34, 112, 121, 109, 111, 110, 103, 111, 34, 10, 99, 97, 116, 98, 111, 111, 115, 116, 58, 32, 34, 99, 97, 116, 98, 111, 111, 115, 116, 34, 10, 99, 97, 116, 101, 103, 111, 114, 121, 95, 101, 110, 99, 111, 100, 101, 114, 115, 58, 32, 34, 99, 97, 116, 101, 103, 111, 114, 121, 45, 101, 110, 99, 111, 100, 101, 114, 115, 34, 10, 99, 104, 97, 114, 100, 101, 116, 58, 32, 34, 99, 104, 97, 114, 100, 101, 116, 34, 10, 99, 108, 105, 99, 107, 58, 32, 34, 99, 108, 105, 99, 107, 34, 10, 99, 108, 111, 117, 100, 112, 105, 99, 107, 108, 101, 58, 32, 34, 99, 108, 111, 117, 100, 112, 105, 99, 107, 108, 101, 34, 10, 67, 114, 121, 112, 116, 111, 58, 32, 34, 112, 121, 99, 114, 121, 112, 116, 111, 100, 111, 109, 101, 34, 10, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 121, 58, 32, 34, 99, 114, 121, 112, 116, 111, 103, 114, 97, 112, 104, 121, 34, 10, 99, 117, 112, 121, 58, 32, 34, 99, 117, 112, 121, 34, 10, 99, 118, 50, 58, 32, 34, 111, 112, 101, 110, 99, 118, 45, 112, 121, 116, 104, 111, 110, 34, 10, 99, 118, 120, 112, 121, 58, 32, 34, 99, 118, 120, 112, 121, 34, 10, 100, 97, 115, 104, 58, 32, 34, 100, 97, 115, 104, 34, 10, 100, 97, 115, 107, 58, 32, 34, 100, 97, 115, 107, 34, 10, 100, 97, 116, 97, 115, 101, 116, 115, 58, 32, 34, 100, 97, 116, 97, 115, 101, 116, 115, 34, 10, 100, 97, 116, 101, 117, 116, 105, 108, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 100, 97, 116, 101, 117, 116, 105, 108, 34, 10, 100, 101, 116, 101, 99, 116, 114, 111, 110, 50, 58, 32, 34, 100, 101, 116, 101, 99, 116, 114, 111, 110, 50, 34, 10, 100, 105, 108, 108, 58, 32, 34, 100, 105, 108, 108, 34, 10, 100, 105, 115, 116, 114, 105, 98, 117, 116, 101, 100, 58, 32, 34, 100, 105, 115, 116, 114, 105, 98, 117, 116, 101, 100, 34, 10, 100, 106, 97, 110, 103, 111, 58, 32, 34, 68, 106, 97, 110, 103, 111, 34, 10, 100, 111, 99, 107, 101, 114, 58, 32, 34, 100, 111, 99, 107, 101, 114, 34, 10, 100, 111, 99, 111, 112, 116, 58, 32, 34, 100, 111, 99, 111, 112, 116, 34, 10, 100, 111, 99, 120, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 100, 111, 99, 120, 34, 10, 100, 111, 116, 101, 110, 118, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 100, 111, 116, 101, 110, 118, 34, 10, 101, 108, 97, 115, 116, 105, 99, 115, 101, 97, 114, 99, 104, 58, 32, 34, 101, 108, 97, 115, 116, 105, 99, 115, 101, 97, 114, 99, 104, 34, 10, 101, 108, 105, 53, 58, 32, 34, 101, 108, 105, 53, 34, 10, 101, 109, 99, 101, 101, 58, 32, 34, 101, 109, 99, 101, 101, 34, 10, 102, 97, 105, 115, 115, 58, 32, 34, 102, 97, 105, 115, 115, 45, 99, 112, 117, 34, 10, 102, 97, 115, 116, 97, 105, 58, 32, 34, 102, 97, 115, 116, 97, 105, 34, 10, 102, 97, 115, 116, 97, 112, 105, 58, 32, 34, 102, 97, 115, 116, 97, 112, 105, 34, 10, 102, 101, 97, 116, 117, 114, 101, 116, 111, 111, 108, 115, 58, 32, 34, 102, 101, 97, 116, 117, 114, 101, 116, 111, 111, 108, 115, 34, 10, 102, 105, 111, 110, 97, 58, 32, 34, 102, 105, 111, 110, 97, 34, 10, 102, 105, 116, 122, 58, 32, 34, 80, 121, 77, 117, 80, 68, 70, 34, 10, 102, 108, 97, 115, 107, 58, 32, 34, 70, 108, 97, 115, 107, 34, 10, 102, 108, 97, 120, 58, 32, 34, 102, 108, 97, 120, 34, 10, 102, 111, 108, 105, 117, 109, 58, 32, 34, 102, 111, 108, 105, 117, 109, 34, 10, 102, 115, 115, 112, 101, 99, 58, 32, 34, 102, 115, 115, 112, 101, 99, 34, 10, 103, 99, 115, 102, 115, 58, 32, 34, 103, 99, 115, 102, 115, 34, 10, 103, 101, 110, 115, 105, 109, 58, 32, 34, 103, 101, 110, 115, 105, 109, 34, 10, 103, 101, 111, 112, 97, 110, 100, 97, 115, 58, 32, 34, 103, 101, 111, 112, 97, 110, 100, 97, 115, 34, 10, 103, 105, 58, 32, 34, 80, 121, 71, 79, 98, 106, 101, 99, 116, 34, 10, 103, 105, 116, 58, 32, 34, 71, 105, 116, 80, 121, 116, 104, 111, 110, 34, 10, 103, 105, 116, 104, 117, 98, 58, 32, 34, 80, 121, 71, 105, 116, 104, 117, 98, 34, 10, 103, 114, 97, 112, 104, 118, 105, 122, 58, 32, 34, 103, 114, 97, 112, 104, 118, 105, 122, 34, 10, 103, 114, 101, 97, 116, 95, 101, 120, 112, 101, 99, 116, 97, 116, 105, 111, 110, 115, 58, 32, 34, 103, 114, 101, 97, 116, 45, 101, 120, 112, 101, 99, 116, 97, 116, 105, 111, 110, 115, 34, 10, 103, 114, 112, 99, 58, 32, 34, 103, 114, 112, 99, 105, 111, 34, 10, 103, 121, 109, 58, 32, 34, 103, 121, 109, 34, 10, 103, 121, 109, 110, 97, 115, 105, 117, 109, 58, 32, 34, 103, 121, 109, 110, 97, 115, 105, 117, 109, 34, 10, 104, 53, 112, 121, 58, 32, 34, 104, 53, 112, 121, 34, 10, 104, 100, 98, 115, 99, 97, 110, 58, 32, 34, 104, 100, 98, 115, 99, 97, 110, 34, 10, 104, 116, 116, 112, 120, 58, 32, 34, 104, 116, 116, 112, 120, 34, 10, 104, 121, 112, 101, 114, 111, 112, 116, 58, 32, 34, 104, 121, 112, 101, 114, 111, 112, 116, 34, 10, 105, 109, 97, 103, 101, 105, 111, 58, 32, 34, 105, 109, 97, 103, 101, 105, 111, 34, 10, 105, 109, 98, 108, 101, 97, 114, 110, 58, 32, 34, 105, 109, 98, 97, 108, 97, 110, 99, 101, 100, 45, 108, 101, 97, 114, 110, 34, 10, 105, 109, 103, 97, 117, 103, 58, 32, 34, 105, 109, 103, 97, 117, 103, 34, 10, 73, 80, 121, 116, 104, 111, 110, 58, 32, 34, 105, 112, 121, 116, 104, 111, 110, 34, 10, 105, 112, 121, 119, 105, 100, 103, 101, 116, 115, 58, 32, 34, 105, 112, 121, 119, 105, 100, 103, 101, 116, 115, 34, 10, 106, 97, 120, 58, 32, 34, 106, 97, 120, 34, 10, 106, 97, 120, 108, 105, 98, 58, 32, 34, 106, 97, 120, 108, 105, 98, 34, 10, 106, 105, 101, 98, 97, 58, 32, 34, 106, 105, 101, 98, 97, 34, 10, 106, 105, 110, 106, 97, 50, 58, 32, 34, 74, 105, 110, 106, 97, 50, 34, 10, 106, 111, 98, 108, 105, 98, 58, 32, 34, 106, 111, 98, 108, 105, 98, 34, 10, 106, 111, 115, 101, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 106, 111, 115, 101, 34, 10, 106, 119, 116, 58, 32, 34, 80, 121, 74, 87, 84, 34, 10, 107, 101, 114, 97, 115, 58, 32, 34, 107, 101, 114, 97, 115, 34, 10, 107, 101, 114, 97, 115, 95, 116, 117, 110, 101, 114, 58, 32, 34, 107, 101, 114, 97, 115, 45, 116, 117, 110, 101, 114, 34, 10, 107, 102, 112, 58, 32, 34, 107, 102, 112, 34, 10, 107, 111, 114, 110, 105, 97, 58, 32, 34, 107, 111, 114, 110, 105, 97, 34, 10, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 58, 32, 34, 107, 117, 98, 101, 114, 110, 101, 116, 101, 115, 34, 10, 76, 101, 118, 101, 110, 115, 104, 116, 101, 105, 110, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 76, 101, 118, 101, 110, 115, 104, 116, 101, 105, 110, 34, 10, 108, 105, 98, 114, 111, 115, 97, 58, 32, 34, 108, 105, 98, 114, 111, 115, 97, 34, 10, 108, 105, 103, 104, 116, 103, 98, 109, 58, 32, 34, 108, 105, 103, 104, 116, 103, 98, 109, 34, 10, 108, 105, 103, 104, 116, 110, 105, 110, 103, 58, 32, 34, 108, 105, 103, 104, 116, 110, 105, 110, 103, 34, 10, 108, 105, 109, 101, 58, 32, 34, 108, 105, 109, 101, 34, 10, 108, 120, 109, 108, 58, 32, 34, 108, 120, 109, 108, 34, 10, 108, 122, 52, 58, 32, 34, 108, 122, 52, 34, 10, 109, 97, 103, 105, 99, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 109, 97, 103, 105, 99, 34, 10, 109, 97, 114, 107, 100, 111, 119, 110, 58, 32, 34, 77, 97, 114, 107, 100, 111, 119, 110, 34, 10, 109, 97, 114, 107, 117, 112, 115, 97, 102, 101, 58, 32, 34, 77, 97, 114, 107, 117, 112, 83, 97, 102, 101, 34, 10, 109, 97, 116, 112, 108, 111, 116, 108, 105, 98, 58, 32, 34, 109, 97, 116, 112, 108, 111, 116, 108, 105, 98, 34, 10, 109, 105, 110, 105, 111, 58, 32, 34, 109, 105, 110, 105, 111, 34, 10, 109, 105, 115, 115, 105, 110, 103, 110, 111, 58, 32, 34, 109, 105, 115, 115, 105, 110, 103, 110, 111, 34, 10, 109, 108, 102, 108, 111, 119, 58, 32, 34, 109, 108, 102, 108, 111, 119, 34, 10, 109, 108, 120, 116, 101, 110, 100, 58, 32, 34, 109, 108, 120, 116, 101, 110, 100, 34, 10, 109, 111, 99, 107, 58, 32, 34, 109, 111, 99, 107, 34, 10, 109, 111, 118, 105, 101, 112, 121, 58, 32, 34, 109, 111, 118, 105, 101, 112, 121, 34, 10, 109, 112, 108, 95, 116, 111, 111, 108, 107, 105, 116, 115, 58, 32, 34, 109, 97, 116, 112, 108, 111, 116, 108, 105, 98, 34, 10, 109, 115, 103, 112, 97, 99, 107, 58, 32, 34, 109, 115, 103, 112, 97, 99, 107, 34, 10, 109, 117, 108, 116, 105, 112, 97, 114, 116, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 109, 117, 108, 116, 105, 112, 97, 114, 116, 34, 10, 109, 120, 110, 101, 116, 58, 32, 34, 109, 120, 110, 101, 116, 34, 10, 77, 121, 83, 81, 76, 100, 98, 58, 32, 34, 109, 121, 115, 113, 108, 99, 108, 105, 101, 110, 116, 34, 10, 110, 98, 102, 111, 114, 109, 97, 116, 58, 32, 34, 110, 98, 102, 111, 114, 109, 97, 116, 34, 10, 110, 101, 116, 119, 111, 114, 107, 120, 58, 32, 34, 110, 101, 116, 119, 111, 114, 107, 120, 34, 10, 110, 108, 116, 107, 58, 32, 34, 110, 108, 116, 107, 34, 10, 110, 117, 109, 98, 97, 58, 32, 34, 110, 117, 109, 98, 97, 34, 10, 110, 117, 109, 112, 121, 58, 32, 34, 110, 117, 109, 112, 121, 34, 10, 111, 110, 110, 120, 58, 32, 34, 111, 110, 110, 120, 34, 10, 111, 110, 110, 120, 114, 117, 110, 116, 105, 109, 101, 58, 32, 34, 111, 110, 110, 120, 114, 117, 110, 116, 105, 109, 101, 34, 10, 111, 112, 101, 110, 112, 121, 120, 108, 58, 32, 34, 111, 112, 101, 110, 112, 121, 120, 108, 34, 10, 79, 112, 101, 110, 83, 83, 76, 58, 32, 34, 112, 121, 79, 112, 101, 110, 83, 83, 76, 34, 10, 111, 112, 116, 97, 120, 58, 32, 34, 111, 112, 116, 97, 120, 34, 10, 111, 112, 116, 117, 110, 97, 58, 32, 34, 111, 112, 116, 117, 110, 97, 34, 10, 111, 114, 106, 115, 111, 110, 58, 32, 34, 111, 114, 106, 115, 111, 110, 34, 10, 111, 114, 116, 111, 111, 108, 115, 58, 32, 34, 111, 114, 116, 111, 111, 108, 115, 34, 10, 112, 97, 110, 100, 97, 115, 58, 32, 34, 112, 97, 110, 100, 97, 115, 34, 10, 112, 97, 110, 100, 97, 115, 95, 100, 97, 116, 97, 114, 101, 97, 100, 101, 114, 58, 32, 34, 112, 97, 110, 100, 97, 115, 45, 100, 97, 116, 97, 114, 101, 97, 100, 101, 114, 34, 10, 112, 97, 110, 100, 97, 115, 95, 112, 114, 111, 102, 105, 108, 105, 110, 103, 58, 32, 34, 112, 97, 110, 100, 97, 115, 45, 112, 114, 111, 102, 105, 108, 105, 110, 103, 34, 10, 112, 97, 112, 101, 114, 109, 105, 108, 108, 58, 32, 34, 112, 97, 112, 101, 114, 109, 105, 108, 108, 34, 10, 112, 97, 114, 97, 109, 105, 107, 111, 58, 32, 34, 112, 97, 114, 97, 109, 105, 107, 111, 34, 10, 112, 101, 110, 100, 117, 108, 117, 109, 58, 32, 34, 112, 101, 110, 100, 117, 108, 117, 109, 34, 10, 80, 73, 76, 58, 32, 34, 80, 105, 108, 108, 111, 119, 34, 10, 112, 107, 103, 95, 114, 101, 115, 111, 117, 114, 99, 101, 115, 58, 32, 34, 115, 101, 116, 117, 112, 116, 111, 111, 108, 115, 34, 10, 112, 108, 111, 116, 108, 121, 58, 32, 34, 112, 108, 111, 116, 108, 121, 34, 10, 112, 108, 111, 116, 110, 105, 110, 101, 58, 32, 34, 112, 108, 111, 116, 110, 105, 110, 101, 34, 10, 112, 109, 100, 97, 114, 105, 109, 97, 58, 32, 34, 112, 109, 100, 97, 114, 105, 109, 97, 34, 10, 112, 111, 108, 97, 114, 115, 58, 32, 34, 112, 111, 108, 97, 114, 115, 34, 10, 112, 112, 116, 120, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 112, 112, 116, 120, 34, 10, 112, 114, 97, 119, 58, 32, 34, 112, 114, 97, 119, 34, 10, 112, 114, 111, 112, 104, 101, 116, 58, 32, 34, 112, 114, 111, 112, 104, 101, 116, 34, 10, 112, 114, 111, 116, 111, 98, 117, 102, 58, 32, 34, 112, 114, 111, 116, 111, 98, 117, 102, 34, 10, 112, 115, 117, 116, 105, 108, 58, 32, 34, 112, 115, 117, 116, 105, 108, 34, 10, 112, 115, 121, 99, 111, 112, 103, 50, 58, 32, 34, 112, 115, 121, 99, 111, 112, 103, 50, 45, 98, 105, 110, 97, 114, 121, 34, 10, 112, 117, 108, 112, 58, 32, 34, 80, 117, 76, 80, 34, 10, 112, 121, 97, 114, 114, 111, 119, 58, 32, 34, 112, 121, 97, 114, 114, 111, 119, 34, 10, 112, 121, 100, 97, 110, 116, 105, 99, 58, 32, 34, 112, 121, 100, 97, 110, 116, 105, 99, 34, 10, 112, 121, 100, 111, 116, 58, 32, 34, 112, 121, 100, 111, 116, 34, 10, 112, 121, 100, 117, 98, 58, 32, 34, 112, 121, 100, 117, 98, 34, 10, 112, 121, 103, 97, 109, 101, 58, 32, 34, 112, 121, 103, 97, 109, 101, 34, 10, 112, 121, 108, 97, 98, 58, 32, 34, 109, 97, 116, 112, 108, 111, 116, 108, 105, 98, 34, 10, 112, 121, 109, 99, 58, 32, 34, 112, 121, 109, 99, 34, 10, 112, 121, 109, 99, 51, 58, 32, 34, 112, 121, 109, 99, 51, 34, 10, 112, 121, 109, 111, 110, 103, 111, 58, 32, 34, 112, 121, 109, 111, 110, 103, 111, 34, 10, 112, 121, 109, 121, 115, 113, 108, 58, 32, 34, 80, 121, 77, 121, 83, 81, 76, 34, 10, 112, 121, 111, 100, 98, 99, 58, 32, 34, 112, 121, 111, 100, 98, 99, 34, 10, 112, 121, 112, 114, 111, 106, 58, 32, 34, 112, 121, 112, 114, 111, 106, 34, 10, 112, 121, 115, 112, 97, 114, 107, 58, 32, 34, 112, 121, 115, 112, 97, 114, 107, 34, 10, 112, 121, 116, 101, 115, 115, 101, 114, 97, 99, 116, 58, 32, 34, 112, 121, 116, 101, 115, 115, 101, 114, 97, 99, 116, 34, 10, 112, 121, 116, 101, 115, 116, 58, 32, 34, 112, 121, 116, 101, 115, 116, 34, 10, 112, 121, 116, 111, 114, 99, 104, 95, 108, 105, 103, 104, 116, 110, 105, 110, 103, 58, 32, 34, 112, 121, 116, 111, 114, 99, 104, 45, 108, 105, 103, 104, 116, 110, 105, 110, 103, 34, 10, 112, 121, 116, 122, 58, 32, 34, 112, 121, 116, 122, 34, 10, 114, 97, 115, 116, 101, 114, 105, 111, 58, 32, 34, 114, 97, 115, 116, 101, 114, 105, 111, 34, 10, 114, 97, 121, 58, 32, 34, 114, 97, 121, 34, 10, 114, 101, 100, 105, 115, 58, 32, 34, 114, 101, 100, 105, 115, 34, 10, 114, 101, 103, 101, 120, 58, 32, 34, 114, 101, 103, 101, 120, 34, 10, 114, 101, 113, 117, 101, 115, 116, 115, 58, 32, 34, 114, 101, 113, 117, 101, 115, 116, 115, 34, 10, 114, 105, 99, 104, 58, 32, 34, 114, 105, 99, 104, 34, 10, 115, 51, 102, 115, 58, 32, 34, 115, 51, 102, 115, 34, 10, 115, 99, 105, 112, 121, 58, 32, 34, 115, 99, 105, 112, 121, 34, 10, 115, 99, 114, 97, 112, 121, 58, 32, 34, 83, 99, 114, 97, 112, 121, 34, 10, 115, 101, 97, 98, 111, 114, 110, 58, 32, 34, 115, 101, 97, 98, 111, 114, 110, 34, 10, 115, 101, 108, 101, 110, 105, 117, 109, 58, 32, 34, 115, 101, 108, 101, 110, 105, 117, 109, 34, 10, 115, 101, 110, 116, 101, 110, 99, 101, 95, 116, 114, 97, 110, 115, 102, 111, 114, 109, 101, 114, 115, 58, 32, 34, 115, 101, 110, 116, 101, 110, 99, 101, 45, 116, 114, 97, 110, 115, 102, 111, 114, 109, 101, 114, 115, 34, 10, 115, 101, 110, 116, 101, 110, 99, 101, 112, 105, 101, 99, 101, 58, 32, 34, 115, 101, 110, 116, 101, 110, 99, 101, 112, 105, 101, 99, 101, 34, 10, 115, 101, 114, 105, 97, 108, 58, 32, 34, 112, 121, 115, 101, 114, 105, 97, 108, 34, 10, 115, 101, 116, 117, 112, 116, 111, 111, 108, 115, 58, 32, 34, 115, 101, 116, 117, 112, 116, 111, 111, 108, 115, 34, 10, 115, 104, 97, 112, 58, 32, 34, 115, 104, 97, 112, 34, 10, 115, 104, 97, 112, 101, 108, 121, 58, 32, 34, 115, 104, 97, 112, 101, 108, 121, 34, 10, 115, 105, 109, 112, 108, 101, 106, 115, 111, 110, 58, 32, 34, 115, 105, 109, 112, 108, 101, 106, 115, 111, 110, 34, 10, 115, 105, 120, 58, 32, 34, 115, 105, 120, 34, 10, 115, 107, 105, 109, 97, 103, 101, 58, 32, 34, 115, 99, 105, 107, 105, 116, 45, 105, 109, 97, 103, 101, 34, 10, 115, 107, 108, 101, 97, 114, 110, 58, 32, 34, 115, 99, 105, 107, 105, 116, 45, 108, 101, 97, 114, 110, 34, 10, 115, 107, 116, 105, 109, 101, 58, 32, 34, 115, 107, 116, 105, 109, 101, 34, 10, 115, 108, 117, 103, 105, 102, 121, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 115, 108, 117, 103, 105, 102, 121, 34, 10, 115, 110, 97, 112, 112, 121, 58, 32, 34, 112, 121, 116, 104, 111, 110, 45, 115, 110, 97, 112, 112, 121, 34, 10, 115, 111, 117, 110, 100, 102, 105, 108, 101, 58, 32, 34, 83, 111, 117, 110, 100, 70, 105, 108, 101, 34, 10, 115, 112, 97, 99, 121, 58, 32, 34, 115, 112, 97, 99, 121, 34, 10, 115, 113, 108, 97, 108, 99, 104, 101, 109, 121, 58, 32, 34, 83, 81, 76, 65, 108, 99, 104, 101, 109, 121, 34, 10, 115, 113, 108, 105, 116, 101, 95, 117, 116, 105, 108, 115, 58, 32, 34, 115, 113, 108, 105, 116, 101, 45, 117, 116, 105, 108, 115, 34, 10, 115, 116, 97, 116, 115, 109, 111, 100, 101, 108, 115, 58, 32, 34, 115, 116, 97, 116, 115, 109, 111, 100, 101, 108, 115, 34, 10, 115, 119, 101, 101, 116, 118, 105, 122, 58, 32, 34, 115, 119, 101, 101, 116, 118, 105, 122, 34, 10, 115, 121, 109, 112, 121, 58, 32, 34, 115, 121, 109, 112, 121, 34, 10, 116, 97, 58, 32, 34, 116, 97, 34, 10, 116, 97, 98, 108, 101, 115, 58, 32, 34, 116, 97, 98, 108, 101, 115, 34, 10, 116, 97, 98, 117, 108, 97, 116, 101, 58, 32, 34, 116, 97, 98, 117, 108, 97, 116, 101, 34, 10, 116, 101, 110, 115, 111, 114, 98, 111, 97, 114, 100, 58, 32, 34, 116, 101, 110, 115, 111, 114, 98, 111, 97, 114, 100, 34, 10, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 58, 32, 34, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 34, 10, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 95, 97, 100, 100, 111, 110, 115, 58, 32, 34, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 45, 97, 100, 100, 111, 110, 115, 34, 10, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 95, 100, 97, 116, 97, 115, 101, 116, 115, 58, 32, 34, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 45, 100, 97, 116, 97, 115, 101, 116, 115, 34, 10, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 95, 104, 117, 98, 58, 32, 34, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 45, 104, 117, 98, 34, 10, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 95, 112, 114, 111, 98, 97, 98, 105, 108, 105, 116, 121, 58, 32, 34, 116, 101, 110, 115, 111, 114, 102, 108, 111, 119, 45, 112, 114, 111, 98, 97, 98, 105, 108, 105, 116, 121, 34, 10, 116, 101, 120, 116, 98, 108, 111, 98, 58, 32, 34, 116, 101, 120, 116, 98, 108, 111, 98, 34, 10, 116, 105, 109, 109, 58, 32, 34, 116, 105, 109, 109, 34, 10, 116, 111, 107, 101, 110, 105, 122, 101, 114, 115, 58, 32, 34, 116, 111, 107, 101, 110, 105, 122, 101, 114, 115, 34, 10, 116, 111, 109, 108, 58, 32, 34, 116, 111, 109, 108, 34, 10, 116, 111, 109, 108, 105, 58, 32, 34, 116, 111, 109, 108, 105, 34, 10, 116, 111, 114, 99, 104, 58, 32, 34, 116, 111, 114, 99, 104, 34, 10, 116, 111, 114, 99, 104, 97, 117, 100, 105, 111, 58, 32, 34, 116, 111, 114, 99, 104, 97, 117, 100, 105, 111, 34, 10, 116, 111, 114, 99, 104, 116, 101, 120, 116, 58, 32, 34, 116, 111, 114, 99, 104, 116, 101, 120, 116, 34, 10, 116, 111, 114, 99, 104, 118, 105, 115, 105, 111, 110, 58, 32, 34, 116, 111, 114, 99, 104, 118, 105, 115, 105, 111, 110, 34, 10, 116, 113, 100, 109, 58, 32, 34, 116, 113, 100, 109, 34, 10, 116, 114, 97, 110, 115, 102, 111, 114, 109, 101, 114, 115, 58, 32, 34, 116, 114, 97, 110, 115, 102, 111, 114, 109, 101, 114, 115, 34, 10, 116, 119, 101, 101, 112, 121, 58, 32, 34, 116, 119, 101, 101, 112, 121, 34, 10, 116, 121, 112, 101, 114, 58, 32, 34, 116, 121, 112, 101, 114, 34, 10, 117, 106, 115, 111, 110, 58, 32, 34, 117, 106, 115, 111, 110, 34, 10, 117, 108, 116, 114, 97, 108, 121, 116, 105, 99, 115, 58, 32, 34, 117, 108, 116, 114, 97, 108, 121, 116, 105, 99, 115, 34, 10, 117, 109, 97, 112, 58, 32, 34, 117, 109, 97, 112, 45, 108, 101, 97, 114, 110, 34, 10, 117, 114, 108, 108, 105, 98, 51, 58, 32, 34, 117, 114, 108, 108, 105, 98, 51, 34, 10, 117, 115, 98, 58, 32, 34, 112, 121, 117, 115, 98, 34, 10, 117, 118, 105, 99, 111, 114, 110, 58, 32, 34, 117, 118, 105, 99, 111, 114, 110, 34, 10, 119, 97, 110, 100, 98, 58, 32, 34, 119, 97, 110, 100, 98, 34, 10, 119, 105, 110, 51, 50, 97, 112, 105, 58, 32, 34, 112, 121, 119, 105, 110, 51, 50, 34, 10, 119, 105, 110, 51, 50, 99, 111, 109, 58, 32, 34, 112, 121, 119, 105, 110, 51, 50, 34, 10, 119, 111, 114, 100, 99, 108, 111, 117, 100, 58, 32, 34, 119, 111, 114, 100, 99, 108, 111, 117, 100, 34, 10, 119, 120, 58, 32, 34, 119, 120, 80, 121, 116, 104, 111, 110, 34, 10, 120, 103, 98, 111, 111, 115, 116, 58, 32, 34, 120, 103, 98, 111, 111, 115, 116, 34, 10, 120, 108, 114, 100, 58, 32, 34, 120, 108, 114, 100, 34, 10, 120, 108, 115, 120, 119, 114, 105, 116, 101, 114, 58, 32, 34, 88, 108, 115, 120, 87, 114, 105, 116, 101, 114, 34, 10, 121, 97, 109, 108, 58, 32, 34, 80, 121, 89, 65, 77, 76, 34, 10, 121, 101, 108, 108, 111, 119, 98, 114, 105, 99, 107, 58, 32, 34, 121, 101, 108, 108, 111, 119, 98, 114, 105, 99, 107, 34, 10, 121, 102, 105, 110, 97, 110, 99, 101, 58, 32, 34, 121, 102, 105, 110, 97, 110, 99, 101, 34, 10, 122, 109, 113, 58, 32, 34, 112, 121, 122, 109, 113, 34, 10})
+	box.Add("/python/stdlib_modules.txt", []byte{35, 32, 84, 111, 112, 32, 108, 101, 118, 101, 108, 32, 109, 111, 100, 117, 108, 101, 115, 32, 111, 102, 32, 116, 104, 101, 32, 80, 121, 116, 104, 111, 110, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 108, 105, 98, 114, 97, 114, 121, 32, 40, 115, 121, 115, 46, 115, 116, 100, 108, 105, 98, 95, 109, 111, 100, 117, 108, 101, 95, 110, 97, 109, 101, 115, 44, 32, 80, 121, 116, 104, 111, 110, 32, 51, 46, 49, 49, 41, 46, 32, 73, 109, 112, 111, 114, 116, 115, 32, 111, 102, 10, 35, 32, 116, 104, 101, 115, 101, 32, 97, 114, 101, 32, 110, 101, 118, 101, 114, 32, 105, 110, 115, 116, 97, 108, 108, 101, 100, 46, 10, 95, 95, 102, 117, 116, 117, 114, 101, 95, 95, 10, 95, 95, 109, 97, 105, 110, 95, 95, 10, 95, 97, 98, 99, 10, 95, 97, 105, 120, 95, 115, 117, 112, 112, 111, 114, 116, 10, 95, 97, 115, 116, 10, 95, 97, 115, 121, 110, 99, 105, 111, 10, 95, 98, 105, 115, 101, 99, 116, 10, 95, 98, 108, 97, 107, 101, 50, 10, 95, 98, 111, 111, 116, 115, 117, 98, 112, 114, 111, 99, 101, 115, 115, 10, 95, 98, 122, 50, 10, 95, 99, 111, 100, 101, 99, 115, 10, 95, 99, 111, 100, 101, 99, 115, 95, 99, 110, 10, 95, 99, 111, 100, 101, 99, 115, 95, 104, 107, 10, 95, 99, 111, 100, 101, 99, 115, 95, 105, 115, 111, 50, 48, 50, 50, 10, 95, 99, 111, 100, 101, 99, 115, 95, 106, 112, 10, 95, 99, 111, 100, 101, 99, 115, 95, 107, 114, 10, 95, 99, 111, 100, 101, 99, 115, 95, 116, 119, 10, 95, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 10, 95, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 95, 97, 98, 99, 10, 95, 99, 111, 109, 112, 97, 116, 95, 112, 105, 99, 107, 108, 101, 10, 95, 99, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 10, 95, 99, 111, 110, 116, 101, 120, 116, 118, 97, 114, 115, 10, 95, 99, 114, 121, 112, 116, 10, 95, 99, 115, 118, 10, 95, 99, 116, 121, 112, 101, 115, 10, 95, 99, 117, 114, 115, 101, 115, 10, 95, 99, 117, 114, 115, 101, 115, 95, 112, 97, 110, 101, 108, 10, 95, 100, 97, 116, 101, 116, 105, 109, 101, 10, 95, 100, 98, 109, 10, 95, 100, 101, 99, 105, 109, 97, 108, 10, 95, 101, 108, 101, 109, 101, 110, 116, 116, 114, 101, 101, 10, 95, 102, 114, 111, 122, 101, 110, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 10, 95, 102, 114, 111, 122, 101, 110, 95, 105, 109, 112, 111, 114, 116, 108, 105, 98, 95, 101, 120, 116, 101, 114, 110, 97, 108, 10, 95, 102, 117, 110, 99, 116, 111, 111, 108, 115, 10, 95, 103, 100, 98, 109, 10, 95, 104, 97, 115, 104, 108, 105, 98, 10, 95, 104, 101, 97, 112, 113, 10, 95, 105, 109, 112, 10, 95, 105, 111, 10, 95, 106, 115, 111, 110, 10, 95, 108, 111, 99, 97, 108, 101, 10, 95, 108, 115, 112, 114, 111, 102, 10, 95, 108, 122, 109, 97, 10, 95, 109, 97, 114, 107, 117, 112, 98, 97, 115, 101, 10, 95, 109, 100, 53, 10, 95, 109, 115, 105, 10, 95, 109, 117, 108, 116, 105, 98, 121, 116, 101, 99, 111, 100, 101, 99, 10, 95, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 10, 95, 111, 112, 99, 111, 100, 101, 10, 95, 111, 112, 101, 114, 97, 116, 111, 114, 10, 95, 111, 115, 120, 95, 115, 117, 112, 112, 111, 114, 116, 10, 95, 111, 118, 101, 114, 108, 97, 112, 112, 101, 100, 10, 95, 112, 105, 99, 107, 108, 101, 10, 95, 112, 111, 115, 105, 120, 115, 104, 109, 101, 109, 10, 95, 112, 111, 115, 105, 120, 115, 117, 98, 112, 114, 111, 99, 101, 115, 115, 10, 95, 112, 121, 95, 97, 98, 99, 10, 95, 112, 121, 100, 101, 99, 105, 109, 97, 108, 10, 95, 112, 121, 105, 111, 10, 95, 113, 117, 101, 117, 101, 10, 95, 114, 97, 110, 100, 111, 109, 10, 95, 115, 99, 112, 114, 111, 120, 121, 10, 95, 115, 104, 97, 49, 10, 95, 115, 104, 97, 50, 53, 54, 10, 95, 115, 104, 97, 51, 10, 95, 115, 104, 97, 53, 49, 50, 10, 95, 115, 105, 103, 110, 97, 108, 10, 95, 115, 105, 116, 101, 98, 117, 105, 108, 116, 105, 110, 115, 10, 95, 115, 111, 99, 107, 101, 116, 10, 95, 115, 113, 108, 105, 116, 101, 51, 10, 95, 115, 114, 101, 10, 95, 115, 115, 108, 10, 95, 115, 116, 97, 116, 10, 95, 115, 116, 97, 116, 105, 115, 116, 105, 99, 115, 10, 95, 115, 116, 114, 105, 110, 103, 10, 95, 115, 116, 114, 112, 116, 105, 109, 101, 10, 95, 115, 116, 114, 117, 99, 116, 10, 95, 115, 121, 109, 116, 97, 98, 108, 101, 10, 95, 116, 104, 114, 101, 97, 100, 10, 95, 116, 104, 114, 101, 97, 100, 105, 110, 103, 95, 108, 111, 99, 97, 108, 10, 95, 116, 107, 105, 110, 116, 101, 114, 10, 95, 116, 111, 107, 101, 110, 105, 122, 101, 10, 95, 116, 114, 97, 99, 101, 109, 97, 108, 108, 111, 99, 10, 95, 116, 121, 112, 105, 110, 103, 10, 95, 117, 117, 105, 100, 10, 95, 119, 97, 114, 110, 105, 110, 103, 115, 10, 95, 119, 101, 97, 107, 114, 101, 102, 10, 95, 119, 101, 97, 107, 114, 101, 102, 115, 101, 116, 10, 95, 119, 105, 110, 97, 112, 105, 10, 95, 122, 111, 110, 101, 105, 110, 102, 111, 10, 97, 98, 99, 10, 97, 105, 102, 99, 10, 97, 110, 116, 105, 103, 114, 97, 118, 105, 116, 121, 10, 97, 114, 103, 112, 97, 114, 115, 101, 10, 97, 114, 114, 97, 121, 10, 97, 115, 116, 10, 97, 115, 121, 110, 99, 104, 97, 116, 10, 97, 115, 121, 110, 99, 105, 111, 10, 97, 115, 121, 110, 99, 111, 114, 101, 10, 97, 116, 101, 120, 105, 116, 10, 97, 117, 100, 105, 111, 111, 112, 10, 98, 97, 115, 101, 54, 52, 10, 98, 100, 98, 10, 98, 105, 110, 97, 115, 99, 105, 105, 10, 98, 105, 115, 101, 99, 116, 10, 98, 117, 105, 108, 116, 105, 110, 115, 10, 98, 122, 50, 10, 99, 80, 114, 111, 102, 105, 108, 101, 10, 99, 97, 108, 101, 110, 100, 97, 114, 10, 99, 103, 105, 10, 99, 103, 105, 116, 98, 10, 99, 104, 117, 110, 107, 10, 99, 109, 97, 116, 104, 10, 99, 109, 100, 10, 99, 111, 100, 101, 10, 99, 111, 100, 101, 99, 115, 10, 99, 111, 100, 101, 111, 112, 10, 99, 111, 108, 108, 101, 99, 116, 105, 111, 110, 115, 10, 99, 111, 108, 111, 114, 115, 121, 115, 10, 99, 111, 109, 112, 105, 108, 101, 97, 108, 108, 10, 99, 111, 110, 99, 117, 114, 114, 101, 110, 116, 10, 99, 111, 110, 102, 105, 103, 112, 97, 114, 115, 101, 114, 10, 99, 111, 110, 116, 101, 120, 116, 108, 105, 98, 10, 99, 111, 110, 116, 101, 120, 116, 118, 97, 114, 115, 10, 99, 111, 112, 121, 10, 99, 111, 112, 121, 114, 101, 103, 10, 99, 114, 121, 112, 116, 10, 99, 115, 118, 10, 99, 116, 121, 112, 101, 115, 10, 99, 117, 114, 115, 101, 115, 10, 100, 97, 116, 97, 99, 108, 97, 115, 115, 101, 115, 10, 100, 97, 116, 101, 116, 105, 109, 101, 10, 100, 98, 109, 10, 100, 101, 99, 105, 109, 97, 108, 10, 100, 105, 102, 102, 108, 105, 98, 10, 100, 105, 115, 10, 100, 105, 115, 116, 117, 116, 105, 108, 115, 10, 100, 111, 99, 116, 101, 115, 116, 10, 101, 109, 97, 105, 108, 10, 101, 110, 99, 111, 100, 105, 110, 103, 115, 10, 101, 110, 115, 117, 114, 101, 112, 105, 112, 10, 101, 110, 117, 109, 10, 101, 114, 114, 110, 111, 10, 102, 97, 117, 108, 116, 104, 97, 110, 100, 108, 101, 114, 10, 102, 99, 110, 116, 108, 10, 102, 105, 108, 101, 99, 109, 112, 10, 102, 105, 108, 101, 105, 110, 112, 117, 116, 10, 102, 110, 109, 97, 116, 99, 104, 10, 102, 114, 97, 99, 116, 105, 111, 110, 115, 10, 102, 116, 112, 108, 105, 98, 10, 102, 117, 110, 99, 116, 111, 111, 108, 115, 10, 103, 99, 10, 103, 101, 110, 101, 114, 105, 99, 112, 97, 116, 104, 10, 103, 101, 116, 111, 112, 116, 10, 103, 101, 116, 112, 97, 115, 115, 10, 103, 101, 116, 116, 101, 120, 116, 10, 103, 108, 111, 98, 10, 103, 114, 97, 112, 104, 108, 105, 98, 10, 103, 114, 112, 10, 103, 122, 105, 112, 10, 104, 97, 115, 104, 108, 105, 98, 10, 104, 101, 97, 112, 113, 10, 104, 109, 97, 99, 10, 104, 116, 109, 108, 10, 104, 116, 116, 112, 10, 105, 100, 108, 101, 108, 105, 98, 10, 105, 109, 97, 112, 108, 105, 98, 10, 105, 109, 103, 104, 100, 114, 10, 105, 109, 112, 10, 105, 109, 112, 111, 114, 116, 108, 105, 98, 10, 105, 110, 115, 112, 101, 99, 116, 10, 105, 111, 10, 105, 112, 97, 100, 100, 114, 101, 115, 115, 10, 105, 116, 101, 114, 116, 111, 111, 108, 115, 10, 106, 115, 111, 110, 10, 107, 101, 121, 119, 111, 114, 100, 10, 108, 105, 98, 50, 116, 111, 51, 10, 108, 105, 110, 101, 99, 97, 99, 104, 101, 10, 108, 111, 99, 97, 108, 101, 10, 108, 111, 103, 103, 105, 110, 103, 10, 108, 122, 109, 97, 10, 109, 97, 105, 108, 98, 111, 120, 10, 109, 97, 105, 108, 99, 97, 112, 10, 109, 97, 114, 115, 104, 97, 108, 10, 109, 97, 116, 104, 10, 109, 105, 109, 101, 116, 121, 112, 101, 115, 10, 109, 109, 97, 112, 10, 109, 111, 100, 117, 108, 101, 102, 105, 110, 100, 101, 114, 10, 109, 115, 105, 108, 105, 98, 10, 109, 115, 118, 99, 114, 116, 10, 109, 117, 108, 116, 105, 112, 114, 111, 99, 101, 115, 115, 105, 110, 103, 10, 110, 101, 116, 114, 99, 10, 110, 105, 115, 10, 110, 110, 116, 112, 108, 105, 98, 10, 110, 116, 10, 110, 116, 112, 97, 116, 104, 10, 110, 116, 117, 114, 108, 50, 112, 97, 116, 104, 10, 110, 117, 109, 98, 101, 114, 115, 10, 111, 112, 99, 111, 100, 101, 10, 111, 112, 101, 114, 97, 116, 111, 114, 10, 111, 112, 116, 112, 97, 114, 115, 101, 10, 111, 115, 10, 111, 115, 115, 97, 117, 100, 105, 111, 100, 101, 118, 10, 112, 97, 116, 104, 108, 105, 98, 10, 112, 100, 98, 10, 112, 105, 99, 107, 108, 101, 10, 112, 105, 99, 107, 108, 101, 116, 111, 111, 108, 115, 10, 112, 105, 112, 101, 115, 10, 112, 107, 103, 117, 116, 105, 108, 10, 112, 108, 97, 116, 102, 111, 114, 109, 10, 112, 108, 105, 115, 116, 108, 105, 98, 10, 112, 111, 112, 108, 105, 98, 10, 112, 111, 115, 105, 120, 10, 112, 111, 115, 105, 120, 112, 97, 116, 104, 10, 112, 112, 114, 105, 110, 116, 10, 112, 114, 111, 102, 105, 108, 101, 10, 112, 115, 116, 97, 116, 115, 10, 112, 116, 121, 10, 112, 119, 100, 10, 112, 121, 95, 99, 111, 109, 112, 105, 108, 101, 10, 112, 121, 99, 108, 98, 114, 10, 112, 121, 100, 111, 99, 10, 112, 121, 100, 111, 99, 95, 100, 97, 116, 97, 10, 112, 121, 101, 120, 112, 97, 116, 10, 113, 117, 101, 117, 101, 10, 113, 117, 111, 112, 114, 105, 10, 114, 97, 110, 100, 111, 109, 10, 114, 101, 10, 114, 101, 97, 100, 108, 105, 110, 101, 10, 114, 101, 112, 114, 108, 105, 98, 10, 114, 101, 115, 111, 117, 114, 99, 101, 10, 114, 108, 99, 111, 109, 112, 108, 101, 116, 101, 114, 10, 114, 117, 110, 112, 121, 10, 115, 99, 104, 101, 100, 10, 115, 101, 99, 114, 101, 116, 115, 10, 115, 101, 108, 101, 99, 116, 10, 115, 101, 108, 101, 99, 116, 111, 114, 115, 10, 115, 104, 101, 108, 118, 101, 10, 115, 104, 108, 101, 120, 10, 115, 104, 117, 116, 105, 108, 10, 115, 105, 103, 110, 97, 108, 10, 115, 105, 116, 101, 10, 115, 109, 116, 112, 100, 10, 115, 109, 116, 112, 108, 105, 98, 10, 115, 110, 100, 104, 100, 114, 10, 115, 111, 99, 107, 101, 116, 10, 115, 111, 99, 107, 101, 116, 115, 101, 114, 118, 101, 114, 10, 115, 112, 119, 100, 10, 115, 113, 108, 105, 116, 101, 51, 10, 115, 114, 101, 95, 99, 111, 109, 112, 105, 108, 101, 10, 115, 114, 101, 95, 99, 111, 110, 115, 116, 97, 110, 116, 115, 10, 115, 114, 101, 95, 112, 97, 114, 115, 101, 10, 115, 115, 108, 10, 115, 116, 97, 116, 10, 115, 116, 97, 116, 105, 115, 116, 105, 99, 115, 10, 115, 116, 114, 105, 110, 103, 10, 115, 116, 114, 105, 110, 103, 112, 114, 101, 112, 10, 115, 116, 114, 117, 99, 116, 10, 115, 117, 98, 112, 114, 111, 99, 101, 115, 115, 10, 115, 117, 110, 97, 117, 10, 115, 121, 109, 116, 97, 98, 108, 101, 10, 115, 121, 115, 10, 115, 121, 115, 99, 111, 110, 102, 105, 103, 10, 115, 121, 115, 108, 111, 103, 10, 116, 97, 98, 110, 97, 110, 110, 121, 10, 116, 97, 114, 102, 105, 108, 101, 10, 116, 101, 108, 110, 101, 116, 108, 105, 98, 10, 116, 101, 109, 112, 102, 105, 108, 101, 10, 116, 101, 114, 109, 105, 111, 115, 10, 116, 101, 120, 116, 119, 114, 97, 112, 10, 116, 104, 105, 115, 10, 116, 104, 114, 101, 97, 100, 105, 110, 103, 10, 116, 105, 109, 101, 10, 116, 105, 109, 101, 105, 116, 10, 116, 107, 105, 110, 116, 101, 114, 10, 116, 111, 107, 101, 110, 10, 116, 111, 107, 101, 110, 105, 122, 101, 10, 116, 111, 109, 108, 108, 105, 98, 10, 116, 114, 97, 99, 101, 10, 116, 114, 97, 99, 101, 98, 97, 99, 107, 10, 116, 114, 97, 99, 101, 109, 97, 108, 108, 111, 99, 10, 116, 116, 121, 10, 116, 117, 114, 116, 108, 101, 10, 116, 117, 114, 116, 108, 101, 100, 101, 109, 111, 10, 116, 121, 112, 101, 115, 10, 116, 121, 112, 105, 110, 103, 10, 117, 110, 105, 99, 111, 100, 101, 100, 97, 116, 97, 10, 117, 110, 105, 116, 116, 101, 115, 116, 10, 117, 114, 108, 108, 105, 98, 10, 117, 117, 10, 117, 117, 105, 100, 10, 118, 101, 110, 118, 10, 119, 97, 114, 110, 105, 110, 103, 115, 10, 119, 97, 118, 101, 10, 119, 101, 97, 107, 114, 101, 102, 10, 119, 101, 98, 98, 114, 111, 119, 115, 101, 114, 10, 119, 105, 110, 114, 101, 103, 10, 119, 105, 110, 115, 111, 117, 110, 100, 10, 119, 115, 103, 105, 114, 101, 102, 10, 120, 100, 114, 108, 105, 98, 10, 120, 109, 108, 10, 120, 109, 108, 114, 112, 99, 10, 122, 105, 112, 97, 112, 112, 10, 122, 105, 112, 102, 105, 108, 101, 10, 122, 105, 112, 105, 109, 112, 111, 114, 116, 10, 122, 108, 105, 98, 10, 122, 111, 110, 101, 105, 110, 102, 111, 10})
 }
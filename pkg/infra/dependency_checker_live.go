@@ -209,16 +209,12 @@ func (dc *LiveDependencyCheckers) IsKFPReady() (running bool, err error) {
 }
 
 func (dc *LiveDependencyCheckers) CheckForMissingPackages(target string) error {
-	requiredLibraries := []string{"dill", "pipreqs", "requests"}
+	requiredLibraries := []string{"dill", "requests"}
 
-	switch target {
-	case "aml":
+	// Notebooks compile to an Argo workflow without kfp, which is only needed to compile pipelines written with
+	// the KFP SDK (see utils.CompileForKFP)
+	if target == "aml" {
 		requiredLibraries = append(requiredLibraries, "azureml", "azureml.core", "azureml.pipeline")
-	case "kubeflow":
-		fallthrough
-	default:
-		requiredLibraries = append(requiredLibraries, "kfp")
-
 	}
 
 	log.Tracef("Freezing python packages")
@@ -0,0 +1,407 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/internal/box"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+// ArgoWorkflowFileName is the Argo workflow a program compiled for Kubeflow is uploaded as
+const ArgoWorkflowFileName = "workflow.yaml"
+
+const (
+	runInfoTask       = "get-run-info"
+	createContextTask = "create-context-file"
+	outputsDir        = "/tmp/outputs"
+	inputsDir         = "/tmp/inputs"
+)
+
+// workflowNameRegex matches the characters Argo doesn't allow in the names of templates and tasks
+var workflowNameRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// CreateArgoWorkflow builds the Argo workflow Kubeflow runs a program as, from the step files written to
+// compiledDir by WriteStepFiles. It is what the KFP SDK compiles the root file to, so uploading a program
+// doesn't need Python: each step runs its step file as a program, in the image of its environment.
+func (c *CompileLive) CreateArgoWorkflow(aggregatedSteps map[string]CodeBlock, sameConfigFile loaders.SameConfig, compiledDir string) (*v1alpha1.Workflow, error) {
+	contextStorage, err := ResolveContextStorage(sameConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	envVarsByEnvironment, err := EnvVarsByEnvironment(sameConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	stepOrder, err := StepOrder(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+	packagesBySteps, err := StepPackages(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parameters cross into the workflow as strings and are decoded by type in each step
+	runParameters := encodedRunParameters(sameConfigFile)
+	workflowParameters := make([]v1alpha1.Parameter, 0, len(runParameters)+2)
+	for _, runParameter := range runParameters {
+		workflowParameters = append(workflowParameters, v1alpha1.Parameter{Name: runParameter.Name, Value: v1alpha1.AnyStringPtr(runParameter.Default)})
+	}
+	workflowParameters = append(workflowParameters,
+		v1alpha1.Parameter{Name: "context", Value: v1alpha1.AnyStringPtr("")},
+		v1alpha1.Parameter{Name: "metadata_url", Value: v1alpha1.AnyStringPtr("")},
+	)
+
+	programName := workflowName(sameConfigFile.Spec.Metadata.Name)
+	if err := checkWorkflowNames(programName, stepOrder); err != nil {
+		return nil, err
+	}
+	// The tasks every workflow starts with run in the image of the default environment, so they unpickle the
+	// context with the same Python and dill as the steps
+	helperImage := environmentImage(sameConfigFile, "default")
+	runInfoProgram := string(box.Get("/kfp/get_run_info.py"))
+	createContextProgram := string(box.Get("/kfp/create_context_file.py"))
+	templates := []v1alpha1.Template{
+		{
+			Name: runInfoTask,
+			Container: &apiv1.Container{
+				Image:   helperImage,
+				Command: pythonProgramCommand([]string{"kfp", "dill"}, runInfoProgram),
+				Args:    []string{"--run-id", "{{workflow.uid}}", "--run-info", outputsDir + "/run_info/data"},
+			},
+			Outputs: v1alpha1.Outputs{
+				Parameters: []v1alpha1.Parameter{{Name: "run_info", ValueFrom: &v1alpha1.ValueFrom{Path: outputsDir + "/run_info/data"}}},
+			},
+		},
+		{
+			Name: createContextTask,
+			Container: &apiv1.Container{
+				Image:   helperImage,
+				Command: pythonProgramCommand(nil, createContextProgram),
+				// A run starts from the context it is given, or an empty one, as it does with the root file
+				Args: []string{"--context-string", "{{workflow.parameters.context}}", "--output-context", outputsDir + "/output_context/data"},
+			},
+			Outputs: v1alpha1.Outputs{
+				Artifacts: []v1alpha1.Artifact{{Name: "output_context", Path: outputsDir + "/output_context/data"}},
+			},
+		},
+	}
+	tasks := []v1alpha1.DAGTask{
+		{Name: runInfoTask, Template: runInfoTask},
+		{Name: createContextTask, Template: createContextTask},
+	}
+
+	for _, stepName := range stepOrder {
+		codeBlock := aggregatedSteps[stepName]
+		program, err := ioutil.ReadFile(filepath.Join(compiledDir, stepName+".py"))
+		if err != nil {
+			return nil, fmt.Errorf("could not read the step file of %v: %v", stepName, err)
+		}
+
+		template, task, err := stepTemplate(codeBlock, string(program), packagesBySteps[stepName], sameConfigFile, envVarsByEnvironment[codeBlock.EnvironmentName], contextStorage, runParameters)
+		if err != nil {
+			return nil, fmt.Errorf("step %v: %v", stepName, err)
+		}
+		templates = append(templates, template)
+		tasks = append(tasks, task)
+	}
+	templates = append(templates, v1alpha1.Template{Name: programName, DAG: &v1alpha1.DAGTemplate{Tasks: tasks}})
+
+	workflow := &v1alpha1.Workflow{
+		TypeMeta: metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow"},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: programName + "-",
+		},
+		Spec: v1alpha1.WorkflowSpec{
+			Entrypoint:         programName,
+			Arguments:          v1alpha1.Arguments{Parameters: workflowParameters},
+			ServiceAccountName: "pipeline-runner",
+			Templates:          templates,
+		},
+	}
+
+	// Environments on private registries without a secret pull their images with the secret 'same program run'
+	// creates, see ImagePullSecretName
+	for _, environment := range sameConfigFile.Spec.Environments {
+		if environment.PrivateRegistry && environment.Credentials.SecretName == "" {
+			workflow.Spec.ImagePullSecrets = []apiv1.LocalObjectReference{{Name: ImagePullSecretName(sameConfigFile.Spec.Metadata.Name)}}
+			break
+		}
+	}
+	return workflow, nil
+}
+
+// stepTemplate returns the template a step runs as, and the task running it in the workflow's DAG.
+func stepTemplate(codeBlock CodeBlock, program string, packages []string, sameConfigFile loaders.SameConfig, envVars EnvVars, contextStorage ContextStorage, runParameters []RunParameter) (v1alpha1.Template, v1alpha1.DAGTask, error) {
+	name := workflowName(codeBlock.StepIdentifier)
+
+	// The first parent's context comes in as the step's input context, and the others' as extra ones
+	inputArtifacts := make([]v1alpha1.Artifact, 0, len(codeBlock.Parents)+1)
+	argumentArtifacts := make([]v1alpha1.Artifact, 0, len(codeBlock.Parents)+1)
+	args := make([]string, 0)
+	dependencies := []string{runInfoTask}
+	contextSources := []string{createContextTask}
+	contextInputs := []string{"input_context"}
+	contextFlags := []string{"--input-context"}
+	if len(codeBlock.Parents) > 0 {
+		contextSources = make([]string, 0, len(codeBlock.Parents))
+		for _, parent := range codeBlock.Parents {
+			contextSources = append(contextSources, workflowName(parent))
+		}
+		for _, parent := range extraParents(codeBlock.Parents) {
+			contextInputs = append(contextInputs, "input_context_"+parent)
+			contextFlags = append(contextFlags, "--input-context-"+parent)
+		}
+	}
+	for i, contextSource := range contextSources {
+		path := fmt.Sprintf("%v/%v/data", inputsDir, contextInputs[i])
+		inputArtifacts = append(inputArtifacts, v1alpha1.Artifact{Name: contextInputs[i], Path: path})
+		argumentArtifacts = append(argumentArtifacts, v1alpha1.Artifact{
+			Name: contextInputs[i],
+			From: fmt.Sprintf("{{tasks.%v.outputs.artifacts.output_context}}", contextSource),
+		})
+		args = append(args, contextFlags[i], path)
+		dependencies = AppendIfMissing(dependencies, contextSource)
+	}
+	sort.Strings(dependencies)
+	args = append(args, "--output-context", outputsDir+"/output_context/data")
+
+	inputParameters := []v1alpha1.Parameter{{Name: "run_info"}, {Name: "metadata_url"}}
+	argumentParameters := []v1alpha1.Parameter{
+		{Name: "run_info", Value: v1alpha1.AnyStringPtr(fmt.Sprintf("{{tasks.%v.outputs.parameters.run_info}}", runInfoTask))},
+		{Name: "metadata_url", Value: v1alpha1.AnyStringPtr("{{workflow.parameters.metadata_url}}")},
+	}
+	args = append(args, "--run-info", "{{inputs.parameters.run_info}}", "--metadata-url", "{{inputs.parameters.metadata_url}}")
+	for _, runParameter := range runParameters {
+		inputParameters = append(inputParameters, v1alpha1.Parameter{Name: runParameter.Name})
+		argumentParameters = append(argumentParameters, v1alpha1.Parameter{
+			Name:  runParameter.Name,
+			Value: v1alpha1.AnyStringPtr(fmt.Sprintf("{{workflow.parameters.%v}}", runParameter.Name)),
+		})
+		args = append(args, "--"+runParameter.Name, fmt.Sprintf("{{inputs.parameters.%v}}", runParameter.Name))
+	}
+
+	resources, err := ResolveStepResources(codeBlock, sameConfigFile)
+	if err != nil {
+		return v1alpha1.Template{}, v1alpha1.DAGTask{}, err
+	}
+	limits, err := resourceLimits(resources)
+	if err != nil {
+		return v1alpha1.Template{}, v1alpha1.DAGTask{}, err
+	}
+
+	// Secret looking values are read from the secret 'same program run' creates, see CreateEnvFileSecrets
+	env := make([]apiv1.EnvVar, 0, len(envVars.Plain)+len(envVars.Secrets)+2)
+	for _, envName := range SortedEnvVarNames(envVars.Plain) {
+		env = append(env, apiv1.EnvVar{Name: envName, Value: envVars.Plain[envName]})
+	}
+	secretName := EnvSecretName(sameConfigFile.Spec.Metadata.Name, codeBlock.EnvironmentName)
+	for _, envName := range SortedEnvVarNames(envVars.Secrets) {
+		env = append(env, secretEnvVar(envName, secretName, envName))
+	}
+	if contextStorage.Enabled() {
+		env = append(env,
+			secretEnvVar("AWS_ACCESS_KEY_ID", contextStorage.CredentialsSecret, "accesskey"),
+			secretEnvVar("AWS_SECRET_ACCESS_KEY", contextStorage.CredentialsSecret, "secretkey"),
+		)
+	}
+
+	stepPackages := []string{"dill", "requests"}
+	if contextStorage.Enabled() {
+		stepPackages = append(stepPackages, "boto3")
+	}
+	stepPackages = append(stepPackages, packages...)
+
+	template := v1alpha1.Template{
+		Name: name,
+		Metadata: v1alpha1.Metadata{
+			Annotations: map[string]string{"pipelines.kubeflow.org/task_display_name": codeBlock.DisplayName},
+			Labels:      map[string]string{"pipelines.kubeflow.org/enable_caching": "true"},
+		},
+		Inputs: v1alpha1.Inputs{Parameters: inputParameters, Artifacts: inputArtifacts},
+		Outputs: v1alpha1.Outputs{
+			Artifacts: []v1alpha1.Artifact{{Name: "output_context", Path: outputsDir + "/output_context/data"}},
+		},
+		Container: &apiv1.Container{
+			Image:   environmentImage(sameConfigFile, codeBlock.EnvironmentName),
+			Command: pythonProgramCommand(stepPackages, program),
+			Args:    args,
+		},
+	}
+	if codeBlock.CacheValue != "" {
+		template.Metadata.Annotations["pipelines.kubeflow.org/max_cache_staleness"] = codeBlock.CacheValue
+	}
+	if len(env) > 0 {
+		template.Container.Env = env
+	}
+	if len(limits) > 0 {
+		template.Container.Resources = apiv1.ResourceRequirements{Limits: limits}
+	}
+	if gpuNodeSelectorKey, gpuNodeSelectorValue := GPUNodeSelector(resources.GPUType); gpuNodeSelectorKey != "" {
+		template.NodeSelector = map[string]string{gpuNodeSelectorKey: gpuNodeSelectorValue}
+	}
+	if retries := codeBlock.FailurePolicy.PlatformRetryCount(); retries > 0 {
+		limit := intstr.FromInt(retries)
+		template.RetryStrategy = &v1alpha1.RetryStrategy{Limit: &limit}
+	}
+	if timeoutSeconds := codeBlock.FailurePolicy.PlatformTimeoutSeconds(); timeoutSeconds > 0 {
+		timeout := intstr.FromInt(timeoutSeconds)
+		template.ActiveDeadlineSeconds = &timeout
+	}
+
+	task := v1alpha1.DAGTask{
+		Name:         name,
+		Template:     name,
+		Arguments:    v1alpha1.Arguments{Parameters: argumentParameters, Artifacts: argumentArtifacts},
+		Dependencies: dependencies,
+	}
+	return template, task, nil
+}
+
+// pythonProgramCommand returns the command running a Python program after installing packages, the way the
+// KFP SDK runs the components it creates from functions.
+func pythonProgramCommand(packages []string, program string) []string {
+	launcher := "program_path=$(mktemp)\nprintf \"%s\" \"$0\" > \"$program_path\"\npython3 -u \"$program_path\" \"$@\"\n"
+	if len(packages) == 0 {
+		return []string{"sh", "-ec", launcher, program}
+	}
+
+	quotedPackages := make([]string, 0, len(packages))
+	for _, packageName := range packages {
+		quotedPackages = append(quotedPackages, shellQuote(packageName))
+	}
+	pipInstall := "PIP_DISABLE_PIP_VERSION_CHECK=1 python3 -m pip install --quiet --no-warn-script-location " + strings.Join(quotedPackages, " ")
+	return []string{
+		"sh", "-c", fmt.Sprintf("(%v || %v --user) && \"$0\" \"$@\"", pipInstall, pipInstall),
+		"sh", "-ec", launcher, program,
+	}
+}
+
+// resourceLimits returns the limits of a step's resources, see ResolveStepResources.
+func resourceLimits(resources loaders.StepResources) (apiv1.ResourceList, error) {
+	limits := apiv1.ResourceList{}
+	for resourceName, value := range map[apiv1.ResourceName]string{
+		apiv1.ResourceCPU:    resources.CPU,
+		apiv1.ResourceMemory: resources.Memory,
+		"nvidia.com/gpu":     resources.GPU,
+	} {
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the %v limit '%v': %v", resourceName, value, err)
+		}
+		limits[resourceName] = quantity
+	}
+	return limits, nil
+}
+
+func secretEnvVar(name string, secretName string, key string) apiv1.EnvVar {
+	return apiv1.EnvVar{
+		Name: name,
+		ValueFrom: &apiv1.EnvVarSource{
+			SecretKeyRef: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: secretName}, Key: key},
+		},
+	}
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+// checkWorkflowNames checks that no two steps, and no step and a template SAME adds, have the same name in the
+// workflow, as names that differ only in case or in '_' and '-' (e.g. 'Train' and 'train') become the same one.
+func checkWorkflowNames(programName string, stepNames []string) error {
+	namesTaken := map[string]string{
+		runInfoTask:       fmt.Sprintf("the '%v' task SAME adds", runInfoTask),
+		createContextTask: fmt.Sprintf("the '%v' task SAME adds", createContextTask),
+		programName:       fmt.Sprintf("the program's DAG, named after the program ('%v')", programName),
+	}
+	for _, stepName := range stepNames {
+		name := workflowName(stepName)
+		if takenBy, taken := namesTaken[name]; taken {
+			return fmt.Errorf("step %v and %v have the same name in the Argo workflow, '%v' - rename one of them", stepName, takenBy, name)
+		}
+		namesTaken[name] = fmt.Sprintf("step %v", stepName)
+	}
+	return nil
+}
+
+// workflowName turns a name into one Argo allows for workflows, templates and tasks, e.g. 'same-step-1' for
+// 'same_step_1'.
+func workflowName(name string) string {
+	name = strings.Trim(workflowNameRegex.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	return ValueOrDefault(name, "same-program")
+}
+
+// ImagePullSecretName is the name of the secret 'same program run' creates to pull the images of the
+// environments on private registries that don't name a secret of their own.
+func ImagePullSecretName(experimentName string) string {
+	return alphaNumericOnly(removeIllegalExperimentNameCharacters(experimentName))
+}
+
+// ArgoWorkflowYAML returns a workflow as YAML, without the fields only a running workflow has.
+func ArgoWorkflowYAML(workflow *v1alpha1.Workflow) ([]byte, error) {
+	workflowBytes, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the Argo workflow: %v", err)
+	}
+	workflowMap := make(map[string]interface{})
+	if err := json.Unmarshal(workflowBytes, &workflowMap); err != nil {
+		return nil, fmt.Errorf("could not marshal the Argo workflow: %v", err)
+	}
+	delete(workflowMap, "status")
+	if metadata, ok := workflowMap["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+	removeEmptyObjects(workflowMap)
+
+	workflowYAML, err := yaml.Marshal(workflowMap)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal the Argo workflow: %v", err)
+	}
+	return workflowYAML, nil
+}
+
+// removeEmptyObjects removes the empty objects nested in a value parsed from JSON, e.g. the 'inputs: {}' of
+// templates without inputs, which are the same to Argo as leaving them out.
+func removeEmptyObjects(value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			removeEmptyObjects(child)
+			if childMap, ok := child.(map[string]interface{}); ok && len(childMap) == 0 {
+				delete(value, key)
+			}
+		}
+	case []interface{}:
+		for _, child := range value {
+			removeEmptyObjects(child)
+		}
+	}
+}
+
+// WriteArgoWorkflow writes a workflow into the directory of the program it runs, see ArgoWorkflowFileName.
+func WriteArgoWorkflow(compiledDir string, workflow *v1alpha1.Workflow) error {
+	workflowYAML, err := ArgoWorkflowYAML(workflow)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(compiledDir, ArgoWorkflowFileName), workflowYAML, 0600); err != nil {
+		return fmt.Errorf("could not write the Argo workflow to %v: %v", compiledDir, err)
+	}
+	return nil
+}
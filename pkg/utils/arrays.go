@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -24,9 +25,15 @@ func ContainsInt(s []int, e int) bool {
 }
 
 func JoinMapKeysValues(s map[string]string) (string, error) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	values := make([]string, 0, len(s))
-	for k, v := range s {
-		values = append(values, fmt.Sprintf("%v='%v'", k, v))
+	for _, k := range keys {
+		values = append(values, fmt.Sprintf("%v='%v'", k, s[k]))
 	}
 	return strings.Join(values, ", "), nil
 }
@@ -3,46 +3,75 @@ package utils
 import (
 	"os"
 
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
 	log "github.com/sirupsen/logrus"
 )
 
 type CompileInterface interface {
-	ConfirmPackages(loaders.SameConfig) (map[string]string, error)
-	FindAllSteps(string) ([]FoundStep, error)
+	ConfirmPackages(loaders.SameConfig, map[string]string) map[string]string
+	FindAllSteps([]NotebookCell) ([]FoundStep, error)
 	CombineCodeSlicesToSteps([]FoundStep) (map[string]CodeBlock, error)
 	CreateRootFile(string, map[string]CodeBlock, loaders.SameConfig) (string, error)
-	ConvertNotebook(string, string) (string, error)
+	CreateArgoWorkflow(map[string]CodeBlock, loaders.SameConfig, string) (*v1alpha1.Workflow, error)
+	ReadNotebook(string) ([]NotebookCell, error)
 	WriteStepFiles(string, string, map[string]CodeBlock) (map[string]map[string]string, error)
 	WriteSupportFiles(string, []string) error
 }
 
 type CodeBlock struct {
-	StepIdentifier    string
+	StepIdentifier string
+	// DisplayName is the name the step was given, see parseStepTag, and Index orders the steps
+	DisplayName       string
+	Index             int
 	Code              string
 	Parameters        map[string]string
 	PackagesToInstall map[string]string
 	Tags              map[string]string
 	CacheValue        string
 	EnvironmentName   string
+	RunParameters     []RunParameter
+	// ImportMap maps the modules the step imports to the distributions to install, see ImportMapFor
+	ImportMap map[string]string
+	// DependsOn holds the steps named by 'depends_on=' tags, DependsOnSet is whether there were any such tags
+	DependsOn    []string
+	DependsOnSet bool
+	// Parents are the steps this step runs after, see ResolveStepDependencies
+	Parents []string
+	// Resources are the resources set by the step's tags, see ResolveStepResources
+	Resources loaders.StepResources
+	// FailurePolicy is how the step handles failing, see StepFailurePolicy
+	FailurePolicy StepFailurePolicy
+	// ContextStorage is where the step spills a context too large to pass inline, see ResolveContextStorage
+	ContextStorage ContextStorage
+	// SourceCells are the cells of the notebook the lines of Code came from, and SourceFile is the notebook's
+	// name, see StepSourceMap
+	SourceCells []SourceCell
+	SourceFile  string
 }
 
 type FoundStep struct {
 	Index           int
 	StepName        string
+	DisplayName     string
 	Tags            []string
 	CodeSlice       string
 	CacheValue      string
 	EnvironmentName string
+	DependsOn       []string
+	DependsOnSet    bool
+	Resources       loaders.StepResources
+	FailurePolicy   StepFailurePolicy
+	// SourceCells are the cells the lines of CodeSlice came from
+	SourceCells []SourceCell
 }
 
 type RootFile struct {
-	StepImports             []string
-	RootParameterString     string
-	Steps                   map[string]RootStep
-	ExperimentName          string
-	StepString              string
-	GlobalPackagesToInstall string
+	StepImports         []string
+	RootParameterString string
+	Steps               map[string]RootStep
+	ExperimentName      string
+	StepString          string
 }
 
 type RootStep struct {
@@ -50,7 +79,7 @@ type RootStep struct {
 	PackageString       string
 	ContextVariableName string
 	CacheValue          string
-	PreviousStep        string
+	Parents             []string
 }
 
 func GetCompileFunctions() CompileInterface {
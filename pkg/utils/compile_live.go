@@ -3,10 +3,7 @@ package utils
 import (
 	"bufio"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -15,7 +12,6 @@ import (
 	"unicode"
 
 	pongo2 "github.com/flosch/pongo2/v4"
-	"github.com/spf13/cobra"
 
 	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
 	"github.com/azure-octo/same-cli/internal/box"
@@ -26,49 +22,37 @@ import (
 type CompileLive struct {
 }
 
-func (c *CompileLive) ConfirmPackages(sameConfigFile loaders.SameConfig) (map[string]string, error) {
-	pipCommand := `
-	#!/bin/bash
-	pip3 list --format freeze
-		`
-
-	cmdReturn, err := ExecuteInlineBashScript(&cobra.Command{}, pipCommand, "Pip Freeze failed", false)
-
-	if err != nil {
-		log.Tracef("Error executing: %v\n CmdReturn: %v", err.Error(), cmdReturn)
-		return map[string]string{}, err
-	}
-	missingPackages := map[string]string{}
-	allPackages := strings.Split(cmdReturn, "\n")
-	for _, packageString := range allPackages {
-		packageAndVersion := strings.Split(packageString, "==")
-		packageName := packageAndVersion[0]
-		packageVersion := ""
-		if len(packageAndVersion) > 1 {
-			packageVersion = packageAndVersion[1]
-		}
-		missingPackages[packageName] = packageVersion
-	}
-	// returning nothing for now, figure it out later
-	return map[string]string{}, nil
+// ConfirmPackages compares the packages declared by the environments with 'append_current_environment' set to
+// the ones installed locally (see CurrentPackages), and returns the declared packages that differ, with the
+// version installed locally ("" if the package isn't installed). Other environments don't run the local
+// packages, so aren't compared.
+func (c *CompileLive) ConfirmPackages(sameConfigFile loaders.SameConfig, currentPackages map[string]string) map[string]string {
+	mismatches := map[string]string{}
+	for _, env := range sameConfigFile.Spec.Environments {
+		if !env.AppendCurrentEnvironment {
+			continue
+		}
+		for requirement, installedVersion := range PackageMismatches(env.Packages, currentPackages) {
+			mismatches[requirement] = installedVersion
+		}
+	}
+	return mismatches
 }
 
-func (c *CompileLive) FindAllSteps(convertedText string) (foundSteps []FoundStep, err error) {
-	// Need to enable multiline for beginning of the line checking - (?m)
-	// Looking for something of the format:
-	// # - ...
-	// or
-	// # + tags=[...]
-	re_text := `(?m)^\s*# (?:\+|\-) ?(.*?)$`
-	re_steps := regexp.MustCompile(re_text)
-	stepsFound := re_steps.FindAllStringSubmatch(convertedText, -1)
-
-	tagsFound := make([][]string, len(stepsFound))
+func (c *CompileLive) FindAllSteps(cells []NotebookCell) (foundSteps []FoundStep, err error) {
+	// Only code cells run, markdown and raw cells are left out of the steps
+	codeCells := make([]NotebookCell, 0, len(cells))
+	// cellNumbers are the positions of the code cells in the notebook, counting from 1, see SourceCell
+	cellNumbers := make([]int, 0, len(cells))
 	namedStepsFound := false
-	for i, thisStep := range stepsFound {
-		tagsFound[i] = ParseTagsForStep(thisStep[1])
-		for _, tag := range tagsFound[i] {
-			if strings.HasPrefix(tag, "same_step_") {
+	for i, cell := range cells {
+		if cell.CellType != CellTypeCode {
+			continue
+		}
+		codeCells = append(codeCells, cell)
+		cellNumbers = append(cellNumbers, i+1)
+		for _, tag := range cell.Tags {
+			if IsStepTag(strings.TrimSpace(tag)) {
 				namedStepsFound = true
 			}
 		}
@@ -76,86 +60,125 @@ func (c *CompileLive) FindAllSteps(convertedText string) (foundSteps []FoundStep
 
 	if !namedStepsFound {
 		log.Tracef("no steps found in the file - treating the entire file as a single step.")
+		codeSlices := make([]string, 0, len(codeCells))
+		sourceCells := make([]SourceCell, 0, len(codeCells))
+		stepLine := 1
+		for i, cell := range codeCells {
+			codeSlices = append(codeSlices, cell.PythonSource())
+			sourceCells = appendSourceCell(sourceCells, cellNumbers[i], stepLine, cell.PythonSource())
+			// The cells are joined by a blank line
+			stepLine += strings.Count(cell.PythonSource(), "\n") + 1
+		}
+
 		foundStep := FoundStep{}
-		foundStep.CodeSlice = convertedText
+		foundStep.CodeSlice = strings.Join(codeSlices, "\n")
+		foundStep.SourceCells = sourceCells
 		foundStep.Index = 0
 		foundStep.StepName = "same_step_0"
+		foundStep.DisplayName = "same_step_0"
 		foundStep.Tags = nil
 
 		return []FoundStep{foundStep}, nil
 	}
 
-	log.Trace("Found at least one step with a 'same_step_#' format, breaking up the file")
+	log.Trace("Found at least one step with a 'same_step_#' or 'step=' format, breaking up the file")
 
-	code_blocks_slices := re_steps.Split(convertedText, -1)
 	foundSteps = make([]FoundStep, 0)
 	current_step_name := "same_step_0"
+	current_display_name := "same_step_0"
 	current_index := 0
-	log.Tracef("Raw steps found: %v", len(stepsFound))
-	log.Tracef("Code slices found: %v", len(code_blocks_slices))
-	log.Tracef("Raw tag blocks found: %v", len(tagsFound))
-	for i := range stepsFound {
-
-		if (i == 0) && (code_blocks_slices[0] == "") {
-			// When splitting cells, you can often have a zero cell
-			// at the start, so skipping it
-			code_blocks_slices = code_blocks_slices[1:]
-		}
-
+	// namedStepTags are the tags that started each named step, and namedStepCells the cells they are in
+	namedStepTags := make(map[string]string)
+	namedStepCells := make(map[string]int)
+	log.Tracef("Code cells found: %v", len(codeCells))
+	for i, cell := range codeCells {
 		cacheValue := ""
 		environmentName := ""
-		genericTags := make([]string, 0)
-
-		// Drop tags into one  of three categories (should be more extensible in the future)
-		for _, tag := range tagsFound[i] {
-			if strings.HasPrefix(tag, "same_step_") {
-				current_step_name = tag
-				current_index, _ = strconv.Atoi(strings.Split(tag, "_")[2])
+		dependsOn := make([]string, 0)
+		dependsOnSet := false
+		resources := loaders.StepResources{}
+		failurePolicy := StepFailurePolicy{}
+		orderIndex := unorderedStep
+		cellTags := make([]string, 0, len(cell.Tags))
+
+		// Drop tags into one  of four categories (should be more extensible in the future)
+		for _, tag := range cell.Tags {
+			tag = strings.TrimSpace(tag)
+			cellTags = append(cellTags, tag)
+			if IsStepTag(tag) {
+				current_step_name, current_display_name, current_index, err = parseStepTag(tag)
+				if err != nil {
+					return nil, err
+				}
+				if current_index == unorderedStep {
+					// A named step only goes on in the cells right after it, so a name used again is a duplicate
+					if startedBy, started := namedStepTags[current_step_name]; started {
+						if startedBy != tag {
+							return nil, fmt.Errorf("step %v is tagged both '%v' and '%v' - use one of them", current_display_name, startedBy, tag)
+						}
+						if len(foundSteps) == 0 || foundSteps[len(foundSteps)-1].StepName != current_step_name {
+							return nil, fmt.Errorf("two steps are named %v, in cells %v and %v - give them different names", current_display_name, namedStepCells[current_step_name], cellNumbers[i])
+						}
+					} else {
+						namedStepTags[current_step_name] = tag
+						namedStepCells[current_step_name] = cellNumbers[i]
+					}
+				}
+			} else if strings.HasPrefix(tag, "order=") {
+				// e.g. order=2 - the index a named step is sorted by
+				orderIndex, err = parseOrderTag(tag)
+				if err != nil {
+					return nil, err
+				}
 			} else if strings.HasPrefix(tag, "cache=") {
-				cacheValue = strings.Split(tag, "=")[1]
+				cacheValue = strings.TrimPrefix(tag, "cache=")
 			} else if strings.HasPrefix(tag, "environment=") {
-				environmentName = strings.Split(tag, "=")[1]
-			} else {
-				genericTags = append(genericTags, tag)
+				environmentName = strings.TrimPrefix(tag, "environment=")
+			} else if strings.HasPrefix(tag, "depends_on=") {
+				// e.g. depends_on=same_step_1,same_step_2 - an empty list makes the step a root of the DAG
+				dependsOnSet = true
+				for _, parent := range strings.Split(strings.TrimPrefix(tag, "depends_on="), ",") {
+					if parent = strings.TrimSpace(parent); parent != "" {
+						dependsOn = append(dependsOn, parent)
+					}
+				}
+			} else if isResource, err := setStepResource(&resources, tag); isResource {
+				// e.g. cpu=2, memory=8Gi, gpu=1 or gpu_type=nvidia-tesla-k80
+				if err != nil {
+					return nil, fmt.Errorf("invalid tag '%v': %v", tag, err)
+				}
+			} else if isFailurePolicy, err := setStepFailurePolicy(&failurePolicy, tag); isFailurePolicy {
+				// e.g. retries=3, timeout=PT2H or continue_on_failure=true
+				if err != nil {
+					return nil, fmt.Errorf("invalid tag '%v': %v", tag, err)
+				}
 			}
 		}
 		thisFoundStep := FoundStep{}
 		thisFoundStep.StepName = current_step_name
+		thisFoundStep.DisplayName = current_display_name
 		thisFoundStep.CacheValue = cacheValue
 		thisFoundStep.EnvironmentName = environmentName
-		thisFoundStep.Tags = genericTags
+		thisFoundStep.DependsOn = dependsOn
+		thisFoundStep.DependsOnSet = dependsOnSet
+		thisFoundStep.Resources = resources
+		thisFoundStep.FailurePolicy = failurePolicy
+		thisFoundStep.Tags = cellTags
 		thisFoundStep.Index = current_index
-		thisFoundStep.CodeSlice = code_blocks_slices[i]
+		if orderIndex != unorderedStep {
+			thisFoundStep.Index = orderIndex
+		}
+		thisFoundStep.CodeSlice = cell.PythonSource() + "\n"
+		thisFoundStep.SourceCells = appendSourceCell(nil, cellNumbers[i], 1, cell.PythonSource())
 		foundSteps = append(foundSteps, thisFoundStep)
 
 	}
 
-	return foundSteps, nil
-}
-
-func ParseTagsForStep(s string) []string {
-	re_tags_text := `tags=\[([^\]]*)\]`
-	re_tags := regexp.MustCompile(re_tags_text)
-	tags_found := re_tags.FindAllStringSubmatch(s, -1)
-	log.Tracef(" - Tags found: %v\n", len(tags_found))
-	if len(tags_found) > 0 {
-		all_tags := strings.Split(tags_found[0][1], ",")
-		returned_tags := make([]string, len(all_tags))
-		for _, this_tag := range all_tags {
-			this_tag = strings.TrimSpace(this_tag)
-			if this_tag[0] == '"' {
-				this_tag = this_tag[1:]
-			}
-			if end := len(this_tag) - 1; this_tag[end] == '"' {
-				this_tag = this_tag[:end]
-			}
-			returned_tags = append(returned_tags, this_tag)
-		}
-		return returned_tags
+	if err := assignStepIndexes(foundSteps); err != nil {
+		return nil, err
 	}
 
-	return nil
-
+	return foundSteps, nil
 }
 
 func (c *CompileLive) CombineCodeSlicesToSteps(foundSteps []FoundStep) (map[string]CodeBlock, error) {
@@ -170,8 +193,25 @@ func (c *CompileLive) CombineCodeSlicesToSteps(foundSteps []FoundStep) (map[stri
 			thisCodeBlock = aggregatedSteps[foundStep.StepName]
 		}
 
+		// The cells of the slice start after the lines already in the step
+		stepLines := strings.Count(thisCodeBlock.Code, "\n")
+		for _, sourceCell := range foundStep.SourceCells {
+			sourceCell.StepLine += stepLines
+			thisCodeBlock.SourceCells = append(thisCodeBlock.SourceCells, sourceCell)
+		}
 		thisCodeBlock.Code += foundStep.CodeSlice
+
+		// Tags set in later cells of a step override those set in earlier ones, as other settings do
+		for _, tag := range foundStep.Tags {
+			if thisCodeBlock.Tags == nil {
+				thisCodeBlock.Tags = make(map[string]string)
+			}
+			name, value := splitTag(tag)
+			thisCodeBlock.Tags[name] = value
+		}
 		thisCodeBlock.StepIdentifier = foundStep.StepName
+		thisCodeBlock.DisplayName = ValueOrDefault(foundStep.DisplayName, foundStep.StepName)
+		thisCodeBlock.Index = foundStep.Index
 
 		if foundStep.CacheValue != "" {
 			thisCodeBlock.CacheValue = foundStep.CacheValue
@@ -185,33 +225,69 @@ func (c *CompileLive) CombineCodeSlicesToSteps(foundSteps []FoundStep) (map[stri
 			thisCodeBlock.EnvironmentName = "default"
 		}
 
+		if foundStep.DependsOnSet {
+			// Dependencies named in any of the step's cells all count
+			thisCodeBlock.DependsOnSet = true
+			for _, parent := range foundStep.DependsOn {
+				if !ContainsString(thisCodeBlock.DependsOn, parent) {
+					thisCodeBlock.DependsOn = append(thisCodeBlock.DependsOn, parent)
+				}
+			}
+		}
+
+		// Resources set in later cells of a step override those set in earlier ones
+		thisCodeBlock.Resources = mergeStepResources(thisCodeBlock.Resources, foundStep.Resources)
+		thisCodeBlock.FailurePolicy = mergeStepFailurePolicies(thisCodeBlock.FailurePolicy, foundStep.FailurePolicy)
+
 		aggregatedSteps[foundStep.StepName] = thisCodeBlock
 	}
 
+	if err := ResolveStepDependencies(aggregatedSteps); err != nil {
+		return nil, err
+	}
+
 	return aggregatedSteps, nil
 }
 
+// StepPackages returns the packages each step installs, sorted: the packages it imports, its environment's
+// packages (both in PackagesToInstall), and the packages needed to load the context it starts from - e.g. a
+// step given a pandas DataFrame needs pandas, even if it never imports it.
+func StepPackages(aggregatedSteps map[string]CodeBlock) (map[string][]string, error) {
+	contextDistributions, err := ContextDistributions(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	packagesBySteps := make(map[string][]string, len(aggregatedSteps))
+	for stepName, codeBlock := range aggregatedSteps {
+		stepPackages := make([]string, 0, len(codeBlock.PackagesToInstall))
+		for k := range codeBlock.PackagesToInstall {
+			stepPackages = append(stepPackages, k)
+		}
+		stepPackages = unionStrings(stepPackages, contextDistributions[stepName])
+		sort.Strings(stepPackages)
+		packagesBySteps[stepName] = stepPackages
+	}
+	return packagesBySteps, nil
+}
+
 func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]CodeBlock, sameConfigFile loaders.SameConfig) (string, error) {
 
-	if !ContainsString([]string{"kubeflow", "aml"}, target) {
+	if !ContainsString(loaders.Targets, target) {
 		return "", fmt.Errorf("unknown compilation target: %v", target)
 	}
 
-	rootParameterString := ""
-
-	if len(sameConfigFile.Spec.Run.Parameters) > 0 {
-		rootParameters := make(map[string]string, len(sameConfigFile.Spec.Run.Parameters))
-		for k, untyped_v := range sameConfigFile.Spec.Run.Parameters {
-			switch untyped_v.(type) {
-			case int, int8, uint8, int16, uint16, int32, uint32, int64, uint64, uint, uintptr, float32, float64, bool, string:
-				rootParameters[k] = fmt.Sprintf("%v", untyped_v)
-			default:
-				log.Warnf("We only support numeric, bool and strings as default parameters (no dicts or lists). We're setting the default value for '%v' to ''.", k)
-				rootParameters[k] = ""
-			}
+	// Parameters cross into the pipeline as strings and are decoded by type in each step
+	runParameters := RunParameters(sameConfigFile)
+	rootParameters := make([]string, 0, len(runParameters))
+	for _, runParameter := range runParameters {
+		rootParameters = append(rootParameters, fmt.Sprintf("%v=%v", runParameter.Name, runParameter.Default))
+	}
+	rootParameterString := strings.Join(rootParameters, ", ")
 
-		}
-		rootParameterString, _ = JoinMapKeysValues(rootParameters)
+	contextStorage, err := ResolveContextStorage(sameConfigFile)
+	if err != nil {
+		return "", err
 	}
 
 	environments := make(map[string]loaders.Environment)
@@ -220,17 +296,25 @@ func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]C
 	defaultEnvironment := &loaders.Environment{}
 
 	// Pulling from Docker Hub through AML requires the below tag structure of library/name:tag
-	defaultEnvironment.ImageTag = "library/python:3.9-slim-buster"
+	defaultEnvironment.ImageTag = DefaultImageTag
 	defaultEnvironment.Packages = make([]string, 0)
 	defaultEnvironment.PrivateRegistry = false
 	environments["default"] = *defaultEnvironment
 
+	// Environments are read in order of their names, so the secrets to create are always listed the same way
 	if len(sameConfigFile.Spec.Environments) > 0 {
-		for env_name, env := range sameConfigFile.Spec.Environments {
+		specEnvNames := make([]string, 0, len(sameConfigFile.Spec.Environments))
+		for env_name := range sameConfigFile.Spec.Environments {
+			specEnvNames = append(specEnvNames, env_name)
+		}
+		sort.Strings(specEnvNames)
+		for _, env_name := range specEnvNames {
+			env := sameConfigFile.Spec.Environments[env_name]
 			thisEnvironment := &loaders.Environment{}
 			thisEnvironment.ImageTag = ValueOrDefault(env.ImageTag, environments[env_name].ImageTag)
 			thisEnvironment.Packages = env.Packages
 			thisEnvironment.PrivateRegistry = env.PrivateRegistry
+			thisEnvironment.Resources = env.Resources
 			if thisEnvironment.PrivateRegistry {
 
 				// Two options - either someone has set the secret name (implying it's already mounted, so we'll just move on), or no secret name and so we have to creaate the secret inline.
@@ -244,39 +328,43 @@ func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]C
 		}
 	}
 
-	previousStep := ""
-	stepsLeftToParse := make(map[string]string)
-	allSteps := []map[string]string{}
-	// Copying this to a new variable so that we can delete them
-	for _, thisCodeBlock := range aggregatedSteps {
-		stepsLeftToParse[thisCodeBlock.StepIdentifier] = thisCodeBlock.StepIdentifier
+	envVarsByEnvironment, err := EnvVarsByEnvironment(sameConfigFile)
+	if err != nil {
+		return "", err
 	}
 
-	stepsToParse := make([]string, 0, len(stepsLeftToParse))
-	for key := range stepsLeftToParse {
-		stepsToParse = append(stepsToParse, key)
+	// Secret looking values are never written into the root file - they are read from a secret (created
+	// by 'same program run') on Kubeflow, and from the environment at submission time on AML.
+	allEnvVars := make([]EnvVar, 0)
+	envNames := make([]string, 0, len(environments))
+	for envName := range environments {
+		envNames = append(envNames, envName)
+	}
+	sort.Strings(envNames)
+	for _, envName := range envNames {
+		allEnvVars = append(allEnvVars, envVarsByEnvironment[envName].TemplateEnvVars(envName, EnvSecretName(sameConfigFile.Spec.Metadata.Name, envName))...)
 	}
-	sort.Strings(stepsToParse)
 
-	// Unfortunately, every early step's package includes also need to be included in later
-	// steps. This is become some objects (like IPython.image) require module imports.
-	// There's probably a more elegant way to handle this.
-	globalPackagesSlice := make(map[string]string)
-	globalPackagesString := ""
+	allSteps := []map[string]interface{}{}
+
+	// Steps are written out parents first, as each step refers to the outputs of its parents
+	stepsToParse, err := StepOrder(aggregatedSteps)
+	if err != nil {
+		return "", err
+	}
+
+	packagesBySteps, err := StepPackages(aggregatedSteps)
+	if err != nil {
+		return "", err
+	}
+
+	computeTargets := make(map[string]map[string]string)
 	for i := 0; i < len(stepsToParse); i++ {
 		thisCodeBlock := aggregatedSteps[stepsToParse[i]]
 
-		// Another hacky work-around - we're just building every package into every container
-		// ...definitely should be more efficient (only build in what we need per container)
 		packageString := ""
-		for k := range thisCodeBlock.PackagesToInstall {
-			// Using key mapping on a hash table to eliminate dupes
-			globalPackagesSlice[k] = ""
-		}
-
-		for k := range globalPackagesSlice {
+		for _, k := range packagesBySteps[thisCodeBlock.StepIdentifier] {
 			packageString += fmt.Sprintf("\"%v\",", k)
-			globalPackagesString += fmt.Sprintf("\"%v\",", k)
 		}
 
 		imagePullSecretName := ""
@@ -284,24 +372,61 @@ func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]C
 			imagePullSecretName = environments[thisCodeBlock.EnvironmentName].Credentials.SecretName
 		}
 
-		allSteps = append(allSteps, map[string]string{
+		resources, err := ResolveStepResources(thisCodeBlock, sameConfigFile)
+		if err != nil {
+			return "", fmt.Errorf("step %v: %v", thisCodeBlock.StepIdentifier, err)
+		}
+		gpuNodeSelectorKey, gpuNodeSelectorValue := GPUNodeSelector(resources.GPUType)
+
+		// AML has no per step limits, so a step asking for resources runs on a compute target of a VM size
+		// that has them
+		computeTarget := ""
+		if target == "aml" {
+			vmSize, err := AMLVMSize(resources)
+			if err != nil {
+				return "", fmt.Errorf("step %v: %v", thisCodeBlock.StepIdentifier, err)
+			}
+			if vmSize != "" {
+				computeTarget = strings.ReplaceAll(amlComputeName(vmSize), "-", "_")
+				computeTargets[computeTarget] = map[string]string{
+					"Name":   amlComputeName(vmSize),
+					"VMSize": vmSize,
+				}
+			}
+		}
+
+		stepEnvVars := make([]EnvVar, 0)
+		for _, envVar := range allEnvVars {
+			if envVar.Environment == thisCodeBlock.EnvironmentName {
+				stepEnvVars = append(stepEnvVars, envVar)
+			}
+		}
+
+		allSteps = append(allSteps, map[string]interface{}{
 			"Name":                thisCodeBlock.StepIdentifier,
+			"DisplayName":         thisCodeBlock.DisplayName,
 			"PackageString":       packageString,
 			"CacheValue":          thisCodeBlock.CacheValue,
-			"PreviousStep":        previousStep,
+			"Parents":             thisCodeBlock.Parents,
+			"ExtraParents":        extraParents(thisCodeBlock.Parents),
 			"Environment":         thisCodeBlock.EnvironmentName,
 			"ImageName":           environments[thisCodeBlock.EnvironmentName].ImageTag,
 			"PrivateRepository":   strconv.FormatBool(environments[thisCodeBlock.EnvironmentName].PrivateRegistry),
 			"ImagePullSecretName": imagePullSecretName,
+			"EnvVars":             stepEnvVars,
+			"Resources":           resources,
+			"GPUNodeSelectorKey":  gpuNodeSelectorKey,
+			"GPUNodeSelector":     gpuNodeSelectorValue,
+			"ComputeTarget":       computeTarget,
+			"Retries":             thisCodeBlock.FailurePolicy.PlatformRetryCount(),
+			"TimeoutSeconds":      thisCodeBlock.FailurePolicy.PlatformTimeoutSeconds(),
 		})
 
-		previousStep = thisCodeBlock.StepIdentifier
-
 	}
 
 	experimentName := removeIllegalExperimentNameCharacters(sameConfigFile.Spec.Metadata.Name)
 	stepString := ""
-	for _, step := range stepsToParse {
+	for _, step := range SortedStepNames(aggregatedSteps) {
 		if stepString != "" {
 			stepString += ", "
 		}
@@ -311,15 +436,19 @@ func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]C
 	safeExperimentName := alphaNumericOnly(experimentName)
 
 	rootFileContext := pongo2.Context{
-		"RootParameterString":  rootParameterString,
-		"GlobalPackagesString": globalPackagesString,
-		"Steps":                allSteps,
-		"StepString":           stepString,
-		"ExperimentName":       experimentName,
-		"SafeExperimentName":   safeExperimentName,
-		"Kubeconfig":           sameConfigFile.Spec.KubeConfig,
-		"Environments":         environments,
-		"SecretsToCreate":      imagePullSecretsToCreate,
+		"RootParameterString": rootParameterString,
+		"RunParameters":       runParameters,
+		"Steps":               allSteps,
+		"StepString":          stepString,
+		"ExperimentName":      experimentName,
+		"SafeExperimentName":  safeExperimentName,
+		"Kubeconfig":          sameConfigFile.Spec.KubeConfig,
+		"Environments":        environments,
+		"SecretsToCreate":     imagePullSecretsToCreate,
+		"EnvVars":             allEnvVars,
+		"ComputeTargets":      computeTargets,
+		"ContextStorage":      contextStorage,
+		"HelperImage":         environmentImage(sameConfigFile, "default"),
 	}
 
 	var root_file_bytes []byte
@@ -345,15 +474,15 @@ func (c *CompileLive) CreateRootFile(target string, aggregatedSteps map[string]C
 
 func (c *CompileLive) WriteStepFiles(target string, compiledDir string, aggregatedSteps map[string]CodeBlock) (map[string]map[string]string, error) {
 
-	tempStepHolderDir, err := ioutil.TempDir(os.TempDir(), "SAME-compile-*")
-	defer os.Remove(tempStepHolderDir)
-
 	returnedPackages := make(map[string]map[string]string)
 
+	// Steps only load and pass on the variables they, and the steps after them, use
+	stepVariables, err := StepVariablesFor(aggregatedSteps)
 	if err != nil {
-		return nil, fmt.Errorf("error creating temporary directory to write steps to: %v", err)
+		return nil, err
 	}
 
+	sourceMaps := make(map[string]StepSourceMap, len(aggregatedSteps))
 	for i := range aggregatedSteps {
 		returnedPackages[aggregatedSteps[i].StepIdentifier] = make(map[string]string)
 		parameterString, _ := JoinMapKeysValues(aggregatedSteps[i].Parameters)
@@ -396,90 +525,96 @@ func (c *CompileLive) WriteStepFiles(target string, compiledDir string, aggregat
 
 		stepFileContext := pongo2.Context{
 			"Name":             aggregatedSteps[i].StepIdentifier,
+			"Parents":          aggregatedSteps[i].Parents,
+			"ExtraParents":     extraParents(aggregatedSteps[i].Parents),
 			"Parameter_String": parameterString,
 			"Inner_Code":       innerCodeToExecute,
+			"RunParameters":    aggregatedSteps[i].RunParameters,
+			// Retries are only needed in the step on AML, or when it continues on failure - KFP retries other steps itself
+			"Retries":           aggregatedSteps[i].FailurePolicy.RetryCount(),
+			"ContinueOnFailure": aggregatedSteps[i].FailurePolicy.ContinuesOnFailure(),
+			"TimeoutSeconds":    aggregatedSteps[i].FailurePolicy.StepTimeoutSeconds(),
+			"Loads":             stepVariables[i].Loads,
+			"LoadsAll":          stepVariables[i].LoadsAll,
+			"Exports":           stepVariables[i].Exports,
+			"ExportsAll":        stepVariables[i].ExportsAll,
+			"ContextStorage":    aggregatedSteps[i].ContextStorage,
 		}
 
 		tmpl := pongo2.Must(pongo2.FromBytes(step_file_bytes))
-		stepFileString, err := tmpl.Execute(stepFileContext)
-		if err != nil {
-			return nil, fmt.Errorf("error writing step %v: %v", aggregatedSteps[i].StepIdentifier, err.Error())
-		}
 
-		err = os.WriteFile(stepToWrite, []byte(stepFileString), 0400)
+		// The step carries its source map, so tracebacks of a run can be mapped back to the notebook
+		offset, err := innerCodeOffset(tmpl, stepFileContext)
 		if err != nil {
-			return nil, fmt.Errorf("Error writing step %v: %v", stepToWrite, err.Error())
+			return nil, fmt.Errorf("error writing step %v: %v", aggregatedSteps[i].StepIdentifier, err.Error())
 		}
-
-		tempStepFile, err := ioutil.TempFile(tempStepHolderDir, fmt.Sprintf("SAME-inner-code-file-*-%v", fmt.Sprintf("%v.py", aggregatedSteps[i].StepIdentifier)))
-		if err != nil {
-			return nil, fmt.Errorf("error creating tempfile for step %v: %v", aggregatedSteps[i].StepIdentifier, err.Error())
+		sourceMap := NewStepSourceMap(aggregatedSteps[i], offset)
+		sourceMaps[aggregatedSteps[i].StepIdentifier] = sourceMap
+		if stepFileContext["SourceMap"], err = sourceMap.comment(); err != nil {
+			return nil, err
 		}
 
-		err = ioutil.WriteFile(tempStepFile.Name(), []byte(innerCodeToExecute), 0400)
+		stepFileString, err := tmpl.Execute(stepFileContext)
 		if err != nil {
-			return nil, fmt.Errorf("Error writing temporary step file %v: %v", tempStepFile, err.Error())
+			return nil, fmt.Errorf("error writing step %v: %v", aggregatedSteps[i].StepIdentifier, err.Error())
 		}
 
-		log.Tracef("Freezing python packages")
-		pipCommand := fmt.Sprintf(`
-#!/bin/bash
-set -e
-pipreqs %v --print 
-	`, tempStepHolderDir)
-
-		cmdReturn, err := ExecuteInlineBashScript(&cobra.Command{}, pipCommand, "Pipreqs output failed", false)
-
+		err = os.WriteFile(stepToWrite, []byte(stepFileString), 0400)
 		if err != nil {
-			log.Tracef("Error executing: %v\n CmdReturn: %v", err.Error(), cmdReturn)
-			return nil, err
+			return nil, fmt.Errorf("Error writing step %v: %v", stepToWrite, err.Error())
 		}
-		allPackages := strings.Split(cmdReturn, "\n")
 
-		for _, packageString := range allPackages {
-			if packageString != "" && !strings.HasPrefix(packageString, "INFO: ") {
-				returnedPackages[aggregatedSteps[i].StepIdentifier][packageString] = ""
+		importMap := aggregatedSteps[i].ImportMap
+		if importMap == nil {
+			if importMap, err = DefaultImportMap(); err != nil {
+				return nil, err
 			}
 		}
+		distributions, unknownModules := FindDistributions(innerCodeToExecute, importMap)
+		if len(unknownModules) > 0 {
+			log.Warnf("Step %v imports modules SAME doesn't know the distribution of, so they won't be installed: %v. Add them to 'import_map' in the SAME file (or map them to \"\" if they are already installed).", aggregatedSteps[i].DisplayName, strings.Join(unknownModules, ", "))
+		}
+		for _, distribution := range distributions {
+			returnedPackages[aggregatedSteps[i].StepIdentifier][distribution] = ""
+		}
 
 	}
 
-	return returnedPackages, nil
-}
-
-func (c *CompileLive) ConvertNotebook(jupytextExecutablePath string, notebookFilePath string) (string, error) {
-	log.Infof("Using notebook from here: %v\n", notebookFilePath)
-	notebookFile, err := os.Open(notebookFilePath)
-	if err != nil {
-		return "", fmt.Errorf("program_compile.go: error reading from notebook file: %v", notebookFilePath)
+	orderedSourceMaps := make([]StepSourceMap, 0, len(sourceMaps))
+	for _, stepName := range SortedStepNames(aggregatedSteps) {
+		orderedSourceMaps = append(orderedSourceMaps, sourceMaps[stepName])
 	}
-
-	scriptCmd := exec.Command("/bin/sh", "-c", fmt.Sprintf("%v --to py", jupytextExecutablePath))
-	scriptStdin, err := scriptCmd.StdinPipe()
-
-	if err != nil {
-		return "", fmt.Errorf("Error building Stdin pipe for notebook file: %v", err.Error())
+	if err := WriteSourceMaps(compiledDir, orderedSourceMaps); err != nil {
+		return nil, err
 	}
 
-	b, _ := ioutil.ReadAll(notebookFile)
+	return returnedPackages, nil
+}
 
-	go func() {
-		defer scriptStdin.Close()
-		_, _ = io.WriteString(scriptStdin, string(b))
-	}()
+// innerCodeOffsetSentinel stands in for the code of a step when finding where the code starts, see innerCodeOffset
+const innerCodeOffsetSentinel = "__same_inner_code_starts_here__"
 
-	out, err := scriptCmd.CombinedOutput()
+// innerCodeOffset returns the number of lines that come before a step's code in the code the step runs, which
+// starts on the line of '__inner_code_to_execute = """'.
+func innerCodeOffset(tmpl *pongo2.Template, stepFileContext pongo2.Context) (int, error) {
+	sentinelContext := pongo2.Context{}
+	sentinelContext.Update(stepFileContext)
+	sentinelContext["Inner_Code"] = innerCodeOffsetSentinel
+	sentinelFileString, err := tmpl.Execute(sentinelContext)
 	if err != nil {
-		return "", fmt.Errorf("Error executing notebook conversion: %v", err.Error())
+		return 0, err
 	}
 
-	if err != nil {
-		return "", fmt.Errorf(`
-could not convert the file: %v
-full error message: %v`, notebookFilePath, string(out))
+	codeStart := strings.Index(sentinelFileString, `__inner_code_to_execute = """`)
+	sentinelAt := strings.Index(sentinelFileString, innerCodeOffsetSentinel)
+	if codeStart < 0 || sentinelAt < codeStart {
+		return 0, fmt.Errorf("could not find the code of the step in its template")
 	}
+	return strings.Count(sentinelFileString[codeStart:sentinelAt], "\n"), nil
+}
 
-	return string(out), nil
+func (c *CompileLive) ReadNotebook(notebookFilePath string) ([]NotebookCell, error) {
+	return ReadPipelineSource(notebookFilePath)
 }
 
 func (c CompileLive) WriteSupportFiles(workingDirectory string, directoriesToWriteTo []string) error {
@@ -492,6 +627,15 @@ func (c CompileLive) WriteSupportFiles(workingDirectory string, directoriesToWri
 
 	// Copying all *.py and requirements.txt files
 
+	// A directory being written to may be inside the working directory (e.g. with --output-dir), and is never
+	// copied into itself
+	absDirectoriesToWriteTo := make([]string, 0, len(directoriesToWriteTo))
+	for _, destDir := range directoriesToWriteTo {
+		if absDestDir, err := filepath.Abs(destDir); err == nil {
+			absDirectoriesToWriteTo = append(absDirectoriesToWriteTo, absDestDir)
+		}
+	}
+
 	for _, destDir := range directoriesToWriteTo {
 		opt := recurseCopy.Options{
 			Skip: func(src string) (bool, error) {
@@ -500,7 +644,8 @@ func (c CompileLive) WriteSupportFiles(workingDirectory string, directoriesToWri
 					return true, err
 				}
 				if fi.IsDir() {
-					return false, nil
+					absSrc, err := filepath.Abs(src)
+					return err == nil && ContainsString(absDirectoriesToWriteTo, absSrc), nil
 				}
 				return !strings.HasSuffix(src, ".py"), nil
 			},
@@ -518,6 +663,15 @@ func (c CompileLive) WriteSupportFiles(workingDirectory string, directoriesToWri
 	return nil
 }
 
+// extraParents are the parents after the first. A step gets the context of its first parent through its usual
+// input, and the context of each extra parent through an input of its own, to be merged in the step.
+func extraParents(parents []string) []string {
+	if len(parents) < 2 {
+		return []string{}
+	}
+	return parents[1:]
+}
+
 func removeIllegalExperimentNameCharacters(s string) string {
 	return strings.Map(
 		func(r rune) rune {
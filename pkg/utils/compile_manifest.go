@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/internal/box"
+)
+
+// CompileManifestFileName is the file in a compile output directory recording what was compiled into it
+const CompileManifestFileName = "same-manifest.json"
+
+// compilerFiles are the bundled files the compiled output of each target is generated from
+var compilerFiles = map[string][]string{
+	"kubeflow": {"/kfp/root.tmpl", "/kfp/step.tmpl", "/kfp/get_run_info.py", "/kfp/create_context_file.py", "/python/import_map.yaml", "/python/stdlib_modules.txt"},
+	"aml":      {"/aml/root.tmpl", "/aml/step.tmpl", "/python/import_map.yaml", "/python/stdlib_modules.txt"},
+}
+
+// CompileManifest records the hashes of the inputs a program was compiled from, and of the files compiled
+// from them. Compiling unchanged inputs into a directory with an up to date manifest is skipped.
+type CompileManifest struct {
+	// InputsHash is a hash over all of the inputs, which identifies the compiled program
+	InputsHash string            `json:"inputs_hash"`
+	Inputs     map[string]string `json:"inputs"`
+	Outputs    map[string]string `json:"outputs"`
+}
+
+// CompileInputs returns the hashes of everything that goes into compiling a program: the target, the version of
+// the SAME CLI compiling it (which generates the workflow and the variable flows) and the templates, the SAME file as loaded (with its bases, parameters and captured packages), the notebook,
+// and the Python files next to it that are copied into the program (unless copyFiles is false). Anything
+// under skipDir, where the program is compiled to, is left out.
+func CompileInputs(target string, cliVersion string, sameConfigFile loaders.SameConfig, notebookFilePath string, copyFiles bool, skipDir string) (map[string]string, error) {
+	inputs := make(map[string]string)
+	inputs["target"] = hashBytes([]byte(target))
+	inputs["cli"] = hashBytes([]byte(cliVersion))
+
+	compilerHash := sha256.New()
+	for _, file := range compilerFiles[target] {
+		compilerHash.Write(box.Get(file))
+	}
+	inputs["compiler"] = hex.EncodeToString(compilerHash.Sum(nil))
+
+	sameConfigBytes, err := loaders.V1{}.MarshalSAME(sameConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling same config file: %v", err)
+	}
+	inputs["same.yaml"] = hashBytes(sameConfigBytes)
+
+	notebookBytes, err := ioutil.ReadFile(notebookFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %v: %v", notebookFilePath, err)
+	}
+	inputs["notebook:"+filepath.Base(notebookFilePath)] = hashBytes(notebookBytes)
+
+	if !copyFiles {
+		return inputs, nil
+	}
+	notebookDir := filepath.Dir(notebookFilePath)
+	supportFiles, err := hashFiles(notebookDir, skipDir, func(path string) bool { return strings.HasSuffix(path, ".py") })
+	if err != nil {
+		return nil, err
+	}
+	for path, hash := range supportFiles {
+		inputs["support:"+path] = hash
+	}
+	return inputs, nil
+}
+
+// NewCompileManifest records the inputs a program was compiled from, and the files compiled into outputDir.
+func NewCompileManifest(inputs map[string]string, outputDir string) (CompileManifest, error) {
+	outputs, err := hashFiles(outputDir, "", func(path string) bool { return path != CompileManifestFileName })
+	if err != nil {
+		return CompileManifest{}, err
+	}
+	return CompileManifest{InputsHash: hashInputs(inputs), Inputs: inputs, Outputs: outputs}, nil
+}
+
+// ReadCompileManifest reads the manifest of a compile output directory.
+func ReadCompileManifest(outputDir string) (*CompileManifest, error) {
+	manifestBytes, err := ioutil.ReadFile(filepath.Join(outputDir, CompileManifestFileName))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &CompileManifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("could not read the compile manifest in %v: %v", outputDir, err)
+	}
+	return manifest, nil
+}
+
+// Write writes the manifest into its compile output directory.
+func (m CompileManifest) Write(outputDir string) error {
+	manifestBytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal the compile manifest: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(outputDir, CompileManifestFileName), append(manifestBytes, '\n'), 0600); err != nil {
+		return fmt.Errorf("could not write the compile manifest to %v: %v", outputDir, err)
+	}
+	return nil
+}
+
+// UpToDate reports whether the program in outputDir was compiled from inputs, and hasn't changed since.
+func (m CompileManifest) UpToDate(inputs map[string]string, outputDir string) bool {
+	if !reflect.DeepEqual(m.Inputs, inputs) {
+		return false
+	}
+	outputs, err := hashFiles(outputDir, "", func(path string) bool { return path != CompileManifestFileName })
+	return err == nil && reflect.DeepEqual(m.Outputs, outputs)
+}
+
+// RemoveOutputs removes the files compiled into outputDir, so files from an earlier compile (like the step
+// files of a step that was removed) don't linger. Files that are already gone are skipped.
+func (m CompileManifest) RemoveOutputs(outputDir string) error {
+	for path := range m.Outputs {
+		if err := os.Remove(filepath.Join(outputDir, filepath.FromSlash(path))); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("could not remove the previously compiled %v: %v", path, err)
+		}
+	}
+	return os.Remove(filepath.Join(outputDir, CompileManifestFileName))
+}
+
+// hashFiles returns the hashes of the files under dir that include selects, by their slash separated path
+// relative to dir. Anything under skipDir is left out.
+func hashFiles(dir string, skipDir string, include func(path string) bool) (map[string]string, error) {
+	if skipDir != "" {
+		if absSkipDir, err := filepath.Abs(skipDir); err == nil {
+			skipDir = absSkipDir
+		}
+	}
+
+	hashes := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if absPath, err := filepath.Abs(path); err == nil && skipDir != "" && absPath == skipDir {
+			return filepath.SkipDir
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if !include(relativePath) {
+			return nil
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		hashes[relativePath] = hashBytes(contents)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not hash the files in %v: %v", dir, err)
+	}
+	return hashes, nil
+}
+
+// hashInputs combines the hashes of the inputs into one, independent of the order of the map.
+func hashInputs(inputs map[string]string) string {
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inputsHash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(inputsHash, "%v=%v\n", name, inputs[name])
+	}
+	return hex.EncodeToString(inputsHash.Sum(nil))
+}
+
+func hashBytes(contents []byte) string {
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+import (
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
 
 type CompileMock struct {
 }
@@ -11,10 +14,10 @@ func (c *CompileMock) WriteSupportFiles(workingDirectory string, directoriesToWr
 	return cl.WriteSupportFiles(workingDirectory, directoriesToWriteTo)
 }
 
-func (c *CompileMock) FindAllSteps(convertedText string) ([]FoundStep, error) {
+func (c *CompileMock) FindAllSteps(cells []NotebookCell) ([]FoundStep, error) {
 	// Placeholder until we mock
 	cl := &CompileLive{}
-	return cl.FindAllSteps(convertedText)
+	return cl.FindAllSteps(cells)
 }
 
 func (c *CompileMock) CombineCodeSlicesToSteps(foundSteps []FoundStep) (map[string]CodeBlock, error) {
@@ -29,20 +32,26 @@ func (c *CompileMock) CreateRootFile(target string, aggregatedSteps map[string]C
 	return cl.CreateRootFile(target, aggregatedSteps, sameConfigFile)
 }
 
+func (c *CompileMock) CreateArgoWorkflow(aggregatedSteps map[string]CodeBlock, sameConfigFile loaders.SameConfig, compiledDir string) (*v1alpha1.Workflow, error) {
+	// Placeholder until we mock
+	cl := &CompileLive{}
+	return cl.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, compiledDir)
+}
+
 func (c *CompileMock) WriteStepFiles(target string, compiledDir string, aggregatedSteps map[string]CodeBlock) (map[string]map[string]string, error) {
 	// Placeholder until we mock
 	cl := &CompileLive{}
 	return cl.WriteStepFiles(target, compiledDir, aggregatedSteps)
 }
 
-func (c *CompileMock) ConvertNotebook(jupytextExecutablePath string, notebookFilePath string) (string, error) {
+func (c *CompileMock) ReadNotebook(notebookFilePath string) ([]NotebookCell, error) {
 	// Placeholder until we mock
 	cl := &CompileLive{}
-	return cl.ConvertNotebook(jupytextExecutablePath, notebookFilePath)
+	return cl.ReadNotebook(notebookFilePath)
 }
 
-func (c *CompileMock) ConfirmPackages(sameConfigFile loaders.SameConfig) (map[string]string, error) {
+func (c *CompileMock) ConfirmPackages(sameConfigFile loaders.SameConfig, currentPackages map[string]string) map[string]string {
 	// Placeholder until we mock
 	cl := &CompileLive{}
-	return cl.ConfirmPackages(sameConfigFile)
+	return cl.ConfirmPackages(sameConfigFile, currentPackages)
 }
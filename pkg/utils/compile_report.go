@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
+
+// CompileReportFileName is the file in a compiled program explaining what it was compiled to, see CompileReport
+const CompileReportFileName = "same-compile-report.json"
+
+// DefaultImageTag is the image of the 'default' environment, unless the SAME file sets one
+const DefaultImageTag = "library/python:3.9-slim-buster"
+
+// CompileReport explains what a program was compiled to: its steps, in the order they run, the parameters of
+// the pipeline and the support files copied next to it. It holds nothing that changes between compiles of the
+// same inputs, so reports can be compared.
+type CompileReport struct {
+	Program  string `json:"program"`
+	Target   string `json:"target"`
+	Notebook string `json:"notebook"`
+	// InputsHash identifies the inputs the program was compiled from, see CompileManifest
+	InputsHash   string         `json:"inputs_hash"`
+	Parameters   []RunParameter `json:"parameters"`
+	SupportFiles []string       `json:"support_files"`
+	Steps        []StepReport   `json:"steps"`
+}
+
+// StepReport is what a step was compiled to.
+type StepReport struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	// Cells are the positions of the step's cells in the notebook, counting from 1, see SourceCell
+	Cells       []int    `json:"cells"`
+	Environment string   `json:"environment"`
+	Image       string   `json:"image"`
+	Packages    []string `json:"packages"`
+	// CacheStaleness is how old a cached result of the step can be and still be used, from its 'cache=' tag
+	CacheStaleness string   `json:"cache_staleness"`
+	Tags           []string `json:"tags"`
+	// Predecessors are the steps this step runs after
+	Predecessors []string `json:"predecessors"`
+}
+
+// NewCompileReport explains a program compiled from inputs (see CompileInputs) to aggregatedSteps, whose
+// PackagesToInstall are filled in.
+func NewCompileReport(target string, aggregatedSteps map[string]CodeBlock, sameConfigFile loaders.SameConfig, inputs map[string]string) (CompileReport, error) {
+	report := CompileReport{
+		Program:      sameConfigFile.Spec.Metadata.Name,
+		Target:       target,
+		InputsHash:   hashInputs(inputs),
+		Parameters:   RunParameters(sameConfigFile),
+		SupportFiles: make([]string, 0),
+		Steps:        make([]StepReport, 0, len(aggregatedSteps)),
+	}
+	if report.Parameters == nil {
+		report.Parameters = make([]RunParameter, 0)
+	}
+	for input := range inputs {
+		if strings.HasPrefix(input, "notebook:") {
+			report.Notebook = strings.TrimPrefix(input, "notebook:")
+		} else if strings.HasPrefix(input, "support:") {
+			report.SupportFiles = append(report.SupportFiles, strings.TrimPrefix(input, "support:"))
+		}
+	}
+	sort.Strings(report.SupportFiles)
+
+	stepOrder, err := StepOrder(aggregatedSteps)
+	if err != nil {
+		return CompileReport{}, err
+	}
+	packagesBySteps, err := StepPackages(aggregatedSteps)
+	if err != nil {
+		return CompileReport{}, err
+	}
+	for _, stepName := range stepOrder {
+		codeBlock := aggregatedSteps[stepName]
+		stepReport := StepReport{
+			Name:           codeBlock.StepIdentifier,
+			DisplayName:    codeBlock.DisplayName,
+			Cells:          make([]int, 0, len(codeBlock.SourceCells)),
+			Environment:    codeBlock.EnvironmentName,
+			Image:          environmentImage(sameConfigFile, codeBlock.EnvironmentName),
+			Packages:       packagesBySteps[stepName],
+			CacheStaleness: codeBlock.CacheValue,
+			Tags:           make([]string, 0, len(codeBlock.Tags)),
+			Predecessors:   append(make([]string, 0, len(codeBlock.Parents)), codeBlock.Parents...),
+		}
+		for _, sourceCell := range codeBlock.SourceCells {
+			stepReport.Cells = append(stepReport.Cells, sourceCell.Cell)
+		}
+		for name, value := range codeBlock.Tags {
+			if value == "" {
+				stepReport.Tags = append(stepReport.Tags, name)
+			} else {
+				stepReport.Tags = append(stepReport.Tags, fmt.Sprintf("%v=%v", name, value))
+			}
+		}
+		sort.Strings(stepReport.Tags)
+		report.Steps = append(report.Steps, stepReport)
+	}
+	return report, nil
+}
+
+// Write writes the report into the directory of the program it explains.
+func (r CompileReport) Write(compiledDir string) error {
+	reportBytes, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal the compile report: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(compiledDir, CompileReportFileName), append(reportBytes, '\n'), 0600); err != nil {
+		return fmt.Errorf("could not write the compile report to %v: %v", compiledDir, err)
+	}
+	return nil
+}
+
+// ReadCompileReport reads the report of a compiled program.
+func ReadCompileReport(compiledDir string) (*CompileReport, error) {
+	reportBytes, err := ioutil.ReadFile(filepath.Join(compiledDir, CompileReportFileName))
+	if err != nil {
+		return nil, fmt.Errorf("could not read the compile report in %v: %v", compiledDir, err)
+	}
+	report := &CompileReport{}
+	if err := json.Unmarshal(reportBytes, report); err != nil {
+		return nil, fmt.Errorf("could not parse the compile report in %v: %v", compiledDir, err)
+	}
+	return report, nil
+}
+
+// environmentImage returns the image the steps of an environment run in.
+func environmentImage(sameConfigFile loaders.SameConfig, environmentName string) string {
+	imageTag := sameConfigFile.Spec.Environments[environmentName].ImageTag
+	if imageTag == "" && environmentName == "default" {
+		return DefaultImageTag
+	}
+	return imageTag
+}
+
+// splitTag splits a cell tag into its name and value, e.g. 'cache' and 'P1D' for 'cache=P1D'. Tags without a
+// value, like 'same_step_1', have an empty one.
+func splitTag(tag string) (string, string) {
+	nameAndValue := strings.SplitN(tag, "=", 2)
+	if len(nameAndValue) == 1 {
+		return nameAndValue[0], ""
+	}
+	return nameAndValue[0], nameAndValue[1]
+}
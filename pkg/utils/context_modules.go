@@ -0,0 +1,479 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// tracedVariable is a variable in a step's context, with the top level modules its value comes from
+type tracedVariable struct {
+	Modules []string
+	// IsModule is whether the variable is an imported module (e.g. 'np'). Modules are passed on by name
+	// rather than pickled, so a later step only needs them installed if it uses them.
+	IsModule bool
+}
+
+// unpicklingModules load values whose classes can come from any module, e.g. 'pickle.load(f)'
+var unpicklingModules = map[string]bool{
+	"pickle": true, "_pickle": true, "cPickle": true, "cloudpickle": true, "dill": true, "joblib": true, "shelve": true,
+}
+
+// pythonStatement is one simple statement, or the header of a compound one, with its indentation
+type pythonStatement struct {
+	Indent int
+	Tokens []pythonToken
+}
+
+// compoundKeywords start statements whose header ends with a ':', which may be followed by a body on the same line
+var compoundKeywords = map[string]bool{
+	"if": true, "elif": true, "else": true, "for": true, "while": true, "with": true,
+	"try": true, "except": true, "finally": true, "def": true, "class": true,
+}
+
+// ContextModules returns, for each step, the top level modules needed to load the context the step starts
+// from: the modules the values of its incoming variables come from, and the modules passed on by earlier
+// steps that the step uses. These are found by tracing, through the steps in the order they run, which
+// modules each variable's value is made from - e.g. after 'df = pd.read_csv(path)', 'df' comes from pandas.
+func ContextModules(aggregatedSteps map[string]CodeBlock) (map[string][]string, error) {
+	stepOrder, err := StepOrder(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+	names, err := variableNames(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	outgoing := make(map[string]map[string]tracedVariable)
+	contextModules := make(map[string][]string)
+	for _, stepName := range stepOrder {
+		codeBlock := aggregatedSteps[stepName]
+		incoming := make(map[string]tracedVariable)
+		for _, parent := range codeBlock.Parents {
+			for name, variable := range outgoing[parent] {
+				// Only the variables the step loads need their modules, see StepVariablesFor
+				if !variable.IsModule && !(names[parent].passes(name) && names[stepName].loads(name)) {
+					continue
+				}
+				if existing, exists := incoming[name]; exists {
+					variable = tracedVariable{unionStrings(existing.Modules, variable.Modules), existing.IsModule && variable.IsModule}
+				}
+				incoming[name] = variable
+			}
+		}
+
+		tokens := tokenizePython(codeBlock.Code)
+		referenced := referencedNames(tokens)
+		modules := make([]string, 0)
+		for name, variable := range incoming {
+			if !variable.IsModule || referenced[name] {
+				modules = unionStrings(modules, variable.Modules)
+			}
+		}
+		sort.Strings(modules)
+		contextModules[stepName] = modules
+		outgoing[stepName] = traceVariables(tokens, incoming)
+	}
+	return contextModules, nil
+}
+
+// ContextDistributions returns, for each step, the distributions to install to load the context the step
+// starts from, sorted. See ContextModules.
+func ContextDistributions(aggregatedSteps map[string]CodeBlock) (map[string][]string, error) {
+	contextModules, err := ContextModules(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultImportMap, err := DefaultImportMap()
+	if err != nil {
+		return nil, err
+	}
+	stdlibModules := StdlibModules()
+	contextDistributions := make(map[string][]string)
+	for stepName, modules := range contextModules {
+		importMap := aggregatedSteps[stepName].ImportMap
+		if importMap == nil {
+			importMap = defaultImportMap
+		}
+		distributions := make([]string, 0)
+		for _, module := range modules {
+			// Unknown modules were already reported by the step importing them
+			if distribution := importMap[module]; !stdlibModules[module] && distribution != "" && !ContainsString(distributions, distribution) {
+				distributions = append(distributions, distribution)
+			}
+		}
+		sort.Strings(distributions)
+		contextDistributions[stepName] = distributions
+	}
+	return contextDistributions, nil
+}
+
+// traceVariables returns the variables a step passes on: the ones it started with, updated by the module
+// level imports, assignments, definitions and deletions in its code. The tracing over-approximates - a
+// variable may be traced to modules it doesn't need. Values loaded from pickles can hold objects of any class,
+// so are traced to every module in scope, see modulesOf. Modules the code never names are still missed, e.g.
+// the classes of a value returned by a function of a support file.
+func traceVariables(tokens []pythonToken, incoming map[string]tracedVariable) map[string]tracedVariable {
+	variables := make(map[string]tracedVariable)
+	for name, variable := range incoming {
+		variables[name] = variable
+	}
+
+	statements := splitPythonStatements(tokens)
+	decoratorModules := make([]string, 0)
+	for i := 0; i < len(statements); i++ {
+		statement := statements[i].Tokens
+		keyword := statement[0].Text
+		switch {
+		case statement[0].Kind == pythonTokenOp && keyword == "@":
+			decoratorModules = unionStrings(decoratorModules, modulesOf(statement, variables))
+		case keyword == "def" || keyword == "class":
+			// A function or class brings in the modules used anywhere in its body, which are the statements
+			// indented further than it
+			modules := unionStrings(decoratorModules, modulesOf(statement, variables))
+			decoratorModules = make([]string, 0)
+			for i+1 < len(statements) && statements[i+1].Indent > statements[i].Indent {
+				i++
+				modules = unionStrings(modules, modulesOf(statements[i].Tokens, variables))
+				if imported := importedVariables(statements[i].Tokens); imported != nil {
+					for _, variable := range imported {
+						modules = unionStrings(modules, variable.Modules)
+					}
+				}
+			}
+			if len(statement) > 1 && statement[1].Kind == pythonTokenName {
+				variables[statement[1].Text] = tracedVariable{Modules: modules}
+			}
+		case keyword == "import" || keyword == "from":
+			for name, variable := range importedVariables(statement) {
+				variables[name] = variable
+			}
+		case keyword == "del":
+			for _, name := range targetNames(statement[1:]) {
+				delete(variables, name)
+			}
+		case keyword == "for":
+			if in := indexOfName(statement, "in"); in > 0 {
+				assignVariables(variables, statement[1:in], modulesOf(statement[in+1:], variables), false)
+			}
+		case keyword == "with":
+			for _, item := range splitTopLevel(statement[1:], ",") {
+				if as := indexOfName(item, "as"); as > 0 {
+					assignVariables(variables, item[as+1:], modulesOf(item[:as], variables), false)
+				}
+			}
+		case statement[0].Kind == pythonTokenName && compoundKeywords[keyword]:
+			// Other compound statement headers don't bind variables (besides with ':=', which isn't traced)
+		default:
+			traceAssignment(variables, statement)
+		}
+	}
+	return variables
+}
+
+// traceAssignment traces the variables bound by an assignment statement, e.g. 'a = b = f(x)', 'a, *b = x',
+// 'a: int = 1' or 'a += x'. Assigning to an attribute or item of a variable ('df["a"] = x') adds to the
+// modules it comes from, rather than replacing them.
+func traceAssignment(variables map[string]tracedVariable, statement []pythonToken) {
+	targets, value, augmented := assignmentParts(statement)
+	if len(targets) == 0 {
+		return
+	}
+	modules := modulesOf(value, variables)
+
+	for _, target := range targets {
+		if annotation := splitTopLevel(target, ":"); len(annotation) > 1 {
+			target = annotation[0]
+		}
+		assignVariables(variables, target, modules, augmented)
+	}
+}
+
+// assignmentParts splits an assignment statement into its targets (with any annotation) and the value it
+// assigns, e.g. 'a' and 'b' and 'f(x)' for 'a = b = f(x)'. Statements that aren't assignments have no
+// targets. augmented is whether it is an augmented assignment like 'a += x'.
+func assignmentParts(statement []pythonToken) (targets [][]pythonToken, value []pythonToken, augmented bool) {
+	// The '=' of each assignment, and where the target before it ends
+	depth := 0
+	equals := make([]int, 0)
+	targetEnds := make([]int, 0)
+	for i, token := range statement {
+		if token.Kind == pythonTokenName && token.Text == "lambda" && depth == 0 {
+			// Any '=' after this is a default argument of the lambda
+			break
+		}
+		if token.Kind != pythonTokenOp {
+			continue
+		}
+		switch {
+		case strings.Contains("([{", token.Text):
+			depth++
+		case strings.Contains(")]}", token.Text):
+			depth--
+		case token.Text == "=" && depth == 0:
+			previous, next := "", ""
+			if i > 0 && statement[i-1].Kind == pythonTokenOp {
+				previous = statement[i-1].Text
+			}
+			if i+1 < len(statement) && statement[i+1].Kind == pythonTokenOp {
+				next = statement[i+1].Text
+			}
+			targetEnd := i
+			switch {
+			case next == "=" || previous == "=" || previous == "!" || previous == ":":
+				// '==', '!=' and ':='
+				continue
+			case previous == ">" || previous == "<":
+				// '>>=' and '<<=' are assignments, '>=' and '<=' are comparisons
+				if i < 2 || statement[i-2].Text != previous {
+					continue
+				}
+				targetEnd, augmented = i-2, true
+			case previous != "" && strings.Contains("+-*/%&|^@", previous):
+				targetEnd, augmented = i-1, true
+				if (previous == "*" || previous == "/") && i > 1 && statement[i-2].Text == previous {
+					targetEnd--
+				}
+			}
+			equals = append(equals, i)
+			targetEnds = append(targetEnds, targetEnd)
+		}
+		if augmented {
+			break
+		}
+	}
+	if len(equals) == 0 {
+		return nil, nil, false
+	}
+
+	targetStart := 0
+	for i, equal := range equals {
+		targets = append(targets, statement[targetStart:targetEnds[i]])
+		targetStart = equal + 1
+	}
+	return targets, statement[equals[len(equals)-1]+1:], augmented
+}
+
+// assignVariables binds the names in an assignment target to values made from modules.
+func assignVariables(variables map[string]tracedVariable, target []pythonToken, modules []string, augmented bool) {
+	for _, name := range targetNames(target) {
+		variable := variables[name]
+		if augmented || isMutatedTarget(target, name) {
+			variables[name] = tracedVariable{Modules: unionStrings(variable.Modules, modules)}
+		} else {
+			variables[name] = tracedVariable{Modules: unionStrings(nil, modules)}
+		}
+	}
+}
+
+// targetNames returns the variables an assignment target binds or changes: its names, leaving out
+// attributes ('b' in 'a.b') and the names in subscripts ('i' in 'a[i]').
+func targetNames(target []pythonToken) []string {
+	names := make([]string, 0)
+	subscripts := make([]bool, 0)
+	inSubscript := 0
+	for i, token := range target {
+		previous := pythonToken{}
+		if i > 0 {
+			previous = target[i-1]
+		}
+		switch {
+		case token.Kind == pythonTokenOp && (token.Text == "[" || token.Text == "("):
+			subscript := previous.Kind == pythonTokenName || previous.Text == ")" || previous.Text == "]"
+			subscripts = append(subscripts, subscript)
+			if subscript {
+				inSubscript++
+			}
+		case token.Kind == pythonTokenOp && (token.Text == "]" || token.Text == ")"):
+			if len(subscripts) > 0 {
+				if subscripts[len(subscripts)-1] {
+					inSubscript--
+				}
+				subscripts = subscripts[:len(subscripts)-1]
+			}
+		case token.Kind == pythonTokenName && inSubscript == 0 && previous.Text != "." && !ContainsString(names, token.Text):
+			names = append(names, token.Text)
+		}
+	}
+	return names
+}
+
+// isMutatedTarget is whether a target changes part of a variable ('a.b = x' or 'a[0] = x') rather than binding it.
+func isMutatedTarget(target []pythonToken, name string) bool {
+	for i, token := range target {
+		if token.Kind == pythonTokenName && token.Text == name && (i == 0 || target[i-1].Text != ".") {
+			return i+1 < len(target) && (target[i+1].Text == "." || target[i+1].Text == "[")
+		}
+	}
+	return false
+}
+
+// importedVariables returns the variables an import statement binds, or nil if the statement isn't one.
+// 'import a.b' binds the module 'a', and 'import a.b as c' binds 'c' to the module 'a'. Names imported with
+// 'from' may be classes or functions as well as modules, so are always treated as values; relative imports
+// are local, so come from no module to install.
+func importedVariables(statement []pythonToken) map[string]tracedVariable {
+	variables := make(map[string]tracedVariable)
+	switch statement[0].Text {
+	case "import":
+		for _, item := range splitTopLevel(statement[1:], ",") {
+			if len(item) == 0 || item[0].Kind != pythonTokenName {
+				continue
+			}
+			name := item[0].Text
+			if as := indexOfName(item, "as"); as > 0 && as+1 < len(item) {
+				name = item[as+1].Text
+			}
+			variables[name] = tracedVariable{Modules: []string{item[0].Text}, IsModule: true}
+		}
+	case "from":
+		importAt := indexOfName(statement, "import")
+		if importAt < 2 {
+			return variables
+		}
+		modules := []string{}
+		if statement[1].Kind == pythonTokenName {
+			modules = []string{statement[1].Text}
+		}
+		for _, item := range splitTopLevel(statement[importAt+1:], ",") {
+			names := make([]pythonToken, 0, len(item))
+			for _, token := range item {
+				if token.Kind == pythonTokenName {
+					names = append(names, token)
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+			variables[names[len(names)-1].Text] = tracedVariable{Modules: modules}
+		}
+	default:
+		return nil
+	}
+	return variables
+}
+
+// modulesOf returns the modules of the variables an expression uses.
+func modulesOf(tokens []pythonToken, variables map[string]tracedVariable) []string {
+	modules := make([]string, 0)
+	for name := range referencedNames(tokens) {
+		if variable, exists := variables[name]; exists {
+			modules = unionStrings(modules, variable.Modules)
+		}
+	}
+	for _, module := range modules {
+		if unpicklingModules[module] {
+			// The classes of an unpickled value are only known when it is loaded, so it may need any
+			// module imported so far
+			for _, variable := range variables {
+				modules = unionStrings(modules, variable.Modules)
+			}
+			break
+		}
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// referencedNames returns the names code uses, leaving out attributes ('b' in 'a.b').
+func referencedNames(tokens []pythonToken) map[string]bool {
+	names := make(map[string]bool)
+	for i, token := range tokens {
+		if token.Kind == pythonTokenName && (i == 0 || tokens[i-1].Text != ".") {
+			names[token.Text] = true
+		}
+	}
+	return names
+}
+
+// splitPythonStatements splits tokens into statements at the ends of lines and at ';'. The body of a
+// compound statement on the same line as its header ('if a: b = 1') becomes a statement of its own,
+// indented further than the header.
+func splitPythonStatements(tokens []pythonToken) []pythonStatement {
+	statements := make([]pythonStatement, 0)
+	var addStatement func(indent int, statement []pythonToken)
+	addStatement = func(indent int, statement []pythonToken) {
+		if len(statement) == 0 {
+			return
+		}
+		if statement[0].Kind == pythonTokenName && statement[0].Text == "async" && len(statement) > 1 {
+			statement = statement[1:]
+		}
+		if statement[0].Kind == pythonTokenName && compoundKeywords[statement[0].Text] {
+			if parts := splitTopLevel(statement, ":"); len(parts) > 1 {
+				header := len(parts[0])
+				statements = append(statements, pythonStatement{indent, statement[:header]})
+				addStatement(indent+1, statement[header+1:])
+				return
+			}
+		}
+		statements = append(statements, pythonStatement{indent, statement})
+	}
+
+	start := 0
+	for i := 0; i <= len(tokens); i++ {
+		if i < len(tokens) && tokens[i].Kind != pythonTokenNewline && !(tokens[i].Kind == pythonTokenOp && tokens[i].Text == ";") {
+			continue
+		}
+		if start < i {
+			// Statements after a ';' share the indentation of the first statement on their line
+			indent := tokens[start].Column
+			if start > 0 && tokens[start-1].Text == ";" && len(statements) > 0 {
+				indent = statements[len(statements)-1].Indent
+			}
+			addStatement(indent, tokens[start:i])
+		}
+		start = i + 1
+	}
+	return statements
+}
+
+// splitTopLevel splits tokens at a separator outside of brackets.
+func splitTopLevel(tokens []pythonToken, separator string) [][]pythonToken {
+	parts := make([][]pythonToken, 0)
+	depth := 0
+	start := 0
+	for i, token := range tokens {
+		if token.Kind != pythonTokenOp {
+			continue
+		}
+		switch {
+		case strings.Contains("([{", token.Text):
+			depth++
+		case strings.Contains(")]}", token.Text):
+			depth--
+		case token.Text == separator && depth == 0:
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, tokens[start:])
+}
+
+// indexOfName returns the index of the first name token with the given text outside of brackets, or -1.
+func indexOfName(tokens []pythonToken, name string) int {
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.Kind == pythonTokenOp && strings.Contains("([{", token.Text):
+			depth++
+		case token.Kind == pythonTokenOp && strings.Contains(")]}", token.Text):
+			depth--
+		case token.Kind == pythonTokenName && token.Text == name && depth == 0:
+			return i
+		}
+	}
+	return -1
+}
+
+// unionStrings returns the strings in either slice, without duplicates.
+func unionStrings(left []string, right []string) []string {
+	union := make([]string, 0, len(left)+len(right))
+	for _, s := range append(append([]string{}, left...), right...) {
+		if !ContainsString(union, s) {
+			union = append(union, s)
+		}
+	}
+	return union
+}
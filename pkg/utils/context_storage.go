@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
+
+// The artifact store of Kubeflow Pipelines, which contexts are spilled to unless the SAME file says otherwise
+const (
+	DefaultContextStoragePrefix   = "s3://mlpipeline/same/contexts"
+	DefaultContextStorageEndpoint = "http://minio-service.kubeflow:9000"
+	DefaultContextStorageSecret   = "mlpipeline-minio-artifact"
+)
+
+// ContextStorage is where a step writes the largest variables it passes on, once its context is larger than
+// ThresholdBytes. The steps after it are passed a reference to each spilled variable instead of its value.
+// A ThresholdBytes of 0 means contexts are always passed inline.
+type ContextStorage struct {
+	ThresholdBytes int64
+	Bucket         string
+	// Path is the prefix of the keys variables are written to, which are '<path>/<run id>/<step>/<variable>'
+	Path              string
+	Endpoint          string
+	CredentialsSecret string
+}
+
+// Enabled is whether large contexts are spilled.
+func (s ContextStorage) Enabled() bool {
+	return s.ThresholdBytes > 0
+}
+
+// ResolveContextStorage reads 'context_storage' from a SAME file, filling in the defaults.
+func ResolveContextStorage(sameConfigFile loaders.SameConfig) (ContextStorage, error) {
+	config := sameConfigFile.Spec.ContextStorage
+	if config.SpillThreshold == "" {
+		if config != (loaders.ContextStorage{}) {
+			return ContextStorage{}, fmt.Errorf("context_storage needs a spill_threshold (e.g. '4Mi') to spill contexts")
+		}
+		return ContextStorage{}, nil
+	}
+
+	match := memoryQuantityRegex.FindStringSubmatch(config.SpillThreshold)
+	if match == nil {
+		return ContextStorage{}, fmt.Errorf("context_storage.spill_threshold must be a size with an optional unit (e.g. '4Mi' or '500k'), found: %v", config.SpillThreshold)
+	}
+	size, _ := strconv.ParseFloat(match[1], 64)
+	thresholdBytes := int64(size * memoryUnits[match[2]])
+	if thresholdBytes <= 0 {
+		return ContextStorage{}, fmt.Errorf("context_storage.spill_threshold must be more than 0 bytes, found: %v", config.SpillThreshold)
+	}
+
+	prefix := ValueOrDefault(config.Prefix, DefaultContextStoragePrefix)
+	if !strings.HasPrefix(prefix, "s3://") {
+		return ContextStorage{}, fmt.Errorf("context_storage.prefix must be an 's3://bucket/path' URI, found: %v", prefix)
+	}
+	bucketAndPath := strings.SplitN(strings.TrimPrefix(prefix, "s3://"), "/", 2)
+	if bucketAndPath[0] == "" {
+		return ContextStorage{}, fmt.Errorf("context_storage.prefix must name a bucket, found: %v", prefix)
+	}
+	path := ""
+	if len(bucketAndPath) > 1 {
+		path = strings.Trim(bucketAndPath[1], "/")
+	}
+
+	return ContextStorage{
+		ThresholdBytes:    thresholdBytes,
+		Bucket:            bucketAndPath[0],
+		Path:              path,
+		Endpoint:          ValueOrDefault(config.Endpoint, DefaultContextStorageEndpoint),
+		CredentialsSecret: ValueOrDefault(config.CredentialsSecret, DefaultContextStorageSecret),
+	}, nil
+}
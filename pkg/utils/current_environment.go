@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/spf13/cobra"
+)
+
+// packagingTools are left out of captured environments, as 'pip freeze' leaves them out
+var packagingTools = map[string]bool{"pip": true, "setuptools": true, "wheel": true, "distribute": true}
+
+var packageNameSeparatorRegex = regexp.MustCompile(`[-_.]+`)
+
+// requirementNameRegex matches the name at the start of a requirement, e.g. 'delorean' in 'delorean==1.0.0'
+var requirementNameRegex = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+
+// CurrentPackages returns the packages installed for the local Python interpreter, by normalized name, with
+// their versions.
+func CurrentPackages() (map[string]string, error) {
+	pipCommand := `
+	#!/bin/bash
+	pip3 list --format freeze --exclude-editable
+		`
+
+	cmdReturn, err := ExecuteInlineBashScript(&cobra.Command{}, pipCommand, "Pip Freeze failed", false)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the packages installed locally with pip3: %v", err)
+	}
+	return ParsePipFreeze(cmdReturn), nil
+}
+
+// ParsePipFreeze reads the output of 'pip freeze' into the versions of the packages, by normalized name.
+// Lines that don't pin a version, like comments or editable installs, are skipped.
+func ParsePipFreeze(output string) map[string]string {
+	packages := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		name, version := splitRequirement(line)
+		if version != "" {
+			packages[NormalizePackageName(name)] = version
+		}
+	}
+	return packages
+}
+
+// NormalizePackageName normalizes a distribution name as pip compares them, so 'Scikit_Learn' and
+// 'scikit-learn' are the same package.
+func NormalizePackageName(name string) string {
+	return strings.ToLower(packageNameSeparatorRegex.ReplaceAllString(name, "-"))
+}
+
+// splitRequirement splits a requirement into its name, and its version if it is pinned with '=='.
+func splitRequirement(requirement string) (name string, version string) {
+	requirement = strings.TrimSpace(strings.SplitN(requirement, "#", 2)[0])
+	match := requirementNameRegex.FindStringSubmatch(requirement)
+	if match == nil {
+		return "", ""
+	}
+	name = match[1]
+	rest := strings.TrimSpace(requirement[len(match[0]):])
+	if strings.HasPrefix(rest, "==") && !strings.Contains(rest, ",") {
+		version = strings.TrimSpace(strings.TrimPrefix(rest, "=="))
+	}
+	return name, version
+}
+
+// CapturePackages returns the packages of an environment pinned to the ones installed locally: every package
+// installed locally at its installed version, with the declared packages that aren't installed locally kept
+// as they were declared. The packages are sorted by name.
+func CapturePackages(declared []string, current map[string]string) []string {
+	byName := make(map[string]string)
+	for name, version := range current {
+		if !packagingTools[name] {
+			byName[name] = fmt.Sprintf("%v==%v", name, version)
+		}
+	}
+	for _, requirement := range declared {
+		name, _ := splitRequirement(requirement)
+		if name == "" {
+			continue
+		}
+		if _, installed := current[NormalizePackageName(name)]; !installed {
+			byName[NormalizePackageName(name)] = requirement
+		}
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	packages := make([]string, 0, len(names))
+	for _, name := range names {
+		packages = append(packages, byName[name])
+	}
+	return packages
+}
+
+// PackageMismatches compares the packages an environment declares to the ones installed locally, and returns
+// the declared packages that differ, with the version installed locally ("" if the package isn't installed).
+func PackageMismatches(declared []string, current map[string]string) map[string]string {
+	mismatches := make(map[string]string)
+	for _, requirement := range declared {
+		name, version := splitRequirement(requirement)
+		if name == "" {
+			continue
+		}
+		installedVersion, installed := current[NormalizePackageName(name)]
+		if !installed || version != "" && version != installedVersion {
+			mismatches[strings.TrimSpace(requirement)] = installedVersion
+		}
+	}
+	return mismatches
+}
+
+// AppendCurrentEnvironments adds the packages installed locally to the environments with
+// 'append_current_environment' set, see CapturePackages. The environments are copied rather than changed in
+// place, as the SAME file may be shared with the caller.
+func AppendCurrentEnvironments(sameConfigFile *loaders.SameConfig, current map[string]string) {
+	environments := make(map[string]loaders.Environment, len(sameConfigFile.Spec.Environments))
+	for envName, env := range sameConfigFile.Spec.Environments {
+		if env.AppendCurrentEnvironment {
+			env.Packages = CapturePackages(env.Packages, current)
+		}
+		environments[envName] = env
+	}
+	sameConfigFile.Spec.Environments = environments
+}
+
+// AppendsCurrentEnvironment reports whether any environment has 'append_current_environment' set.
+func AppendsCurrentEnvironment(sameConfigFile loaders.SameConfig) bool {
+	for _, env := range sameConfigFile.Spec.Environments {
+		if env.AppendCurrentEnvironment {
+			return true
+		}
+	}
+	return false
+}
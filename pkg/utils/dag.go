@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ResolveStepDependencies works out the parents of every step. A step tagged 'depends_on=same_step_1,train'
+// runs after the steps it names (and a bare 'depends_on=' makes it a root of the DAG); any other step runs
+// after the step before it, in the order of the step indexes, as steps always have. It returns an error if a
+// step depends on a step that doesn't exist, or the dependencies form a cycle.
+func ResolveStepDependencies(aggregatedSteps map[string]CodeBlock) error {
+	stepNames := SortedStepNames(aggregatedSteps)
+	displayNames := make([]string, 0, len(stepNames))
+	for _, stepName := range stepNames {
+		displayNames = append(displayNames, aggregatedSteps[stepName].DisplayName)
+	}
+
+	problems := make([]string, 0)
+	for i, stepName := range stepNames {
+		thisCodeBlock := aggregatedSteps[stepName]
+		thisCodeBlock.Parents = make([]string, 0)
+		if thisCodeBlock.DependsOnSet {
+			for _, dependency := range thisCodeBlock.DependsOn {
+				parent, exists := stepIdentifier(aggregatedSteps, dependency)
+				if !exists {
+					problems = append(problems, fmt.Sprintf("%v depends on %v, which is not a step in the notebook (expected one of: %v)", thisCodeBlock.DisplayName, dependency, strings.Join(displayNames, ", ")))
+					continue
+				}
+				if !ContainsString(thisCodeBlock.Parents, parent) {
+					thisCodeBlock.Parents = append(thisCodeBlock.Parents, parent)
+				}
+			}
+		} else if i > 0 {
+			thisCodeBlock.Parents = append(thisCodeBlock.Parents, stepNames[i-1])
+		}
+		aggregatedSteps[stepName] = thisCodeBlock
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid step dependencies:\n%v", strings.Join(problems, "\n"))
+	}
+
+	if _, err := StepOrder(aggregatedSteps); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StepOrder returns the steps in an order where every step comes after its parents. Steps that could go in
+// either order are sorted by index, so the order is stable between compilations.
+func StepOrder(aggregatedSteps map[string]CodeBlock) ([]string, error) {
+	positions := make(map[string]int, len(aggregatedSteps))
+	for position, stepName := range SortedStepNames(aggregatedSteps) {
+		positions[stepName] = position
+	}
+
+	remainingParents := make(map[string]int, len(aggregatedSteps))
+	children := make(map[string][]string, len(aggregatedSteps))
+	ready := make([]string, 0)
+	for stepName, thisCodeBlock := range aggregatedSteps {
+		remainingParents[stepName] = len(thisCodeBlock.Parents)
+		for _, parent := range thisCodeBlock.Parents {
+			children[parent] = append(children[parent], stepName)
+		}
+		if len(thisCodeBlock.Parents) == 0 {
+			ready = append(ready, stepName)
+		}
+	}
+
+	order := make([]string, 0, len(aggregatedSteps))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return positions[ready[i]] < positions[ready[j]] })
+		stepName := ready[0]
+		ready = ready[1:]
+		order = append(order, stepName)
+		for _, child := range children[stepName] {
+			remainingParents[child]--
+			if remainingParents[child] == 0 {
+				ready = append(ready, child)
+			}
+		}
+	}
+
+	if len(order) < len(aggregatedSteps) {
+		return nil, fmt.Errorf("the step dependencies form a cycle: %v", strings.Join(findStepCycle(aggregatedSteps), " -> "))
+	}
+	return order, nil
+}
+
+// findStepCycle returns a cycle in the step dependencies, starting and ending with the same step, to show
+// in errors. Steps are shown by their display names.
+func findStepCycle(aggregatedSteps map[string]CodeBlock) []string {
+	stepNames := SortedStepNames(aggregatedSteps)
+	positions := make(map[string]int, len(stepNames))
+	for position, stepName := range stepNames {
+		positions[stepName] = position
+	}
+
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(stepNames))
+	path := make([]string, 0)
+	var visit func(string) []string
+	visit = func(stepName string) []string {
+		state[stepName] = inProgress
+		path = append(path, stepName)
+		for _, parent := range aggregatedSteps[stepName].Parents {
+			switch state[parent] {
+			case inProgress:
+				for i, onPath := range path {
+					if onPath == parent {
+						// The path runs from child to parent, the cycle is shown in the order steps run
+						cycle := append([]string{}, path[i:]...)
+						for l, r := 0, len(cycle)-1; l < r; l, r = l+1, r-1 {
+							cycle[l], cycle[r] = cycle[r], cycle[l]
+						}
+						// Start from the first step by index, so the same cycle is always reported the same way
+						first := 0
+						for j := range cycle {
+							if positions[cycle[j]] < positions[cycle[first]] {
+								first = j
+							}
+						}
+						cycle = append(cycle[first:], cycle[:first]...)
+						displayNames := make([]string, 0, len(cycle)+1)
+						for _, stepName := range append(cycle, cycle[0]) {
+							displayNames = append(displayNames, aggregatedSteps[stepName].DisplayName)
+						}
+						return displayNames
+					}
+				}
+			case unvisited:
+				if cycle := visit(parent); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[stepName] = done
+		return nil
+	}
+
+	for _, stepName := range stepNames {
+		if state[stepName] == unvisited {
+			if cycle := visit(stepName); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
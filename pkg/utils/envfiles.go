@@ -0,0 +1,212 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
+
+// EnvVar is a single environment variable to inject into the steps of an environment. Value (a quoted Python
+// string literal) is only set for variables that are safe to write into the generated files, SecretName is
+// set for the ones that have to be read from a secret instead.
+type EnvVar struct {
+	Environment string
+	Name        string
+	Value       string
+	SecretName  string
+}
+
+// EnvVars are the variables loaded from the envfiles that apply to one environment.
+type EnvVars struct {
+	Plain   map[string]string
+	Secrets map[string]string
+}
+
+var envVarNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Any variable with one of these words in its name is treated as a secret
+var secretEnvVarWords = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "KEY", "APIKEY", "CREDENTIAL", "CREDENTIALS"}
+
+// ParseDotEnv parses the contents of a dotenv file. It supports comments, 'export' prefixes, and single
+// or double quoted values (double quoted values may use \n, \t, \" and \\ escapes).
+func ParseDotEnv(fileName string, contents []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%v:%v: expected KEY=VALUE, found: %v", fileName, lineNumber, line)
+		}
+		name := strings.TrimSpace(parts[0])
+		if !envVarNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("%v:%v: '%v' is not a valid environment variable name", fileName, lineNumber, name)
+		}
+
+		value, err := parseDotEnvValue(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%v:%v: %v", fileName, lineNumber, err)
+		}
+		vars[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read env file %v: %v", fileName, err)
+	}
+	return vars, nil
+}
+
+func parseDotEnvValue(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+
+	quote := raw[0]
+	if quote != '"' && quote != '\'' {
+		// Unquoted values end at an inline comment
+		if i := strings.Index(raw, " #"); i >= 0 {
+			raw = raw[:i]
+		}
+		return strings.TrimSpace(raw), nil
+	}
+
+	end := -1
+	for i := 1; i < len(raw); i++ {
+		if quote == '"' && raw[i] == '\\' {
+			i++
+			continue
+		}
+		if raw[i] == quote {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return "", fmt.Errorf("unterminated quoted value: %v", raw)
+	}
+	if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected characters after quoted value: %v", rest)
+	}
+
+	value := raw[1:end]
+	if quote == '\'' {
+		return value, nil
+	}
+	return strings.NewReplacer(`\n`, "\n", `\t`, "\t", `\"`, `"`, `\\`, `\`).Replace(value), nil
+}
+
+// LoadEnvFiles reads each of the env files (relative to rootDir) in order, later files overriding earlier ones.
+func LoadEnvFiles(rootDir string, envFiles []string) (map[string]string, error) {
+	vars := make(map[string]string)
+	for _, envFile := range envFiles {
+		envFilePath := envFile
+		if !filepath.IsAbs(envFilePath) {
+			envFilePath = filepath.Join(rootDir, envFile)
+		}
+		contents, err := ioutil.ReadFile(envFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read env file '%v': %v", envFile, err)
+		}
+		fileVars, err := ParseDotEnv(envFile, contents)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fileVars {
+			vars[k] = v
+		}
+	}
+	return vars, nil
+}
+
+// IsSecretEnvVar reports whether a variable looks like it holds a secret, based on the words in its name
+// (e.g. DB_PASSWORD, AWS_SECRET_ACCESS_KEY, GITHUB_TOKEN).
+func IsSecretEnvVar(name string) bool {
+	for _, word := range strings.Split(strings.ToUpper(name), "_") {
+		if ContainsString(secretEnvVarWords, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvVarsByEnvironment loads the envfiles for every environment in a SAME file. The top level envfiles apply
+// to every environment, and the envfiles of an environment override them. Paths are relative to the SAME file.
+func EnvVarsByEnvironment(sameConfigFile loaders.SameConfig) (map[string]EnvVars, error) {
+	rootDir := filepath.Dir(sameConfigFile.Spec.ConfigFilePath)
+	globalVars, err := LoadEnvFiles(rootDir, sameConfigFile.Spec.EnvFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	envNames := []string{"default"}
+	for envName := range sameConfigFile.Spec.Environments {
+		envNames = AppendIfMissing(envNames, envName)
+	}
+
+	envVarsByEnvironment := make(map[string]EnvVars, len(envNames))
+	for _, envName := range envNames {
+		envVars, err := LoadEnvFiles(rootDir, sameConfigFile.Spec.Environments[envName].EnvFiles)
+		if err != nil {
+			return nil, err
+		}
+
+		thisEnvVars := EnvVars{Plain: make(map[string]string), Secrets: make(map[string]string)}
+		for _, vars := range []map[string]string{globalVars, envVars} {
+			for k, v := range vars {
+				delete(thisEnvVars.Plain, k)
+				delete(thisEnvVars.Secrets, k)
+				if IsSecretEnvVar(k) {
+					thisEnvVars.Secrets[k] = v
+				} else {
+					thisEnvVars.Plain[k] = v
+				}
+			}
+		}
+		envVarsByEnvironment[envName] = thisEnvVars
+	}
+	return envVarsByEnvironment, nil
+}
+
+// TemplateEnvVars flattens the env vars of an environment into the order they are written into generated files.
+func (e EnvVars) TemplateEnvVars(envName string, secretName string) []EnvVar {
+	envVars := make([]EnvVar, 0, len(e.Plain)+len(e.Secrets))
+	for _, name := range SortedEnvVarNames(e.Plain) {
+		// A JSON string is also a valid Python string literal
+		value, _ := json.Marshal(e.Plain[name])
+		envVars = append(envVars, EnvVar{Environment: envName, Name: name, Value: string(value)})
+	}
+	for _, name := range SortedEnvVarNames(e.Secrets) {
+		envVars = append(envVars, EnvVar{Environment: envName, Name: name, SecretName: secretName})
+	}
+	return envVars
+}
+
+// EnvSecretName is the name of the Kubernetes secret holding the secret env vars of an environment.
+func EnvSecretName(experimentName string, envName string) string {
+	name := strings.ToLower(fmt.Sprintf("%v-%v-env", alphaNumericOnly(experimentName), envName))
+	return strings.Trim(regexp.MustCompile(`[^a-z0-9-]+`).ReplaceAllString(name, "-"), "-")
+}
+
+// SortedEnvVarNames returns the names of a set of env vars in order, so generated files are stable.
+func SortedEnvVarNames(vars map[string]string) []string {
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
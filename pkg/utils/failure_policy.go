@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// isoDurationRegex matches ISO 8601 durations in weeks, days, hours, minutes and seconds, e.g. 'PT2H' or
+// 'P1DT30M'. Years and months are left out, as their length varies.
+var isoDurationRegex = regexp.MustCompile(`^P(?:([0-9]+)W)?(?:([0-9]+)D)?(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+(?:\.[0-9]+)?)S)?)?$`)
+
+// StepFailurePolicy is what happens when a step fails or runs too long, as set by the step's tags (e.g.
+// 'retries=3', 'timeout=PT2H' and 'continue_on_failure=true'). The values are kept as written, and are
+// checked as they are set.
+type StepFailurePolicy struct {
+	Retries           string
+	Timeout           string
+	ContinueOnFailure string
+}
+
+// setStepFailurePolicy sets one part of the failure policy of a step from a cell tag. It returns false if the
+// tag isn't about failures.
+func setStepFailurePolicy(policy *StepFailurePolicy, tag string) (bool, error) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) != 2 {
+		return false, nil
+	}
+	name, value := parts[0], strings.TrimSpace(parts[1])
+	switch name {
+	case "retries":
+		if retries, err := strconv.Atoi(value); err != nil || retries < 0 {
+			return true, fmt.Errorf("retries must be a whole number of times to retry the step, found: %v", value)
+		}
+		policy.Retries = value
+	case "timeout":
+		if _, err := ParseISODuration(value); err != nil {
+			return true, err
+		}
+		policy.Timeout = value
+	case "continue_on_failure":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return true, fmt.Errorf("continue_on_failure must be true or false, found: %v", value)
+		}
+		policy.ContinueOnFailure = value
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// mergeStepFailurePolicies sets every part of base that is set in override.
+func mergeStepFailurePolicies(base StepFailurePolicy, override StepFailurePolicy) StepFailurePolicy {
+	base.Retries = ValueOrDefault(override.Retries, base.Retries)
+	base.Timeout = ValueOrDefault(override.Timeout, base.Timeout)
+	base.ContinueOnFailure = ValueOrDefault(override.ContinueOnFailure, base.ContinueOnFailure)
+	return base
+}
+
+// RetryCount is the number of times the step is retried after failing.
+func (p StepFailurePolicy) RetryCount() int {
+	retries, _ := strconv.Atoi(p.Retries)
+	return retries
+}
+
+// TimeoutSeconds is how long the step may run before it is stopped, or 0 if it may run as long as it likes.
+func (p StepFailurePolicy) TimeoutSeconds() int {
+	if p.Timeout == "" {
+		return 0
+	}
+	seconds, _ := ParseISODuration(p.Timeout)
+	return seconds
+}
+
+// ContinuesOnFailure reports whether the pipeline carries on after the step fails for good. The steps after
+// it then get the context the failed step started with.
+func (p StepFailurePolicy) ContinuesOnFailure() bool {
+	continueOnFailure, _ := strconv.ParseBool(p.ContinueOnFailure)
+	return continueOnFailure
+}
+
+// PlatformRetryCount is the number of times Kubeflow retries the step after failing. A step that continues on
+// failure catches its own failures, so it retries itself instead.
+func (p StepFailurePolicy) PlatformRetryCount() int {
+	if p.ContinuesOnFailure() {
+		return 0
+	}
+	return p.RetryCount()
+}
+
+// PlatformTimeoutSeconds is how long Kubeflow or AML lets the step run before stopping it, or 0 if it doesn't
+// stop it. Being stopped fails the pipeline, so a step that continues on failure times itself out instead.
+func (p StepFailurePolicy) PlatformTimeoutSeconds() int {
+	if p.ContinuesOnFailure() {
+		return 0
+	}
+	return p.TimeoutSeconds()
+}
+
+// StepTimeoutSeconds is how long each attempt of a step that continues on failure may run before the step
+// stops it itself, or 0 if the step doesn't time itself out.
+func (p StepFailurePolicy) StepTimeoutSeconds() int {
+	if !p.ContinuesOnFailure() {
+		return 0
+	}
+	return p.TimeoutSeconds()
+}
+
+// ParseISODuration parses an ISO 8601 duration (e.g. 'PT2H' or 'P1DT30M') into whole seconds, rounding up.
+func ParseISODuration(duration string) (int, error) {
+	match := isoDurationRegex.FindStringSubmatch(duration)
+	if match == nil || duration == "P" || strings.HasSuffix(duration, "T") {
+		return 0, fmt.Errorf("timeout must be an ISO 8601 duration in weeks, days, hours, minutes and seconds (e.g. 'PT2H' or 'P1DT30M'), found: %v", duration)
+	}
+
+	units := []float64{7 * 24 * 60 * 60, 24 * 60 * 60, 60 * 60, 60, 1}
+	total := 0.0
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		value, _ := strconv.ParseFloat(match[i+1], 64)
+		total += value * unit
+	}
+	if total <= 0 {
+		return 0, fmt.Errorf("timeout must be longer than zero, found: %v", duration)
+	}
+	return int(math.Ceil(total)), nil
+}
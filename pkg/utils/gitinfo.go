@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"fmt"
+	netUrl "net/url"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/go-git/go-git/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+// Ways to handle a SAME file whose source doesn't match the commit it claims to be from: a working tree with
+// uncommitted changes, or a metadata.sha that isn't the checked out commit.
+const (
+	GitDirtyWarn   = "warn"
+	GitDirtyFail   = "fail"
+	GitDirtyIgnore = "ignore"
+)
+
+// GitDirtyPolicies are the values accepted for --git-dirty.
+var GitDirtyPolicies = []string{GitDirtyWarn, GitDirtyFail, GitDirtyIgnore}
+
+// GitInfo is the source a SAME file was compiled from: the commit checked out in the git repository holding
+// it, and whether the working tree has changes that aren't part of that commit.
+type GitInfo struct {
+	SHA          string
+	Branch       string
+	RemoteURL    string
+	Dirty        bool
+	ChangedFiles []string
+}
+
+// GetGitInfo reads the git repository holding dir (or one of its parents). It returns nil, and no error,
+// if dir is not in a git repository.
+func GetGitInfo(dir string) (*GitInfo, error) {
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err == git.ErrRepositoryNotExists {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not open the git repository holding %v: %v", dir, err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the current git commit: %v", err)
+	}
+
+	gitInfo := &GitInfo{SHA: head.Hash().String()}
+	if head.Name().IsBranch() {
+		gitInfo.Branch = head.Name().Short()
+	}
+
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the git remotes: %v", err)
+	}
+	remoteURLs := make(map[string]string, len(remotes))
+	remoteNames := make([]string, 0, len(remotes))
+	for _, remote := range remotes {
+		if len(remote.Config().URLs) > 0 {
+			remoteURLs[remote.Config().Name] = remote.Config().URLs[0]
+			remoteNames = append(remoteNames, remote.Config().Name)
+		}
+	}
+	sort.Strings(remoteNames)
+	if url, ok := remoteURLs["origin"]; ok {
+		gitInfo.RemoteURL = withoutCredentials(url)
+	} else if len(remoteNames) > 0 {
+		gitInfo.RemoteURL = withoutCredentials(remoteURLs[remoteNames[0]])
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		// Bare repositories have no working tree to be dirty
+		return gitInfo, nil
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the status of the git working tree: %v", err)
+	}
+	for file, fileStatus := range status {
+		// Like 'git describe --dirty', files git doesn't know about don't count
+		if fileStatus.Worktree == git.Untracked {
+			continue
+		}
+		if fileStatus.Worktree != git.Unmodified || fileStatus.Staging != git.Unmodified {
+			gitInfo.ChangedFiles = append(gitInfo.ChangedFiles, file)
+		}
+	}
+	sort.Strings(gitInfo.ChangedFiles)
+	gitInfo.Dirty = len(gitInfo.ChangedFiles) > 0
+
+	return gitInfo, nil
+}
+
+// ShortSHA is the abbreviated commit, as git shows it.
+func (g GitInfo) ShortSHA() string {
+	if len(g.SHA) > 7 {
+		return g.SHA[:7]
+	}
+	return g.SHA
+}
+
+// MatchesSHA reports whether sha (which may be abbreviated) names the checked out commit.
+func (g GitInfo) MatchesSHA(sha string) bool {
+	return len(sha) >= 4 && strings.HasPrefix(g.SHA, strings.ToLower(sha))
+}
+
+// Describe is a one line summary of the source, e.g. for descriptions shown in the workflow UI.
+func (g GitInfo) Describe() string {
+	description := fmt.Sprintf("commit %v", g.SHA)
+	if g.Branch != "" {
+		description += fmt.Sprintf(" on branch %v", g.Branch)
+	}
+	if g.RemoteURL != "" {
+		description += fmt.Sprintf(" of %v", g.RemoteURL)
+	}
+	if g.Dirty {
+		description += " (with uncommitted changes)"
+	}
+	return description
+}
+
+// withoutCredentials drops any user and password from a remote URL, so it is safe to record.
+func withoutCredentials(remoteURL string) string {
+	parsed, err := netUrl.Parse(remoteURL)
+	if err != nil || parsed.User == nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		// ssh URLs (e.g. git@github.com:org/repo.git) only name the ssh user
+		return remoteURL
+	}
+	parsed.User = nil
+	return parsed.String()
+}
+
+// ApplyGitInfo sets metadata.sha to the checked out commit, applying policy (one of GitDirtyPolicies) if the
+// working tree is dirty or the SAME file names a different commit.
+func ApplyGitInfo(sameConfigFile *loaders.SameConfig, gitInfo GitInfo, policy string) error {
+	if !ContainsString(GitDirtyPolicies, policy) {
+		return fmt.Errorf("unknown git dirty policy '%v', expected one of: %v", policy, strings.Join(GitDirtyPolicies, ", "))
+	}
+
+	problems := make([]string, 0)
+	if gitInfo.Dirty {
+		problems = append(problems, fmt.Sprintf("the git working tree has uncommitted changes, so the program will not match commit %v: %v", gitInfo.ShortSHA(), strings.Join(gitInfo.ChangedFiles, ", ")))
+	}
+	if sha := sameConfigFile.Spec.Metadata.SHA; sha != "" && !gitInfo.MatchesSHA(sha) {
+		problems = append(problems, fmt.Sprintf("metadata.sha is '%v', but commit %v is checked out", sha, gitInfo.ShortSHA()))
+	}
+
+	if len(problems) > 0 {
+		switch policy {
+		case GitDirtyFail:
+			return fmt.Errorf("%v (pass --git-dirty=warn to go ahead anyway)", strings.Join(problems, "; "))
+		case GitDirtyWarn:
+			for _, problem := range problems {
+				log.Warn(problem)
+			}
+		}
+	}
+
+	sameConfigFile.Spec.Metadata.SHA = gitInfo.SHA
+	return nil
+}
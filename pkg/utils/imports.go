@@ -0,0 +1,265 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/internal/box"
+	"gopkg.in/yaml.v2"
+)
+
+// Kinds of the tokens FindImports reads Python source as
+const (
+	pythonTokenName = iota
+	pythonTokenOp
+	pythonTokenNewline
+	pythonTokenOther
+)
+
+type pythonToken struct {
+	Kind int
+	Text string
+	// Column is where the token starts on its line, which is the indentation of the tokens starting statements
+	Column int
+}
+
+// stringPrefixes are the prefixes a Python string literal can have, lower cased
+var stringPrefixes = map[string]bool{"r": true, "u": true, "b": true, "f": true, "br": true, "rb": true, "fr": true, "rf": true}
+
+// FindImports returns the top level modules Python source imports (e.g. 'sklearn' for
+// 'from sklearn.linear_model import LinearRegression'), in the order they are first imported. Relative
+// imports are left out, as they are always local.
+func FindImports(source string) []string {
+	tokens := tokenizePython(source)
+	modules := make([]string, 0)
+	addModule := func(module string) {
+		if !ContainsString(modules, module) {
+			modules = append(modules, module)
+		}
+	}
+
+	// 'import' is a keyword, so it always starts an import; 'from' only does at the start of a statement, as
+	// it is also used by 'raise ... from' and 'yield from'
+	statementStart := true
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case token.Kind == pythonTokenNewline || token.Kind == pythonTokenOp && (token.Text == ";" || token.Text == ":"):
+			statementStart = true
+			continue
+		case token.Kind == pythonTokenName && token.Text == "import":
+			i = readImportedNames(tokens, i+1, addModule)
+		case token.Kind == pythonTokenName && token.Text == "from" && statementStart:
+			if i+1 < len(tokens) && tokens[i+1].Kind == pythonTokenName {
+				addModule(tokens[i+1].Text)
+			}
+			// Skip to the end of the statement, past the names imported from the module
+			for i+1 < len(tokens) && tokens[i+1].Kind != pythonTokenNewline && !(tokens[i+1].Kind == pythonTokenOp && tokens[i+1].Text == ";") {
+				i++
+			}
+		}
+		statementStart = false
+	}
+	return modules
+}
+
+// readImportedNames reads the modules of an 'import a.b as c, d' statement, starting after 'import', and
+// returns the index of its last token.
+func readImportedNames(tokens []pythonToken, i int, addModule func(string)) int {
+	for i < len(tokens) && tokens[i].Kind == pythonTokenName {
+		addModule(tokens[i].Text)
+		i++
+		for i+1 < len(tokens) && tokens[i].Text == "." && tokens[i+1].Kind == pythonTokenName {
+			i += 2
+		}
+		if i+1 < len(tokens) && tokens[i].Text == "as" && tokens[i+1].Kind == pythonTokenName {
+			i += 2
+		}
+		if i >= len(tokens) || tokens[i].Text != "," {
+			break
+		}
+		i++
+	}
+	return i - 1
+}
+
+// tokenizePython splits Python source into the tokens FindImports and ContextModules need: names, operators
+// and the ends of logical lines. Comments are dropped, and string and number literals are kept as single
+// tokens, so that nothing inside them is mistaken for code.
+func tokenizePython(source string) []pythonToken {
+	tokens := make([]pythonToken, 0)
+	depth := 0
+	lineStart := 0
+	addToken := func(kind int, start int, end int) {
+		tokens = append(tokens, pythonToken{kind, source[start:end], start - lineStart})
+	}
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '#':
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case c == '\\' && (strings.HasPrefix(source[i+1:], "\n") || strings.HasPrefix(source[i+1:], "\r\n")):
+			// An explicit line continuation
+			i += 2
+			lineStart = i
+		case c == '\n':
+			// Lines only end outside of brackets
+			if depth == 0 {
+				addToken(pythonTokenNewline, i, i+1)
+			}
+			i++
+			lineStart = i
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+		case c == '\'' || c == '"':
+			end := skipPythonString(source, i)
+			addToken(pythonTokenOther, i, end)
+			i = end
+		case isPythonNameStart(c):
+			end := i
+			for end < len(source) && (isPythonNameStart(source[end]) || source[end] >= '0' && source[end] <= '9') {
+				end++
+			}
+			if end < len(source) && (source[end] == '\'' || source[end] == '"') && stringPrefixes[strings.ToLower(source[i:end])] {
+				stringEnd := skipPythonString(source, end)
+				addToken(pythonTokenOther, i, stringEnd)
+				i = stringEnd
+				continue
+			}
+			addToken(pythonTokenName, i, end)
+			i = end
+		case c >= '0' && c <= '9':
+			end := i
+			for end < len(source) && (isPythonNameStart(source[end]) || source[end] >= '0' && source[end] <= '9' || source[end] == '.') {
+				end++
+			}
+			addToken(pythonTokenOther, i, end)
+			i = end
+		default:
+			if strings.IndexByte("([{", c) >= 0 {
+				depth++
+			} else if strings.IndexByte(")]}", c) >= 0 && depth > 0 {
+				depth--
+			}
+			addToken(pythonTokenOp, i, i+1)
+			i++
+		}
+	}
+	return tokens
+}
+
+// skipPythonString returns the index just past the string literal starting with the quote at i. Unterminated
+// single quoted strings end at the end of their line.
+func skipPythonString(source string, i int) int {
+	quote := source[i : i+1]
+	if strings.HasPrefix(source[i:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	for j := i + len(quote); j < len(source); j++ {
+		switch {
+		case source[j] == '\\':
+			j++
+		case strings.HasPrefix(source[j:], quote):
+			return j + len(quote)
+		case source[j] == '\n' && len(quote) == 1:
+			return j
+		}
+	}
+	return len(source)
+}
+
+func isPythonNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// StdlibModules returns the top level modules of the Python standard library.
+func StdlibModules() map[string]bool {
+	modules := make(map[string]bool)
+	for _, line := range strings.Split(string(box.Get("/python/stdlib_modules.txt")), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			modules[line] = true
+		}
+	}
+	return modules
+}
+
+// DefaultImportMap returns the table bundled with SAME that maps modules to the distributions providing them.
+func DefaultImportMap() (map[string]string, error) {
+	importMap := make(map[string]string)
+	if err := yaml.Unmarshal(box.Get("/python/import_map.yaml"), &importMap); err != nil {
+		return nil, fmt.Errorf("could not read the bundled import map: %v", err)
+	}
+	return importMap, nil
+}
+
+// ImportMapFor returns the import map for a SAME program: the bundled table, with the modules found next to
+// the pipeline source (which are copied into the pipeline, so are never installed), and the SAME file's
+// 'import_map' on top.
+func ImportMapFor(sameConfigFile loaders.SameConfig, sourceDir string) (map[string]string, error) {
+	importMap, err := DefaultImportMap()
+	if err != nil {
+		return nil, err
+	}
+
+	localModules, err := LocalModules(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, module := range localModules {
+		importMap[module] = ""
+	}
+
+	for module, distribution := range sameConfigFile.Spec.ImportMap {
+		importMap[module] = distribution
+	}
+	return importMap, nil
+}
+
+// LocalModules returns the top level modules in a directory: its .py files, and the directories holding an
+// __init__.py.
+func LocalModules(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the local modules in %v: %v", dir, err)
+	}
+	modules := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			if _, err := os.Stat(filepath.Join(dir, entry.Name(), "__init__.py")); err == nil {
+				modules = append(modules, entry.Name())
+			}
+		} else if strings.HasSuffix(entry.Name(), ".py") {
+			modules = append(modules, strings.TrimSuffix(entry.Name(), ".py"))
+		}
+	}
+	return modules, nil
+}
+
+// FindDistributions returns the distributions to install for the modules Python source imports, sorted, and
+// the imported modules that are neither in the standard library nor in the import map.
+func FindDistributions(source string, importMap map[string]string) (distributions []string, unknownModules []string) {
+	stdlibModules := StdlibModules()
+	distributions = make([]string, 0)
+	unknownModules = make([]string, 0)
+	for _, module := range FindImports(source) {
+		if stdlibModules[module] {
+			continue
+		}
+		distribution, known := importMap[module]
+		if !known {
+			unknownModules = append(unknownModules, module)
+		} else if distribution != "" && !ContainsString(distributions, distribution) {
+			distributions = append(distributions, distribution)
+		}
+	}
+	sort.Strings(distributions)
+	sort.Strings(unknownModules)
+	return distributions, unknownModules
+}
@@ -15,14 +15,21 @@ package utils
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
 	gogetter "github.com/hashicorp/go-getter"
 	"github.com/mitchellh/go-homedir"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
@@ -237,6 +244,59 @@ func (k *k8sClient) GetVersion() (string, error) {
 	return version.String(), nil
 }
 
+// ApplySecret creates an Opaque secret, or replaces its data if it already exists.
+func (k *k8sClient) ApplySecret(namespace string, name string, data map[string]string) error {
+	return k.applySecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: data,
+	})
+}
+
+// ApplyImagePullSecret creates a secret to pull images from a private registry with, or replaces it if it
+// already exists.
+func (k *k8sClient) ApplyImagePullSecret(namespace string, name string, credentials loaders.RepositoryCredentials) error {
+	dockerConfig, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			credentials.Server: map[string]string{
+				"username": credentials.Username,
+				"password": credentials.Password,
+				"email":    credentials.Email,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password)),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal the credentials of secret '%v': %v", name, err)
+	}
+	return k.applySecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig},
+	})
+}
+
+func (k *k8sClient) applySecret(secret *corev1.Secret) error {
+	secrets := k.clientset.CoreV1().Secrets(secret.Namespace)
+	_, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(context.TODO(), secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("could not create secret '%v' in namespace '%v': %v", secret.Name, secret.Namespace, err)
+	}
+	return nil
+}
+
+// PodLogs returns the logs of a container of a pod.
+func (k *k8sClient) PodLogs(namespace string, podName string, container string) (string, error) {
+	logBytes, err := k.clientset.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{Container: container}).DoRaw(context.TODO())
+	if err != nil {
+		return "", fmt.Errorf("could not get the logs of pod '%v' in namespace '%v': %v", podName, namespace, err)
+	}
+	return string(logBytes), nil
+}
+
 func GetKubeConfig() (string, error) {
 	kubeConfigPath, err := findKubeConfig()
 	if err != nil {
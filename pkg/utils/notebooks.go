@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Types a notebook cell can have
+const (
+	CellTypeCode     = "code"
+	CellTypeMarkdown = "markdown"
+	CellTypeRaw      = "raw"
+)
+
+// NotebookCell is a single cell of a notebook, with its source as it is written in the notebook.
+type NotebookCell struct {
+	CellType string
+	Source   string
+	Tags     []string
+	Metadata map[string]interface{}
+}
+
+// notebookSource is the source of a cell, which nbformat allows to be a single string or a list of lines.
+type notebookSource string
+
+func (s *notebookSource) UnmarshalJSON(data []byte) error {
+	var lines []string
+	if err := json.Unmarshal(data, &lines); err == nil {
+		*s = notebookSource(strings.Join(lines, ""))
+		return nil
+	}
+	var source string
+	if err := json.Unmarshal(data, &source); err != nil {
+		return fmt.Errorf("cell source must be a string or a list of strings")
+	}
+	*s = notebookSource(source)
+	return nil
+}
+
+type notebookFile struct {
+	NBFormat      int `json:"nbformat"`
+	NBFormatMinor int `json:"nbformat_minor"`
+	Cells         []struct {
+		CellType string                 `json:"cell_type"`
+		Source   notebookSource         `json:"source"`
+		Metadata map[string]interface{} `json:"metadata"`
+	} `json:"cells"`
+}
+
+// ReadNotebook reads the cells of an .ipynb file.
+func ReadNotebook(notebookFilePath string) ([]NotebookCell, error) {
+	log.Infof("Using notebook from here: %v\n", notebookFilePath)
+	notebookBytes, err := ioutil.ReadFile(notebookFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading from notebook file %v: %v", notebookFilePath, err)
+	}
+
+	cells, err := ParseNotebook(notebookBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the notebook %v: %v", notebookFilePath, err)
+	}
+	return cells, nil
+}
+
+// ParseNotebook parses the cells out of a notebook in the nbformat v4 JSON format. The tags of each cell are
+// read from its metadata.
+func ParseNotebook(notebookBytes []byte) ([]NotebookCell, error) {
+	var notebook notebookFile
+	if err := json.Unmarshal(notebookBytes, &notebook); err != nil {
+		return nil, fmt.Errorf("not a valid notebook: %v", err)
+	}
+	if notebook.NBFormat != 4 {
+		return nil, fmt.Errorf("only nbformat 4 notebooks are supported, found nbformat %v (re-save the notebook with a current version of Jupyter)", notebook.NBFormat)
+	}
+
+	cells := make([]NotebookCell, 0, len(notebook.Cells))
+	for i, cell := range notebook.Cells {
+		thisCell := NotebookCell{
+			CellType: cell.CellType,
+			Source:   string(cell.Source),
+		}
+		if err := thisCell.setMetadata(cell.Metadata); err != nil {
+			return nil, fmt.Errorf("cell %v: metadata.%v", i, err)
+		}
+		cells = append(cells, thisCell)
+	}
+	return cells, nil
+}
+
+// PythonSource is the code of a cell as it can run outside of IPython. IPython magics and shell escapes
+// (lines starting with '%' or '!') are commented out, as is the whole of a cell starting with a cell magic
+// (e.g. '%%bash'), whose body isn't Python.
+func (c NotebookCell) PythonSource() string {
+	if c.CellType != CellTypeCode {
+		return ""
+	}
+
+	lines := strings.Split(c.Source, "\n")
+	cellMagic := strings.HasPrefix(strings.TrimSpace(c.Source), "%%")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if cellMagic && trimmed != "" || strings.HasPrefix(trimmed, "%") || strings.HasPrefix(trimmed, "!") {
+			lines[i] = "# " + line
+		}
+	}
+	source := strings.Join(lines, "\n")
+	if source != "" && !strings.HasSuffix(source, "\n") {
+		source += "\n"
+	}
+	return source
+}
+
+// setMetadata sets the metadata of a cell, reading its tags.
+func (c *NotebookCell) setMetadata(metadata map[string]interface{}) error {
+	c.Metadata = metadata
+	rawTags, ok := metadata["tags"]
+	if !ok {
+		return nil
+	}
+	tagList, ok := rawTags.([]interface{})
+	if !ok {
+		return fmt.Errorf("tags must be a list of strings")
+	}
+	for _, rawTag := range tagList {
+		tag, ok := rawTag.(string)
+		if !ok {
+			return fmt.Errorf("tags must be a list of strings, found: %v", rawTag)
+		}
+		c.Tags = append(c.Tags, tag)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
+
+// RunParameter is a parameter of the generated pipeline. Every parameter crosses the workflow boundary as a
+// string, and Type tells the step how to decode it. Default is the encoded default as a Python string literal.
+type RunParameter struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default"`
+}
+
+// RunParameters lists the parameters of the pipeline generated for a SAME file, in order: every declared
+// parameter and every parameter set by the run. Defaults come from the run, then from the declaration.
+func RunParameters(sameConfigFile loaders.SameConfig) []RunParameter {
+	runParameters := encodedRunParameters(sameConfigFile)
+	for i := range runParameters {
+		runParameters[i].Default = pythonStringLiteral(runParameters[i].Default)
+	}
+	return runParameters
+}
+
+// encodedRunParameters lists the parameters like RunParameters, with their defaults encoded as the strings
+// they cross the workflow boundary as.
+func encodedRunParameters(sameConfigFile loaders.SameConfig) []RunParameter {
+	spec := sameConfigFile.Spec
+	names := spec.ParameterNames()
+	for name := range spec.Run.Parameters {
+		names = AppendIfMissing(names, name)
+	}
+	sort.Strings(names)
+
+	runParameters := make([]RunParameter, 0, len(names))
+	for _, name := range names {
+		parameter, declared := spec.Parameters[name]
+		value, set := spec.Run.Parameters[name]
+		if !set {
+			value = parameter.Default
+		}
+
+		parameterType := parameter.ParameterType()
+		if !declared {
+			parameterType = loaders.InferParameterType(value)
+		}
+
+		encoded := ""
+		if value != nil {
+			encoded = loaders.EncodeParameterValue(value)
+		}
+		runParameters = append(runParameters, RunParameter{Name: name, Type: parameterType, Default: encoded})
+	}
+	return runParameters
+}
+
+func pythonStringLiteral(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`).Replace(s) + "'"
+}
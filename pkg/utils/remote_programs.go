@@ -0,0 +1,261 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	netUrl "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/bgentry/go-netrc/netrc"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	gogetter "github.com/hashicorp/go-getter"
+	"github.com/mitchellh/go-homedir"
+	log "github.com/sirupsen/logrus"
+)
+
+// RemoteProgram is a SAME program fetched from a remote source, along with the rest of the tree it came from.
+type RemoteProgram struct {
+	// Source is the normalized go-getter source of the tree (without the subdirectory or any credentials)
+	Source string
+	// Dir is the local copy of the tree in the cache
+	Dir string
+	// ConfigFilePath is the SAME file inside Dir
+	ConfigFilePath string
+	// Cached is true if Dir was already in the cache and nothing was downloaded
+	Cached bool
+}
+
+// gitHostingRegex matches https URLs on the common git hosts that are missing the '//' go-getter uses to
+// separate the repository from the path inside it, e.g. https://github.com/org/repo/subdir/same.yaml.
+var gitHostingRegex = regexp.MustCompile(`^(?:git::)?https://(github\.com|gitlab\.com|bitbucket\.org)/([^/?]+)/([^/?]+?)(?:\.git)?(/[^?]*)?(\?.*)?$`)
+
+// DefaultProgramCacheDir is where remote programs are cached, ~/.same/cache.
+func DefaultProgramCacheDir() (string, error) {
+	return homedir.Expand(filepath.Join("~", ".same", "cache"))
+}
+
+// FetchRemoteProgram downloads the tree holding a remote SAME file into a directory under cacheRoot, so the
+// notebook and any files it references are available next to it. source is anything go-getter understands,
+// with the path to the SAME file inside the tree after '//', e.g.
+//
+//	git::https://github.com/org/repo//subdir/custom.yaml?ref=v1.2
+//
+// If the path doesn't end in .yaml or .yml it is a directory holding a same.yaml. The cache is keyed on the
+// source (including the ref), so later fetches of the same source reuse the tree; delete the directory to
+// fetch it again. Private git repositories are accessed with token, if it is set, or the credentials for the
+// host in ~/.netrc (or the file named by $NETRC). Credentials are never written to the cache or the log.
+func FetchRemoteProgram(source string, cacheRoot string, token string) (*RemoteProgram, error) {
+	treeSource, subdir := gogetter.SourceDirSubdir(normalizeRemoteSource(source))
+	if strings.Contains(subdir, "*") {
+		return nil, fmt.Errorf("globs are not supported in the path to a remote SAME file: %v", subdir)
+	}
+
+	fileInTree := filepath.FromSlash(subdir)
+	if ext := strings.ToLower(filepath.Ext(fileInTree)); ext != ".yaml" && ext != ".yml" {
+		fileInTree = filepath.Join(fileInTree, "same.yaml")
+	}
+	fileInTree = filepath.Clean(fileInTree)
+	if filepath.IsAbs(fileInTree) || strings.HasPrefix(fileInTree, "..") {
+		return nil, fmt.Errorf("the path to the SAME file must be inside the fetched tree, found: %v", subdir)
+	}
+
+	sum := sha256.Sum256([]byte(sourceWithoutCredentials(treeSource)))
+	program := &RemoteProgram{
+		Source: sourceWithoutCredentials(treeSource),
+		Dir:    filepath.Join(cacheRoot, hex.EncodeToString(sum[:])[:16]),
+	}
+	program.ConfigFilePath = filepath.Join(program.Dir, fileInTree)
+
+	if info, err := os.Stat(program.Dir); err == nil && info.IsDir() {
+		log.Infof("Using the copy of %v cached in %v (delete it to fetch it again)", program.Source, program.Dir)
+		program.Cached = true
+	} else {
+		if err := fetchTree(treeSource, cacheRoot, program.Dir, token); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := os.Stat(program.ConfigFilePath); err != nil {
+		return nil, fmt.Errorf("could not find the SAME file '%v' in %v", fileInTree, program.Source)
+	}
+	return program, nil
+}
+
+// fetchTree downloads source into dir. source may carry credentials, which are kept out of the log. The tree is fetched next to dir and moved into place once it is
+// complete, so an interrupted fetch never leaves a partial tree in the cache.
+func fetchTree(source string, cacheRoot string, dir string, token string) error {
+	if err := os.MkdirAll(cacheRoot, 0700); err != nil {
+		return fmt.Errorf("could not create the cache directory %v: %v", cacheRoot, err)
+	}
+	tempDir, err := ioutil.TempDir(cacheRoot, "fetch-*")
+	if err != nil {
+		return fmt.Errorf("could not create a temporary directory in %v: %v", cacheRoot, err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	authenticatedSource, err := withGitCredentials(source, token)
+	if err != nil {
+		return err
+	}
+	source = sourceWithoutCredentials(source)
+
+	pwd, _ := os.Getwd()
+	treeDir := filepath.Join(tempDir, "tree")
+	log.Infof("Downloading %v to %v", source, dir)
+	client := &gogetter.Client{
+		Src:  authenticatedSource,
+		Dst:  treeDir,
+		Pwd:  pwd,
+		Mode: gogetter.ClientModeDir,
+	}
+	if err := client.Get(); err != nil {
+		// go-getter errors can include the source, credentials and all
+		message := err.Error()
+		if authenticatedSource != source {
+			message = strings.ReplaceAll(message, authenticatedSource, source)
+			if parsed, parseErr := netUrl.Parse(strings.TrimPrefix(authenticatedSource, "git::")); parseErr == nil && parsed.User != nil {
+				message = strings.ReplaceAll(message, parsed.User.String(), "<credentials>")
+			}
+		}
+		return fmt.Errorf("could not download '%v': %v", source, message)
+	}
+
+	if authenticatedSource != source {
+		if err := resetOriginURL(treeDir, source); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(treeDir, dir); err != nil {
+		return fmt.Errorf("could not move the downloaded tree into the cache at %v: %v", dir, err)
+	}
+	return nil
+}
+
+// normalizeRemoteSource turns the https URLs of files on github.com, gitlab.com and bitbucket.org into
+// go-getter git sources, e.g. https://github.com/org/repo/subdir becomes
+// git::https://github.com/org/repo.git//subdir. Other sources are returned as they are.
+func normalizeRemoteSource(source string) string {
+	if _, subdir := gogetter.SourceDirSubdir(source); subdir != "" {
+		return ensureGitForced(source)
+	}
+	match := gitHostingRegex.FindStringSubmatch(source)
+	if match == nil {
+		return ensureGitForced(source)
+	}
+
+	normalized := fmt.Sprintf("git::https://%v/%v/%v.git", match[1], match[2], match[3])
+	if pathInRepo := strings.Trim(match[4], "/"); pathInRepo != "" {
+		normalized += "//" + pathInRepo
+	}
+	return normalized + match[5]
+}
+
+// ensureGitForced prefixes http(s) sources with 'git::', as the SAME CLI only fetches http(s) programs from git.
+func ensureGitForced(source string) string {
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		return "git::" + source
+	}
+	return source
+}
+
+// sourceWithoutCredentials drops any user and password from an http(s) source, keeping a forced getter.
+func sourceWithoutCredentials(source string) string {
+	forcedGetter := ""
+	if i := strings.Index(source, "::"); i >= 0 {
+		forcedGetter, source = source[:i+2], source[i+2:]
+	}
+	return forcedGetter + withoutCredentials(source)
+}
+
+// withGitCredentials adds token, or the credentials from netrc, to a git::http(s) source that doesn't
+// already carry credentials.
+func withGitCredentials(source string, token string) (string, error) {
+	if !strings.HasPrefix(source, "git::http://") && !strings.HasPrefix(source, "git::https://") {
+		return source, nil
+	}
+	parsed, err := netUrl.Parse(strings.TrimPrefix(source, "git::"))
+	if err != nil {
+		return "", fmt.Errorf("could not parse '%v': %v", source, err)
+	}
+	if parsed.User != nil {
+		return source, nil
+	}
+
+	if token != "" {
+		parsed.User = netUrl.UserPassword("oauth2", token)
+		return "git::" + parsed.String(), nil
+	}
+
+	machine, err := netrcMachine(parsed.Hostname())
+	if err != nil {
+		return "", err
+	}
+	if machine == nil {
+		return source, nil
+	}
+	parsed.User = netUrl.UserPassword(machine.Login, machine.Password)
+	return "git::" + parsed.String(), nil
+}
+
+// netrcMachine returns the entry for host in the netrc file, or nil if there isn't one.
+func netrcMachine(host string) (*netrc.Machine, error) {
+	netrcPath := os.Getenv("NETRC")
+	if netrcPath == "" {
+		fileName := ".netrc"
+		if runtime.GOOS == "windows" {
+			fileName = "_netrc"
+		}
+		var err error
+		if netrcPath, err = homedir.Expand(filepath.Join("~", fileName)); err != nil {
+			return nil, nil
+		}
+	}
+
+	if info, err := os.Stat(netrcPath); err != nil || info.IsDir() {
+		return nil, nil
+	}
+	parsed, err := netrc.ParseFile(netrcPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the netrc file at %v: %v", netrcPath, err)
+	}
+	machine := parsed.FindMachine(host)
+	if machine == nil || machine.IsDefault() && machine.Login == "" {
+		return nil, nil
+	}
+	return machine, nil
+}
+
+// resetOriginURL points the origin of a cloned repository back at the URL without credentials, so they
+// aren't left behind in the cache.
+func resetOriginURL(dir string, source string) error {
+	repo, err := git.PlainOpen(dir)
+	if err == git.ErrRepositoryNotExists {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not open the downloaded repository in %v: %v", dir, err)
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return fmt.Errorf("could not read the configuration of the downloaded repository: %v", err)
+	}
+	if remote, ok := cfg.Remotes["origin"]; ok {
+		cleanURL := strings.TrimPrefix(source, "git::")
+		if i := strings.Index(cleanURL, "?"); i >= 0 {
+			cleanURL = cleanURL[:i]
+		}
+		cfg.Remotes["origin"] = &gitconfig.RemoteConfig{Name: remote.Name, URLs: []string{cleanURL}, Fetch: remote.Fetch}
+		if err := repo.Storer.SetConfig(cfg); err != nil {
+			return fmt.Errorf("could not update the configuration of the downloaded repository: %v", err)
+		}
+	}
+	return nil
+}
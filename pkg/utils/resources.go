@@ -0,0 +1,207 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+)
+
+var (
+	// cpuQuantityRegex matches Kubernetes CPU quantities, e.g. '2', '0.5' or '500m'
+	cpuQuantityRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)(m?)$`)
+	// memoryQuantityRegex matches Kubernetes memory quantities, e.g. '8Gi', '512Mi' or '1G'
+	memoryQuantityRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)(Ki|Mi|Gi|Ti|Pi|k|M|G|T|P)?$`)
+)
+
+// memoryUnits are the sizes of the memory quantity suffixes, in bytes
+var memoryUnits = map[string]float64{
+	"":   1,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+}
+
+// DefaultGPUNodeSelectorKey is the node label a bare gpu_type (e.g. 'gpu_type=nvidia-tesla-k80') selects nodes by.
+// A gpu_type of the form 'label=value' selects by that label instead.
+const DefaultGPUNodeSelectorKey = "accelerator"
+
+// setStepResource sets one of the resources of a step from a cell tag, e.g. 'cpu=2'. It returns false if the
+// tag doesn't set a resource.
+func setStepResource(resources *loaders.StepResources, tag string) (bool, error) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) != 2 {
+		return false, nil
+	}
+	name, value := parts[0], strings.TrimSpace(parts[1])
+	switch name {
+	case "cpu":
+		resources.CPU = value
+	case "memory":
+		resources.Memory = value
+	case "gpu":
+		resources.GPU = value
+	case "gpu_type":
+		resources.GPUType = value
+	default:
+		return false, nil
+	}
+	return true, validateStepResources(*resources)
+}
+
+// mergeStepResources sets every resource of base that is set in override.
+func mergeStepResources(base loaders.StepResources, override loaders.StepResources) loaders.StepResources {
+	base.CPU = ValueOrDefault(override.CPU, base.CPU)
+	base.Memory = ValueOrDefault(override.Memory, base.Memory)
+	base.GPU = ValueOrDefault(override.GPU, base.GPU)
+	base.GPUType = ValueOrDefault(override.GPUType, base.GPUType)
+	return base
+}
+
+// validateStepResources checks that the resources are quantities Kubernetes understands.
+func validateStepResources(resources loaders.StepResources) error {
+	if resources.CPU != "" && !cpuQuantityRegex.MatchString(resources.CPU) {
+		return fmt.Errorf("cpu must be a number of cores (e.g. '2') or millicores (e.g. '500m'), found: %v", resources.CPU)
+	}
+	if resources.Memory != "" && !memoryQuantityRegex.MatchString(resources.Memory) {
+		return fmt.Errorf("memory must be a size with an optional unit (e.g. '8Gi' or '512Mi'), found: %v", resources.Memory)
+	}
+	if resources.GPU != "" {
+		if gpus, err := strconv.Atoi(resources.GPU); err != nil || gpus < 0 {
+			return fmt.Errorf("gpu must be a whole number of GPUs, found: %v", resources.GPU)
+		}
+	}
+	return nil
+}
+
+// ResolveStepResources works out the resources a step runs with: the resources set by its tags, then the
+// resources of its environment in the SAME file. A step asking for a GPU type gets one GPU unless it says
+// otherwise, and a step asking for GPUs without a type gets the type in the SAME file's resources, if any.
+func ResolveStepResources(codeBlock CodeBlock, sameConfigFile loaders.SameConfig) (loaders.StepResources, error) {
+	environmentResources := sameConfigFile.Spec.Environments[codeBlock.EnvironmentName].Resources
+	if err := validateStepResources(environmentResources); err != nil {
+		return loaders.StepResources{}, fmt.Errorf("environment %v: resources: %v", codeBlock.EnvironmentName, err)
+	}
+
+	resources := mergeStepResources(environmentResources, codeBlock.Resources)
+	if resources.GPUType != "" && resources.GPU == "" {
+		resources.GPU = "1"
+	}
+	if resources.GPU != "" && resources.GPU != "0" && resources.GPUType == "" {
+		resources.GPUType = sameConfigFile.Spec.Resources.GPU.Type
+	}
+	if resources.GPU == "0" {
+		resources.GPU = ""
+		resources.GPUType = ""
+	}
+	return resources, nil
+}
+
+// GPUNodeSelector returns the node label and value that pick nodes with the GPU type, or empty strings if no
+// type is set.
+func GPUNodeSelector(gpuType string) (string, string) {
+	if gpuType == "" {
+		return "", ""
+	}
+	if parts := strings.SplitN(gpuType, "=", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return DefaultGPUNodeSelectorKey, gpuType
+}
+
+// amlVMSize is an AML compute VM size, with the resources of each node.
+type amlVMSize struct {
+	Name     string
+	Cores    float64
+	MemoryGi float64
+	GPUs     int
+	GPUModel string
+}
+
+// amlVMSizes are the VM sizes steps can run on in AML, from the smallest, in the order they are tried. The
+// GPU sizes are ones AML still provisions, from the cheapest GPU model; the K80 (NC) and V100 (NCv3) sizes are
+// retired.
+var amlVMSizes = []amlVMSize{
+	{"Standard_DS2_v2", 2, 7, 0, ""},
+	{"Standard_DS3_v2", 4, 14, 0, ""},
+	{"Standard_DS4_v2", 8, 28, 0, ""},
+	{"Standard_DS5_v2", 16, 56, 0, ""},
+	{"Standard_E16s_v3", 16, 128, 0, ""},
+	{"Standard_E32s_v3", 32, 256, 0, ""},
+	{"Standard_E64s_v3", 64, 432, 0, ""},
+	{"Standard_NC4as_T4_v3", 4, 28, 1, "t4"},
+	{"Standard_NC8as_T4_v3", 8, 56, 1, "t4"},
+	{"Standard_NC16as_T4_v3", 16, 110, 1, "t4"},
+	{"Standard_NC64as_T4_v3", 64, 440, 4, "t4"},
+	{"Standard_NV36ads_A10_v5", 36, 440, 1, "a10"},
+	{"Standard_NV72ads_A10_v5", 72, 880, 2, "a10"},
+	{"Standard_NC24ads_A100_v4", 24, 220, 1, "a100"},
+	{"Standard_NC48ads_A100_v4", 48, 440, 2, "a100"},
+	{"Standard_NC96ads_A100_v4", 96, 880, 4, "a100"},
+	{"Standard_NC40ads_H100_v5", 40, 320, 1, "h100"},
+	{"Standard_NC80adis_H100_v5", 80, 640, 2, "h100"},
+}
+
+// AMLVMSize picks the smallest AML VM size with the resources a step needs. A gpu_type naming a VM size
+// (e.g. 'Standard_NC24ads_A100_v4') is used as is; otherwise it names the GPU model (e.g. 'a100' or
+// 'nvidia-tesla-t4').
+// Steps that don't ask for any resources run on the default compute target, and get "".
+func AMLVMSize(resources loaders.StepResources) (string, error) {
+	if resources == (loaders.StepResources{}) {
+		return "", nil
+	}
+	if strings.HasPrefix(strings.ToLower(resources.GPUType), "standard_") {
+		return resources.GPUType, nil
+	}
+
+	cores, memoryGi, gpus := 0.0, 0.0, 0
+	if match := cpuQuantityRegex.FindStringSubmatch(resources.CPU); match != nil {
+		cores, _ = strconv.ParseFloat(match[1], 64)
+		if match[2] == "m" {
+			cores /= 1000
+		}
+	}
+	if match := memoryQuantityRegex.FindStringSubmatch(resources.Memory); match != nil {
+		memoryGi, _ = strconv.ParseFloat(match[1], 64)
+		memoryGi = memoryGi * memoryUnits[match[2]] / (1 << 30)
+	}
+	if resources.GPU != "" {
+		gpus, _ = strconv.Atoi(resources.GPU)
+	}
+	_, gpuModel := GPUNodeSelector(strings.ToLower(resources.GPUType))
+
+	for _, size := range amlVMSizes {
+		if size.Cores < cores || size.MemoryGi < memoryGi || size.GPUs < gpus || (gpus == 0) != (size.GPUs == 0) {
+			continue
+		}
+		// The model is one of the words of the type, so 'a100' doesn't pick an A10
+		if gpuModel != "" && !ContainsString(strings.FieldsFunc(gpuModel, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }), size.GPUModel) {
+			continue
+		}
+		return size.Name, nil
+	}
+	if resources.GPUType != "" {
+		return "", fmt.Errorf("no AML VM size has %v cores, %vGi of memory and %v GPUs of type %v", cores, memoryGi, gpus, resources.GPUType)
+	}
+	return "", fmt.Errorf("no AML VM size has %v cores, %vGi of memory and %v GPUs", cores, memoryGi, gpus)
+}
+
+// amlComputeName is the name of the compute target created for a VM size. AML compute names are at most 16
+// characters of letters, digits and hyphens.
+func amlComputeName(vmSize string) string {
+	name := "same" + strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(vmSize, "Standard_"), "standard_")), "_", "-")
+	if len(name) > 16 {
+		name = name[:16]
+	}
+	return strings.TrimRight(name, "-")
+}
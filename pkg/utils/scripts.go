@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Formats a pipeline source can be written in
+const (
+	SourceFormatNotebook = "ipynb"
+	SourceFormatPercent  = "py:percent"
+	SourceFormatLight    = "py:light"
+)
+
+var (
+	// percentMarkerRegex matches the start of a cell in the percent format, e.g. '# %% [markdown] tags=["a"]'
+	percentMarkerRegex = regexp.MustCompile(`^# %%(?:\s+\[(\w+)\])?(.*)$`)
+	// lightStartRegex and lightEndRegex match the explicit start and end of a cell in the light format
+	lightStartRegex = regexp.MustCompile(`^# \+(\s.*)?$`)
+	lightEndRegex   = regexp.MustCompile(`^# -\s*$`)
+	// headerFormatRegex reads the format from the jupytext header of a script
+	headerFormatRegex = regexp.MustCompile(`(?m)^#\s+format_name:\s*['"]?(\w+)['"]?\s*$`)
+	// cellMetadataKeyRegex matches the keys in the options of a cell marker, e.g. 'tags=' in '# %% tags=["a"]'
+	cellMetadataKeyRegex = regexp.MustCompile(`(?:^|\s)([A-Za-z_][\w.-]*)=`)
+)
+
+// DetectSourceFormat works out the format of a pipeline source from its contents and file name: .ipynb
+// files are notebooks; scripts take the format named in their jupytext header, then the one named by a
+// .pct.py or .lgt.py extension, then the one whose cell markers they use ('# %%' for percent, '# +' for
+// light). A .py file without any of these is not a notebook - it is a KFP pipeline, and "" is returned.
+func DetectSourceFormat(filePath string, contents []byte) (string, error) {
+	lowerPath := strings.ToLower(filePath)
+	switch {
+	case strings.HasSuffix(lowerPath, ".ipynb"):
+		return SourceFormatNotebook, nil
+	case !strings.HasSuffix(lowerPath, ".py"):
+		return "", fmt.Errorf("unknown pipeline source '%v', expected an .ipynb notebook or a .py script", filePath)
+	}
+
+	header, _ := splitScriptHeader(string(contents))
+	if match := headerFormatRegex.FindStringSubmatch(header); match != nil {
+		switch match[1] {
+		case "percent":
+			return SourceFormatPercent, nil
+		case "light":
+			return SourceFormatLight, nil
+		default:
+			return "", fmt.Errorf("%v is in the jupytext '%v' format, only the percent and light formats are supported", filePath, match[1])
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(lowerPath, ".pct.py"):
+		return SourceFormatPercent, nil
+	case strings.HasSuffix(lowerPath, ".lgt.py"):
+		return SourceFormatLight, nil
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if percentMarkerRegex.MatchString(line) {
+			return SourceFormatPercent, nil
+		}
+		if lightStartRegex.MatchString(line) {
+			return SourceFormatLight, nil
+		}
+	}
+	return "", nil
+}
+
+// IsNotebookSource reports whether the pipeline source at filePath is a notebook (or a script in one of the
+// notebook formats) that has to be compiled, rather than a KFP pipeline.
+func IsNotebookSource(filePath string) bool {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return strings.HasSuffix(strings.ToLower(filePath), ".ipynb")
+	}
+	format, err := DetectSourceFormat(filePath, contents)
+	return err == nil && format != ""
+}
+
+// ReadPipelineSource reads the cells of a notebook, or of a script in the percent or light formats.
+func ReadPipelineSource(filePath string) ([]NotebookCell, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading from pipeline source %v: %v", filePath, err)
+	}
+	format, err := DetectSourceFormat(filePath, contents)
+	if err != nil {
+		return nil, err
+	}
+	if format == "" {
+		return nil, fmt.Errorf("%v has no cell markers ('# %%%%' or '# +') or jupytext header, so it can't be split into steps", filepath.Base(filePath))
+	}
+
+	var cells []NotebookCell
+	switch format {
+	case SourceFormatNotebook:
+		return ReadNotebook(filePath)
+	case SourceFormatPercent:
+		cells, err = ParsePercentScript(string(contents))
+	case SourceFormatLight:
+		cells, err = ParseLightScript(string(contents))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read %v as a %v script: %v", filePath, format, err)
+	}
+	log.Infof("Using %v script from here: %v\n", format, filePath)
+	return cells, nil
+}
+
+// ParsePercentScript parses a script in the jupytext percent format, where every cell starts with a '# %%'
+// line, optionally followed by the cell type and its metadata, e.g. '# %% [markdown]' or
+// '# %% tags=["same_step_1"]'. Code before the first marker is a cell of its own.
+func ParsePercentScript(script string) ([]NotebookCell, error) {
+	header, body := splitScriptHeader(script)
+	// Line numbers in errors count the header too
+	headerLines := strings.Count(header, "\n")
+
+	cells := make([]NotebookCell, 0)
+	current := NotebookCell{CellType: CellTypeCode}
+	lines := make([]string, 0)
+	flush := func() {
+		current.Source = strings.Trim(strings.Join(lines, "\n"), "\n")
+		if current.Source != "" || len(current.Tags) > 0 {
+			cells = append(cells, current)
+		}
+	}
+
+	for i, line := range strings.Split(body, "\n") {
+		line = strings.TrimRight(line, "\r")
+		match := percentMarkerRegex.FindStringSubmatch(line)
+		if match == nil {
+			lines = append(lines, line)
+			continue
+		}
+
+		flush()
+		lines = make([]string, 0)
+		current = NotebookCell{CellType: CellTypeCode}
+		switch match[1] {
+		case "", "code":
+		case "markdown", "md":
+			current.CellType = CellTypeMarkdown
+		case "raw":
+			current.CellType = CellTypeRaw
+		default:
+			return nil, fmt.Errorf("line %v: unknown cell type '%v'", headerLines+i+1, match[1])
+		}
+
+		metadata, err := parseCellMetadata(match[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", headerLines+i+1, err)
+		}
+		if err := current.setMetadata(metadata); err != nil {
+			return nil, fmt.Errorf("line %v: %v", headerLines+i+1, err)
+		}
+	}
+	flush()
+
+	return cells, nil
+}
+
+// ParseLightScript parses a script in the jupytext light format, where cells with metadata (or more than one
+// paragraph) are wrapped in '# +' and '# -' lines, e.g. '# + tags=["same_step_1"]'. Everything else is code,
+// with a new cell starting after each explicit cell.
+func ParseLightScript(script string) ([]NotebookCell, error) {
+	header, body := splitScriptHeader(script)
+	// Line numbers in errors count the header too
+	headerLines := strings.Count(header, "\n")
+
+	cells := make([]NotebookCell, 0)
+	current := NotebookCell{CellType: CellTypeCode}
+	lines := make([]string, 0)
+	flush := func() {
+		current.Source = strings.Trim(strings.Join(lines, "\n"), "\n")
+		if current.Source != "" || len(current.Tags) > 0 {
+			cells = append(cells, current)
+		}
+		lines = make([]string, 0)
+		current = NotebookCell{CellType: CellTypeCode}
+	}
+
+	for i, line := range strings.Split(body, "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case lightStartRegex.MatchString(line):
+			flush()
+			metadata, err := parseCellMetadata(strings.TrimPrefix(line, "# +"))
+			if err != nil {
+				return nil, fmt.Errorf("line %v: %v", headerLines+i+1, err)
+			}
+			if err := current.setMetadata(metadata); err != nil {
+				return nil, fmt.Errorf("line %v: %v", headerLines+i+1, err)
+			}
+		case lightEndRegex.MatchString(line):
+			flush()
+		default:
+			lines = append(lines, line)
+		}
+	}
+	flush()
+
+	return cells, nil
+}
+
+// splitScriptHeader splits the jupytext header (the comment block between two '# ---' lines at the top of
+// a script) from the rest of the script.
+func splitScriptHeader(script string) (string, string) {
+	lines := strings.SplitAfter(script, "\n")
+	start := 0
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	if start == len(lines) || strings.TrimSpace(lines[start]) != "# ---" {
+		return "", script
+	}
+	for end := start + 1; end < len(lines); end++ {
+		trimmed := strings.TrimSpace(lines[end])
+		if trimmed == "# ---" {
+			return strings.Join(lines[start:end+1], ""), strings.Join(lines[end+1:], "")
+		}
+		if !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+	}
+	return "", script
+}
+
+// parseCellMetadata parses the options of a cell marker, 'key=value' pairs whose values are JSON, as jupytext
+// writes them (e.g. 'tags=["same_step_1", "cache=P1D"] slideshow={"slide_type": "-"}'). Anything before the
+// first option is the title of the cell, and is ignored.
+func parseCellMetadata(options string) (map[string]interface{}, error) {
+	metadata := make(map[string]interface{})
+	rest := options
+	for {
+		match := cellMetadataKeyRegex.FindStringSubmatchIndex(rest)
+		if match == nil {
+			return metadata, nil
+		}
+		key := rest[match[2]:match[3]]
+		decoder := json.NewDecoder(strings.NewReader(rest[match[1]:]))
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, fmt.Errorf("the value of '%v' in the cell marker is not valid JSON: %v", key, err)
+		}
+		metadata[key] = value
+		rest = rest[match[1]+int(decoder.InputOffset()):]
+	}
+}
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SourceMapFileName is the file in a compiled program mapping the lines each step runs back to the notebook
+const SourceMapFileName = "same-source-map.json"
+
+// sourceMapMarker starts the comment each step file carries its own source map in, so the source map of a run
+// can be read back from the program it ran
+const sourceMapMarker = "# SAME source map: "
+
+var (
+	// sourceMapCommentRegex matches the comment a step file carries its source map in
+	sourceMapCommentRegex = regexp.MustCompile(`(?m)^[ \t]*` + regexp.QuoteMeta(sourceMapMarker) + `(\{.*\})[ \t]*\r?$`)
+	// tracebackFrameRegex matches a frame of a Python traceback in a step's code, which runs as a file named
+	// after the step, e.g. '  File "<same_step_1>", line 12, in <module>'
+	tracebackFrameRegex = regexp.MustCompile(`(?m)^([ \t]*)File "<(\w+)>", line (\d+)(.*)$`)
+)
+
+// SourceCell is a run of lines of a step's code that came from one cell of the notebook
+type SourceCell struct {
+	// Cell is the position of the cell in the notebook, counting from 1 and including markdown cells, as the
+	// cells are shown in Jupyter
+	Cell int `json:"cell"`
+	// StepLine is the line of the step's code the cell starts at, counting from 1
+	StepLine int `json:"step_line"`
+	Lines    int `json:"lines"`
+}
+
+// StepSourceMap maps the lines of a step's code back to the notebook they came from. The code runs as a file
+// named after the step (e.g. '<same_step_1>'), whose first Offset lines are added by SAME.
+type StepSourceMap struct {
+	Step     string       `json:"step"`
+	Notebook string       `json:"notebook"`
+	Offset   int          `json:"offset"`
+	Cells    []SourceCell `json:"cells"`
+}
+
+// appendSourceCell adds a cell, whose source starts at stepLine of a step's code, to the cells of the step.
+func appendSourceCell(sourceCells []SourceCell, cell int, stepLine int, source string) []SourceCell {
+	return append(sourceCells, SourceCell{Cell: cell, StepLine: stepLine, Lines: strings.Count(source, "\n")})
+}
+
+// NewStepSourceMap returns the source map of a step whose code starts after offset lines of the file it runs as.
+func NewStepSourceMap(codeBlock CodeBlock, offset int) StepSourceMap {
+	sourceCells := codeBlock.SourceCells
+	if sourceCells == nil {
+		sourceCells = make([]SourceCell, 0)
+	}
+	return StepSourceMap{
+		Step:     codeBlock.StepIdentifier,
+		Notebook: codeBlock.SourceFile,
+		Offset:   offset,
+		Cells:    sourceCells,
+	}
+}
+
+// Position returns the cell, and the line in it, that a line of the file the step runs as came from. ok is
+// false for the lines SAME added.
+func (m StepSourceMap) Position(line int) (cell int, cellLine int, ok bool) {
+	stepLine := line - m.Offset
+	for _, sourceCell := range m.Cells {
+		if stepLine >= sourceCell.StepLine && stepLine < sourceCell.StepLine+sourceCell.Lines {
+			return sourceCell.Cell, stepLine - sourceCell.StepLine + 1, true
+		}
+	}
+	return 0, 0, false
+}
+
+// comment returns the comment a step file carries its source map in.
+func (m StepSourceMap) comment() (string, error) {
+	mapBytes, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling the source map of %v: %v", m.Step, err)
+	}
+	return sourceMapMarker + string(mapBytes), nil
+}
+
+// WriteSourceMaps writes the source maps of the steps of a program to its compiled directory.
+func WriteSourceMaps(compiledDir string, sourceMaps []StepSourceMap) error {
+	mapBytes, err := json.MarshalIndent(sourceMaps, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling source maps: %v", err)
+	}
+	sourceMapFile := filepath.Join(compiledDir, SourceMapFileName)
+	if err := ioutil.WriteFile(sourceMapFile, append(mapBytes, '\n'), 0600); err != nil {
+		return fmt.Errorf("error writing source maps to %v: %v", sourceMapFile, err)
+	}
+	return nil
+}
+
+// ReadSourceMaps reads the source maps written to a compiled directory by WriteSourceMaps.
+func ReadSourceMaps(compiledDir string) ([]StepSourceMap, error) {
+	sourceMapFile := filepath.Join(compiledDir, SourceMapFileName)
+	mapBytes, err := ioutil.ReadFile(sourceMapFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read source maps from %v: %v", sourceMapFile, err)
+	}
+	sourceMaps := make([]StepSourceMap, 0)
+	if err := json.Unmarshal(mapBytes, &sourceMaps); err != nil {
+		return nil, fmt.Errorf("could not parse source maps in %v: %v", sourceMapFile, err)
+	}
+	return sourceMaps, nil
+}
+
+// FindSourceMaps returns the source maps carried by the step code in text, e.g. the programs of the templates
+// of a run's workflow. Comments that can't be read are skipped.
+func FindSourceMaps(text string) []StepSourceMap {
+	sourceMaps := make([]StepSourceMap, 0)
+	for _, match := range sourceMapCommentRegex.FindAllStringSubmatch(text, -1) {
+		var sourceMap StepSourceMap
+		if err := json.Unmarshal([]byte(match[1]), &sourceMap); err == nil && sourceMap.Step != "" {
+			sourceMaps = append(sourceMaps, sourceMap)
+		}
+	}
+	return sourceMaps
+}
+
+// RewriteTracebacks rewrites the frames of Python tracebacks that are in the code of a step to point at the
+// notebook cell and line the code came from, e.g. 'File "<same_step_1>", line 12, in <module>' becomes
+// 'File "notebook.ipynb", cell 7, line 3, in <module>'. Frames in code SAME added, or in steps without a
+// source map, are left as they are.
+func RewriteTracebacks(text string, sourceMaps []StepSourceMap) string {
+	sourceMapsBySteps := make(map[string]StepSourceMap, len(sourceMaps))
+	for _, sourceMap := range sourceMaps {
+		sourceMapsBySteps[sourceMap.Step] = sourceMap
+	}
+
+	return tracebackFrameRegex.ReplaceAllStringFunc(text, func(frame string) string {
+		match := tracebackFrameRegex.FindStringSubmatch(frame)
+		sourceMap, found := sourceMapsBySteps[match[2]]
+		if !found {
+			return frame
+		}
+		line, _ := strconv.Atoi(match[3])
+		cell, cellLine, ok := sourceMap.Position(line)
+		if !ok {
+			return frame
+		}
+		notebook := ValueOrDefault(sourceMap.Notebook, "notebook")
+		return fmt.Sprintf("%vFile \"%v\", cell %v, line %v%v", match[1], notebook, cell, cellLine, match[4])
+	})
+}
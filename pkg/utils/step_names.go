@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NamedStepPrefix is put before the name of a step named with a 'step=' or 'same_step=' tag to make its
+// identifier, so the modules and variables generated for it never clash with anything else in the pipeline.
+const NamedStepPrefix = "same_step_"
+
+// stepNameRegex matches the names steps can be given, which end up in the identifiers of the generated code
+var stepNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// unorderedStep is the index of a step that hasn't been given one yet
+const unorderedStep = -1
+
+// IsStepTag reports whether a tag starts a step, e.g. 'same_step_1', 'same_step=load_data' or 'step=train'.
+func IsStepTag(tag string) bool {
+	return strings.HasPrefix(tag, "same_step_") || strings.HasPrefix(tag, "same_step=") || strings.HasPrefix(tag, "step=")
+}
+
+// parseStepTag reads the identifier, display name and index of the step a tag starts. Steps tagged
+// 'same_step_<N>' have the index N; named steps get their index from an 'order=' tag or their place in the
+// notebook, see assignStepIndexes.
+func parseStepTag(tag string) (identifier string, displayName string, index int, err error) {
+	if strings.HasPrefix(tag, "same_step_") {
+		index, err := strconv.Atoi(strings.TrimPrefix(tag, "same_step_"))
+		if err != nil || index < 0 {
+			return "", "", 0, fmt.Errorf("step tags must be 'same_step_' followed by a number, or 'step=' followed by a name, found: %v", tag)
+		}
+		return tag, tag, index, nil
+	}
+
+	name := strings.TrimSpace(tag[strings.Index(tag, "=")+1:])
+	if !stepNameRegex.MatchString(name) {
+		return "", "", 0, fmt.Errorf("step names must start with a letter and only hold letters, digits and underscores, found: %v", tag)
+	}
+	return NamedStepPrefix + name, name, unorderedStep, nil
+}
+
+// parseOrderTag reads the index of an 'order=' tag.
+func parseOrderTag(tag string) (int, error) {
+	index, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(tag, "order=")))
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("order must be a whole number, found: %v", tag)
+	}
+	return index, nil
+}
+
+// assignStepIndexes gives every step one index, which orders the steps: the index in its 'same_step_<N>' or
+// 'order=' tags if it has one, or else the index after the highest one of the steps above it in the notebook.
+// Giving a step two different indexes, or two steps the same index, is an error.
+func assignStepIndexes(foundSteps []FoundStep) error {
+	explicitIndexes := make(map[string]int)
+	for _, foundStep := range foundSteps {
+		if foundStep.Index == unorderedStep {
+			continue
+		}
+		if index, exists := explicitIndexes[foundStep.StepName]; exists && index != foundStep.Index {
+			return fmt.Errorf("step %v has two order indexes, %v and %v", foundStep.DisplayName, index, foundStep.Index)
+		}
+		explicitIndexes[foundStep.StepName] = foundStep.Index
+	}
+
+	indexes := make(map[string]int)
+	highestIndex := unorderedStep
+	for i, foundStep := range foundSteps {
+		index, assigned := indexes[foundStep.StepName]
+		if !assigned {
+			index, assigned = explicitIndexes[foundStep.StepName]
+			if !assigned {
+				index = highestIndex + 1
+			}
+			indexes[foundStep.StepName] = index
+		}
+		if index > highestIndex {
+			highestIndex = index
+		}
+		foundSteps[i].Index = index
+	}
+
+	stepsByIndex := make(map[int]string)
+	displayNames := make(map[string]string)
+	for _, foundStep := range foundSteps {
+		displayNames[foundStep.StepName] = foundStep.DisplayName
+		if other, exists := stepsByIndex[foundStep.Index]; exists && other != foundStep.StepName {
+			return fmt.Errorf("steps %v and %v both have the order index %v", displayNames[other], foundStep.DisplayName, foundStep.Index)
+		}
+		stepsByIndex[foundStep.Index] = foundStep.StepName
+	}
+	return nil
+}
+
+// SortedStepNames returns the identifiers of the steps ordered by their index, so 'same_step_2' comes before
+// 'same_step_10'.
+func SortedStepNames(aggregatedSteps map[string]CodeBlock) []string {
+	stepNames := make([]string, 0, len(aggregatedSteps))
+	for stepName := range aggregatedSteps {
+		stepNames = append(stepNames, stepName)
+	}
+	sort.Slice(stepNames, func(i, j int) bool {
+		left, right := aggregatedSteps[stepNames[i]], aggregatedSteps[stepNames[j]]
+		if left.Index != right.Index {
+			return left.Index < right.Index
+		}
+		return stepNames[i] < stepNames[j]
+	})
+	return stepNames
+}
+
+// stepIdentifier finds the identifier of a step from either its identifier or the name it was given.
+func stepIdentifier(aggregatedSteps map[string]CodeBlock, name string) (string, bool) {
+	if _, exists := aggregatedSteps[name]; exists {
+		return name, true
+	}
+	if _, exists := aggregatedSteps[NamedStepPrefix+name]; exists {
+		return NamedStepPrefix + name, true
+	}
+	return "", false
+}
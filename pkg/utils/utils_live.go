@@ -3,7 +3,6 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net"
 	netUrl "net/url"
 	"os"
@@ -16,6 +15,7 @@ import (
 	gogetter "github.com/hashicorp/go-getter"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	v1 "k8s.io/api/apps/v1"
 )
 
@@ -119,13 +119,9 @@ func (u *UtilsLive) GetFile(tempSameFilename string, corrected_url string) error
 // If the file is remote, it pulls from a GitHub repo.
 // Expects a full file path (including the file name)
 func (u *UtilsLive) GetConfigFilePath(putativeFilePath string) (filePath string, err error) {
-	// TODO: aronchick: This is all probably unnecessary. We could just swap everything out
-	// for gogetter.GetFile() and punt the whole problem at it.
-	// HOWEVER, that doesn't solve for when a github url has an https schema, which causes
-	// gogetter to weirdly reformats he URL (dropping the repo).
-	// E.g., gogetter.GetFile(tempFile.Name(), "https://github.com/SAME-Project/Sample-SAME-Data-Science/same.yaml")
-	// Fails with a bad response code: 404
-	// and 	gogetter.GetFile(tempFile.Name(), "https://github.com/SAME-Project/EXAMPLE-SAME-Enabled-Data-Science-Repo/same.yaml")
+	// Remote programs are fetched with FetchRemoteProgram, which turns https URLs on github.com and the
+	// like into git sources, as go-getter would otherwise drop the repo from them.
+	// E.g., gogetter.GetFile(tempFile.Name(), "https://github.com/SAME-Project/EXAMPLE-SAME-Enabled-Data-Science-Repo/same.yaml")
 	// Fails with fatal: repository 'https://github.com/SAME-Project/' not found
 
 	isRemoteFile, err := u.IsRemoteFilePath(putativeFilePath)
@@ -136,45 +132,18 @@ func (u *UtilsLive) GetConfigFilePath(putativeFilePath string) (filePath string,
 	}
 
 	if isRemoteFile {
-		// Use the default system temp directory and a randomly generated name
-		tempSameDir, err := ioutil.TempDir("", "")
+		cacheDir, err := DefaultProgramCacheDir()
 		if err != nil {
-			log.Errorf("error creating a temporary directory to copy the file to (we're using the standard temporary directory from your system, so this could be an issue of the permissions this CLI is running under): %v", err)
-			return "", err
+			return "", fmt.Errorf("could not find the cache directory for remote programs: %v", err)
 		}
 
-		// Get path to store the file to
-		tempSameFile, err := ioutil.TempFile(tempSameDir, "")
+		// The whole tree is fetched, not just the SAME file, so the notebook and the files it uses are there too
+		program, err := FetchRemoteProgram(putativeFilePath, cacheDir, viper.GetString("git_token"))
 		if err != nil {
-			return "", fmt.Errorf("could not create temporary file in %v", tempSameDir)
+			return "", fmt.Errorf("could not download SAME file from '%v': %v", putativeFilePath, err)
 		}
 
-		configFileUri, err := netUrl.Parse(putativeFilePath)
-		if err != nil {
-			return "", fmt.Errorf("could not parse sameFile url: %v", err)
-		}
-
-		// TODO: Hard coding 'same.yaml' in now - should be optional
-		finalUrl, err := u.UrlToRetrive(configFileUri.String(), "same.yaml")
-		if err != nil {
-			message := fmt.Errorf("unable to process the url to retrieve from the provided configFileUri(%v): %v", configFileUri.String(), err)
-			log.Error(message)
-			return "", message
-		}
-
-		corrected_url := finalUrl.String()
-		if (finalUrl.Scheme == "https") || (finalUrl.Scheme == "http") {
-			log.Info("currently only support http and https on github.com because we need to prefix with git")
-			corrected_url = "git::" + finalUrl.String()
-		}
-
-		log.Infof("Downloading from %v to %v", corrected_url, tempSameFile.Name())
-		errGet := u.GetFile(tempSameFile.Name(), corrected_url)
-		if errGet != nil {
-			return "", fmt.Errorf("could not download SAME file from URL '%v': %v", finalUrl.String(), errGet)
-		}
-
-		filePath = tempSameFile.Name()
+		filePath = program.ConfigFilePath
 	} else {
 		cwd, _ := os.Getwd()
 		log.Tracef("CWD: %v", cwd)
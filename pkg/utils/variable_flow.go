@@ -0,0 +1,543 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// pythonKeywords can't be variables, so are never passed between steps
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pythonBuiltins are the names Python provides to all code, e.g. 'print' and 'len'. They are only passed between
+// steps when a step binds them itself, e.g. with 'sum = 0'.
+var pythonBuiltins = map[string]bool{
+	"ArithmeticError": true, "AssertionError": true, "AttributeError": true, "BaseException": true,
+	"BaseExceptionGroup": true, "BlockingIOError": true, "BrokenPipeError": true, "BufferError": true,
+	"BytesWarning": true, "ChildProcessError": true, "ConnectionAbortedError": true, "ConnectionError": true,
+	"ConnectionRefusedError": true, "ConnectionResetError": true, "DeprecationWarning": true, "EOFError": true,
+	"Ellipsis": true, "EncodingWarning": true, "EnvironmentError": true, "Exception": true,
+	"ExceptionGroup": true, "FileExistsError": true, "FileNotFoundError": true, "FloatingPointError": true,
+	"FutureWarning": true, "GeneratorExit": true, "IOError": true, "ImportError": true, "ImportWarning": true,
+	"IndentationError": true, "IndexError": true, "InterruptedError": true, "IsADirectoryError": true,
+	"KeyError": true, "KeyboardInterrupt": true, "LookupError": true, "MemoryError": true,
+	"ModuleNotFoundError": true, "NameError": true, "NotADirectoryError": true, "NotImplemented": true,
+	"NotImplementedError": true, "OSError": true, "OverflowError": true, "PendingDeprecationWarning": true,
+	"PermissionError": true, "ProcessLookupError": true, "RecursionError": true, "ReferenceError": true,
+	"ResourceWarning": true, "RuntimeError": true, "RuntimeWarning": true, "StopAsyncIteration": true,
+	"StopIteration": true, "SyntaxError": true, "SyntaxWarning": true, "SystemError": true, "SystemExit": true,
+	"TabError": true, "TimeoutError": true, "TypeError": true, "UnboundLocalError": true,
+	"UnicodeDecodeError": true, "UnicodeEncodeError": true, "UnicodeError": true, "UnicodeTranslateError": true,
+	"UnicodeWarning": true, "UserWarning": true, "ValueError": true, "Warning": true, "ZeroDivisionError": true,
+	"__build_class__": true, "__debug__": true, "__import__": true, "abs": true, "aiter": true, "all": true,
+	"anext": true, "any": true, "ascii": true, "bin": true, "bool": true, "breakpoint": true, "bytearray": true,
+	"bytes": true, "callable": true, "chr": true, "classmethod": true, "compile": true, "complex": true,
+	"copyright": true, "credits": true, "delattr": true, "dict": true, "dir": true, "divmod": true,
+	"enumerate": true, "eval": true, "exec": true, "exit": true, "filter": true, "float": true, "format": true,
+	"frozenset": true, "getattr": true, "globals": true, "hasattr": true, "hash": true, "help": true, "hex": true,
+	"id": true, "input": true, "int": true, "isinstance": true, "issubclass": true, "iter": true, "len": true,
+	"license": true, "list": true, "locals": true, "map": true, "max": true, "memoryview": true, "min": true,
+	"next": true, "object": true, "oct": true, "open": true, "ord": true, "pow": true, "print": true,
+	"property": true, "quit": true, "range": true, "repr": true, "reversed": true, "round": true, "set": true,
+	"setattr": true, "slice": true, "sorted": true, "staticmethod": true, "str": true, "sum": true, "super": true,
+	"tuple": true, "type": true, "vars": true, "zip": true,
+}
+
+// dynamicAccessFunctions read variables by a name that is only known when the code runs. 'vars' and 'dir'
+// only do when called without arguments.
+var dynamicAccessFunctions = map[string]bool{"globals": true, "locals": true, "eval": true, "exec": true, "vars": true, "dir": true}
+
+// StepVariables are the variables a step loads from the context it starts from, and the ones it passes on
+// to the steps after it.
+type StepVariables struct {
+	// Loads are the variables the step uses or passes on. LoadsAll is whether it loads every variable, as it
+	// (or a step after it) reads variables dynamically, e.g. with 'globals()'.
+	Loads    []string
+	LoadsAll bool
+	// Exports are the variables a step after this one may use. ExportsAll is whether the step passes on every
+	// variable, as a step after it reads variables dynamically.
+	Exports    []string
+	ExportsAll bool
+}
+
+// VariableFlow is the variables one step passes to another
+type VariableFlow struct {
+	From      string
+	To        string
+	Variables []string
+	// All is whether every variable is passed, as the receiving step (or one after it) reads them dynamically
+	All bool
+}
+
+// stepNames are the names a step's code uses, the names it always binds, and the names it has to pass on
+type stepNames struct {
+	Uses map[string]bool
+	// Binds are the names the step binds whatever path its code takes, so it passes on its own value of them
+	Binds   map[string]bool
+	Dynamic bool
+	Needed  map[string]bool
+	// NeedsAll is whether every variable has to be passed on, as a step after this one reads them dynamically
+	NeedsAll bool
+}
+
+// passes is whether a variable is passed on by the step.
+func (s stepNames) passes(name string) bool {
+	return s.NeedsAll || s.Needed[name]
+}
+
+// loads is whether a variable is loaded by the step.
+func (s stepNames) loads(name string) bool {
+	return s.Dynamic || s.Uses[name] || s.passes(name) && !s.Binds[name]
+}
+
+// codeNames are the names code reads before binding them, the names it binds whatever path it takes (at the
+// top level, and not in a loop), and every name it binds anywhere.
+type codeNames struct {
+	Reads    map[string]bool
+	Binds    map[string]bool
+	AllBinds map[string]bool
+}
+
+// StepVariablesFor returns the variables each step loads and passes on. Rather than every variable going to
+// every step after the one making it, a step only passes on the variables that the steps after it use (by
+// name, before they bind it themselves) or have to pass on in turn. This is found from the names the code uses
+// rather than the names it defines, so variables defined in ways that can't be seen before the code runs
+// (like 'from a import *') are still passed on.
+func StepVariablesFor(aggregatedSteps map[string]CodeBlock) (map[string]StepVariables, error) {
+	names, err := variableNames(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	stepVariables := make(map[string]StepVariables, len(names))
+	for stepName, stepNames := range names {
+		variables := StepVariables{
+			Loads:      make([]string, 0),
+			LoadsAll:   stepNames.Dynamic || stepNames.NeedsAll,
+			Exports:    make([]string, 0),
+			ExportsAll: stepNames.NeedsAll,
+		}
+		for name := range stepNames.Needed {
+			variables.Exports = append(variables.Exports, name)
+			if stepNames.loads(name) {
+				variables.Loads = append(variables.Loads, name)
+			}
+		}
+		for name := range stepNames.Uses {
+			if !stepNames.Needed[name] {
+				variables.Loads = append(variables.Loads, name)
+			}
+		}
+		sort.Strings(variables.Loads)
+		sort.Strings(variables.Exports)
+		stepVariables[stepName] = variables
+	}
+	return stepVariables, nil
+}
+
+// VariableFlows returns the variables each step passes to the steps after it, in the order the steps run.
+// Only variables traced to the step's code (see traceVariables) are listed; modules are left out, as they are
+// passed on by name.
+func VariableFlows(aggregatedSteps map[string]CodeBlock) ([]VariableFlow, error) {
+	stepOrder, err := StepOrder(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+	names, err := variableNames(aggregatedSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	outgoing := make(map[string]map[string]tracedVariable)
+	flows := make([]VariableFlow, 0)
+	for _, stepName := range stepOrder {
+		codeBlock := aggregatedSteps[stepName]
+		incoming := make(map[string]tracedVariable)
+		for _, parent := range codeBlock.Parents {
+			flow := VariableFlow{From: parent, To: stepName, Variables: make([]string, 0), All: names[stepName].Dynamic || names[stepName].NeedsAll}
+			for name, variable := range outgoing[parent] {
+				if !variable.IsModule && !names[parent].passes(name) {
+					continue
+				}
+				incoming[name] = variable
+				if !variable.IsModule && names[stepName].loads(name) {
+					flow.Variables = append(flow.Variables, name)
+				}
+			}
+			sort.Strings(flow.Variables)
+			flows = append(flows, flow)
+		}
+		outgoing[stepName] = traceVariables(tokenizePython(codeBlock.Code), incoming)
+	}
+	return flows, nil
+}
+
+// variableNames finds the names each step uses, and from them, working back from the last steps, the names
+// each step has to pass on: the names used by its children, and the names they have to pass on. Parents
+// that aren't among the steps (when only some of the steps are written) are left out.
+func variableNames(aggregatedSteps map[string]CodeBlock) (map[string]stepNames, error) {
+	presentSteps := make(map[string]CodeBlock, len(aggregatedSteps))
+	for stepName, codeBlock := range aggregatedSteps {
+		parents := make([]string, 0, len(codeBlock.Parents))
+		for _, parent := range codeBlock.Parents {
+			if _, present := aggregatedSteps[parent]; present {
+				parents = append(parents, parent)
+			}
+		}
+		codeBlock.Parents = parents
+		presentSteps[stepName] = codeBlock
+	}
+	stepOrder, err := StepOrder(presentSteps)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]string)
+	for _, stepName := range stepOrder {
+		for _, parent := range presentSteps[stepName].Parents {
+			children[parent] = append(children[parent], stepName)
+		}
+	}
+
+	tokensBySteps := make(map[string][]pythonToken, len(stepOrder))
+	codeNamesBySteps := make(map[string]codeNames, len(stepOrder))
+	programBinds := make(map[string]bool)
+	for _, stepName := range stepOrder {
+		tokensBySteps[stepName] = tokenizePython(presentSteps[stepName].Code)
+		codeNamesBySteps[stepName] = flowNames(tokensBySteps[stepName])
+		for name := range codeNamesBySteps[stepName].AllBinds {
+			programBinds[name] = true
+		}
+	}
+
+	names := make(map[string]stepNames, len(stepOrder))
+	for i := len(stepOrder) - 1; i >= 0; i-- {
+		stepName := stepOrder[i]
+		thisStep := stepNames{Uses: make(map[string]bool), Binds: codeNamesBySteps[stepName].Binds, Dynamic: readsDynamically(tokensBySteps[stepName]), Needed: make(map[string]bool)}
+		for name := range codeNamesBySteps[stepName].Reads {
+			if !pythonBuiltins[name] || programBinds[name] {
+				thisStep.Uses[name] = true
+			}
+		}
+		if presentSteps[stepName].FailurePolicy.ContinuesOnFailure() {
+			// The steps after it may start from the context it started from, which has to hold everything it passes on
+			thisStep.Binds = map[string]bool{}
+		}
+		for _, child := range children[stepName] {
+			thisStep.NeedsAll = thisStep.NeedsAll || names[child].Dynamic || names[child].NeedsAll
+			for name := range names[child].Uses {
+				thisStep.Needed[name] = true
+			}
+			for name := range names[child].Needed {
+				if !names[child].Binds[name] {
+					thisStep.Needed[name] = true
+				}
+			}
+		}
+		names[stepName] = thisStep
+	}
+	return names, nil
+}
+
+// flowNames follows code statement by statement to find the names it reads before binding them. A name
+// bound in a loop, a branch or a function body may not be bound when it is read later, so only names bound
+// at the top level (outside of 'for' headers) count as bound from then on.
+func flowNames(tokens []pythonToken) codeNames {
+	statements := splitPythonStatements(tokens)
+	topIndent := -1
+	for _, statement := range statements {
+		if topIndent < 0 || statement.Indent < topIndent {
+			topIndent = statement.Indent
+		}
+	}
+
+	names := codeNames{Reads: make(map[string]bool), Binds: make(map[string]bool), AllBinds: make(map[string]bool)}
+	for i := 0; i < len(statements); i++ {
+		statement := statements[i]
+		keyword := statement.Tokens[0].Text
+		reads, binds := statementNames(statement.Tokens)
+		if keyword == "def" {
+			// The body runs when the function is called, so is followed as a whole here
+			body := make([]pythonStatement, 0)
+			for i+1 < len(statements) && statements[i+1].Indent > statement.Indent {
+				i++
+				body = append(body, statements[i])
+			}
+			addNames(reads, functionBodyReads(statement.Tokens, body))
+		}
+
+		for name := range reads {
+			if !names.Binds[name] {
+				names.Reads[name] = true
+			}
+		}
+		for _, name := range binds {
+			names.AllBinds[name] = true
+			if statement.Indent == topIndent && keyword != "for" {
+				names.Binds[name] = true
+			}
+		}
+		if keyword == "del" {
+			for _, name := range targetNames(statement.Tokens[1:]) {
+				delete(names.Binds, name)
+			}
+		}
+	}
+	return names
+}
+
+// statementNames returns the names a statement reads and the names it binds. The body of a compound statement
+// is made of statements of its own.
+func statementNames(statement []pythonToken) (map[string]bool, []string) {
+	reads := make(map[string]bool)
+	binds := make([]string, 0)
+	keyword := statement[0].Text
+	switch {
+	case keyword == "import" || keyword == "from":
+		for name := range importedVariables(statement) {
+			binds = append(binds, name)
+		}
+	case keyword == "global" || keyword == "nonlocal":
+	case keyword == "def":
+		_, reads = functionSignature(statement)
+		if len(statement) > 1 && statement[1].Kind == pythonTokenName {
+			binds = append(binds, statement[1].Text)
+		}
+	case keyword == "class":
+		if len(statement) > 1 && statement[1].Kind == pythonTokenName {
+			binds = append(binds, statement[1].Text)
+			statement = statement[2:]
+		}
+		reads = usedNames(statement)
+	case keyword == "for":
+		reads = usedNames(statement)
+		if in := indexOfName(statement, "in"); in > 0 {
+			reads = usedNames(statement[in+1:])
+			binds = targetNames(statement[1:in])
+		}
+	case keyword == "with":
+		for _, item := range splitTopLevel(statement[1:], ",") {
+			as := indexOfName(item, "as")
+			if as < 0 {
+				addNames(reads, usedNames(item))
+				continue
+			}
+			addNames(reads, usedNames(item[:as]))
+			binds = append(binds, targetNames(item[as+1:])...)
+		}
+	case statement[0].Kind == pythonTokenName && compoundKeywords[keyword] || keyword == "del":
+		// 'del' reads the names it unbinds, as they have to be bound to be deleted
+		reads = usedNames(statement)
+	default:
+		targets, value, augmented := assignmentParts(statement)
+		if len(targets) == 0 {
+			reads = usedNames(statement)
+		}
+		addNames(reads, usedNames(value))
+		for _, target := range targets {
+			if annotation := splitTopLevel(target, ":"); len(annotation) > 1 {
+				addNames(reads, usedNames(target[len(annotation[0])+1:]))
+				target = annotation[0]
+			}
+			bound := targetNames(target)
+			for name := range usedNames(target) {
+				if augmented || !ContainsString(bound, name) || isMutatedTarget(target, name) {
+					reads[name] = true
+				} else {
+					binds = append(binds, name)
+				}
+			}
+		}
+	}
+	return reads, binds
+}
+
+// functionSignature returns the parameters of a 'def' statement, and the names its defaults and annotations
+// read when the function is defined.
+func functionSignature(statement []pythonToken) ([]string, map[string]bool) {
+	parameters := make([]string, 0)
+	if len(statement) < 3 || statement[2].Text != "(" {
+		return parameters, usedNames(statement[1:])
+	}
+
+	end := len(statement)
+	depth := 0
+	for i := 2; i < len(statement) && end == len(statement); i++ {
+		switch {
+		case statement[i].Kind != pythonTokenOp:
+		case strings.Contains("([{", statement[i].Text):
+			depth++
+		case strings.Contains(")]}", statement[i].Text):
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+
+	reads := make(map[string]bool)
+	for _, parameter := range splitTopLevel(statement[3:end], ",") {
+		// '*args', '**kwargs' and the '/' and '*' markers
+		for len(parameter) > 0 && parameter[0].Kind == pythonTokenOp {
+			parameter = parameter[1:]
+		}
+		if len(parameter) > 0 && parameter[0].Kind == pythonTokenName {
+			parameters = append(parameters, parameter[0].Text)
+			parameter = parameter[1:]
+		}
+		addNames(reads, usedNames(parameter))
+	}
+	if end < len(statement) {
+		addNames(reads, usedNames(statement[end+1:]))
+	}
+	return parameters, reads
+}
+
+// functionBodyReads returns the names the body of a function reads from outside of it. Its parameters and the
+// names it binds are local to it, unless declared 'global' or 'nonlocal'.
+func functionBodyReads(statement []pythonToken, body []pythonStatement) map[string]bool {
+	parameters, _ := functionSignature(statement)
+	locals := make(map[string]bool)
+	for _, parameter := range parameters {
+		locals[parameter] = true
+	}
+
+	reads := make(map[string]bool)
+	nonLocals := make(map[string]bool)
+	for _, bodyStatement := range body {
+		if keyword := bodyStatement.Tokens[0].Text; keyword == "global" || keyword == "nonlocal" {
+			for _, name := range targetNames(bodyStatement.Tokens[1:]) {
+				nonLocals[name] = true
+			}
+			continue
+		}
+		statementReads, statementBinds := statementNames(bodyStatement.Tokens)
+		addNames(reads, statementReads)
+		for _, name := range statementBinds {
+			locals[name] = true
+		}
+	}
+
+	for name := range locals {
+		if !nonLocals[name] {
+			delete(reads, name)
+		}
+	}
+	return reads
+}
+
+// addNames adds the names in more to names.
+func addNames(names map[string]bool, more map[string]bool) {
+	for name := range more {
+		names[name] = true
+	}
+}
+
+// usedNames returns the names code uses which could be variables, including the ones used in f-strings.
+// Attributes and the names of keyword arguments ('sep' in 'print(a, sep=b)') aren't variables.
+func usedNames(tokens []pythonToken) map[string]bool {
+	names := make(map[string]bool)
+	for i, token := range tokens {
+		if token.Kind != pythonTokenName || pythonKeywords[token.Text] || i > 0 && tokens[i-1].Text == "." {
+			continue
+		}
+		if i > 0 && (tokens[i-1].Text == "(" || tokens[i-1].Text == ",") && i+2 < len(tokens) && tokens[i+1].Text == "=" && tokens[i+2].Text != "=" {
+			continue
+		}
+		names[token.Text] = true
+	}
+	for _, token := range tokens {
+		for _, expression := range fStringExpressions(token) {
+			for name := range usedNames(tokenizePython(expression)) {
+				names[name] = true
+			}
+		}
+	}
+	return names
+}
+
+// readsDynamically is whether code reads variables by names only known when it runs, e.g. 'globals()[name]'
+// or 'eval(code)'.
+func readsDynamically(tokens []pythonToken) bool {
+	for i, token := range tokens {
+		if token.Kind != pythonTokenName || !dynamicAccessFunctions[token.Text] || i > 0 && tokens[i-1].Text == "." {
+			continue
+		}
+		if i+1 >= len(tokens) || tokens[i+1].Text != "(" {
+			continue
+		}
+		if token.Text != "vars" && token.Text != "dir" || i+2 < len(tokens) && tokens[i+2].Text == ")" {
+			return true
+		}
+	}
+	return false
+}
+
+// fStringExpressions returns the expressions in the replacement fields of an f-string literal token, e.g.
+// 'df.shape' in f"{df.shape:>10}", or nothing if the token isn't an f-string.
+func fStringExpressions(token pythonToken) []string {
+	if token.Kind != pythonTokenOther {
+		return nil
+	}
+	quoteAt := strings.IndexAny(token.Text, `'"`)
+	if quoteAt <= 0 || !strings.ContainsAny(token.Text[:quoteAt], "fF") {
+		return nil
+	}
+	return replacementFieldExpressions(token.Text[quoteAt:])
+}
+
+// replacementFieldExpressions returns the expressions in the replacement fields of the body of an f-string,
+// including the fields nested in format specs (like 'width' in '{total:.{width}f}').
+func replacementFieldExpressions(body string) []string {
+	expressions := make([]string, 0)
+	for i := 0; i < len(body); i++ {
+		if body[i] != '{' {
+			continue
+		}
+		if i+1 < len(body) && body[i+1] == '{' {
+			i++
+			continue
+		}
+		expressionEnd, fieldEnd := replacementFieldEnds(body, i)
+		if fieldEnd < 0 {
+			break
+		}
+		expressions = append(expressions, body[i+1:expressionEnd])
+		expressions = append(expressions, replacementFieldExpressions(body[expressionEnd:fieldEnd])...)
+		i = fieldEnd
+	}
+	return expressions
+}
+
+// replacementFieldEnds returns where the expression of the replacement field starting at start ends (at a
+// '!' conversion or ':' format spec outside of brackets and strings), and where the field's closing '}' is,
+// or -1 if it isn't closed.
+func replacementFieldEnds(body string, start int) (expressionEnd int, fieldEnd int) {
+	depth := 0
+	expressionEnd = -1
+	for i := start + 1; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == '\'' || c == '"':
+			i = skipPythonString(body, i) - 1
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth == 0 {
+				if expressionEnd < 0 {
+					expressionEnd = i
+				}
+				return expressionEnd, i
+			}
+			depth--
+		case depth == 0 && expressionEnd < 0 && (c == ':' || c == '!' && !strings.HasPrefix(body[i:], "!=")):
+			expressionEnd = i
+		}
+	}
+	return -1, -1
+}
@@ -0,0 +1,16 @@
+import argparse
+import os
+
+# The base64 encoding of an empty locals() output, the context a run starts from unless it is given one
+EMPTY_CONTEXT = "gAR9lC4="
+
+
+if __name__ == "__main__":
+    parser = argparse.ArgumentParser(prog="create_context_file")
+    parser.add_argument("--context-string", dest="context_string", type=str, default="")
+    parser.add_argument("--output-context", dest="output_context_path", type=str, required=True)
+    args = parser.parse_args()
+
+    os.makedirs(os.path.dirname(args.output_context_path), exist_ok=True)
+    with open(args.output_context_path, "w+") as file_handle:
+        file_handle.write(args.context_string or EMPTY_CONTEXT)
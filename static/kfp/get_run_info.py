@@ -0,0 +1,41 @@
+import argparse
+import base64
+import os
+from pprint import pprint as pp
+
+import dill
+import kfp
+
+
+def get_run_info(run_id):
+    """Returns the info of the current pipeline run, pickled and base64 encoded for the steps"""
+    print(f"Current run ID is {run_id}.")
+    client = kfp.Client(host="http://ml-pipeline:8888")
+    run_info = client.get_run(run_id=run_id)
+    # Hide verbose info
+    run_info.run.pipeline_spec.workflow_manifest = None
+
+    pp(run_info.run)
+
+    run_info_dict = {
+        "run_id": run_info.run.id,
+        "name": run_info.run.name,
+        "created_at": run_info.run.created_at.isoformat(),
+        "pipeline_id": run_info.run.pipeline_spec.pipeline_id,
+    }
+    for r in run_info.run.resource_references:
+        run_info_dict[f"{r.key.type.lower()}_id"] = r.key.id
+
+    return str(base64.urlsafe_b64encode(dill.dumps(run_info_dict)), encoding="ascii")
+
+
+if __name__ == "__main__":
+    parser = argparse.ArgumentParser(prog="get_run_info")
+    parser.add_argument("--run-id", dest="run_id", type=str, required=True)
+    parser.add_argument("--run-info", dest="run_info_path", type=str, required=True)
+    args = parser.parse_args()
+
+    run_info = get_run_info(args.run_id)
+    os.makedirs(os.path.dirname(args.run_info_path), exist_ok=True)
+    with open(args.run_info_path, "w") as file_handle:
+        file_handle.write(run_info)
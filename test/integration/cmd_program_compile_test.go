@@ -3,7 +3,6 @@ package integration_test
 import (
 	"io/ioutil"
 	"os"
-	"os/exec"
 
 	"testing"
 
@@ -60,23 +59,23 @@ func (suite *ProgramCompileSuite) Test_ParseZeroStepsWithParams() {
 }
 
 func (suite *ProgramCompileSuite) Test_ParseOneStep() {
-	testStep(suite.T(), 4, 2, ONE_STEP, "ONE_STEP")
+	testStep(suite.T(), 2, 2, ONE_STEP, "ONE_STEP")
 }
 
 func (suite *ProgramCompileSuite) Test_ParseOneStepWithCache() {
-	testStep(suite.T(), 4, 2, ONE_STEP_WITH_CACHE, "ONE_STEP_WITH_CACHE")
+	testStep(suite.T(), 2, 2, ONE_STEP_WITH_CACHE, "ONE_STEP_WITH_CACHE")
 }
 
 func (suite *ProgramCompileSuite) Test_ParseTwoSteps() {
-	testStep(suite.T(), 6, 3, TWO_STEPS, "TWO_STEPS")
+	testStep(suite.T(), 3, 3, TWO_STEPS, "TWO_STEPS")
 }
 
 func (suite *ProgramCompileSuite) Test_ParseTwoStepsCombine() {
-	testStep(suite.T(), 8, 3, TWO_STEPS_COMBINE, "TWO_STEPS_COMBINE")
+	testStep(suite.T(), 4, 3, TWO_STEPS_COMBINE, "TWO_STEPS_COMBINE")
 }
 
 func (suite *ProgramCompileSuite) Test_ParseTwoStepsCombineNoParams() {
-	testStep(suite.T(), 6, 3, TWO_STEPS_COMBINE_NO_PARAMS, "TWO_STEPS_COMBINE_NO_PARAMS")
+	testStep(suite.T(), 3, 2, TWO_STEPS_COMBINE_NO_PARAMS, "TWO_STEPS_COMBINE_NO_PARAMS")
 }
 
 func (suite *ProgramCompileSuite) Test_SettingCacheValue_NoCache() {
@@ -118,17 +117,13 @@ func (suite *ProgramCompileSuite) Test_ImportsWorkingProperly() {
 func (suite *ProgramCompileSuite) Test_FullNotebookExperience() {
 	os.Setenv("TEST_PASS", "1")
 	c := utils.GetCompileFunctions()
-	jupytextExecutable, err := exec.LookPath("jupytext")
-	if err != nil {
-		assert.Fail(suite.T(), "Jupytext not installed")
-	}
-
 	notebookPath := "../testdata/notebook/sample_notebook.ipynb"
 	if _, exists := os.Stat(notebookPath); exists != nil {
 		assert.Fail(suite.T(), "Notebook not found at: %v", notebookPath)
 	}
-	convertedText, _ := c.ConvertNotebook(jupytextExecutable, notebookPath)
-	foundSteps, _ := c.FindAllSteps(convertedText)
+	cells, err := c.ReadNotebook(notebookPath)
+	assert.NoError(suite.T(), err)
+	foundSteps, _ := c.FindAllSteps(cells)
 	codeBlocks, _ := c.CombineCodeSlicesToSteps(foundSteps)
 	packagesToMerge, _ := c.WriteStepFiles("kubeflow", suite.tmpDirectory, codeBlocks)
 	containsKey := ""
@@ -147,17 +142,13 @@ func (suite *ProgramCompileSuite) Test_KubeflowRootCompile() {
 		assert.Fail(suite.T(), "could not load SAME config file: %v", err)
 	}
 
-	jupytextExecutable, err := exec.LookPath("jupytext")
-	if err != nil {
-		assert.Fail(suite.T(), "Jupytext not installed")
-	}
-
 	notebook_path := "../testdata/notebook/sample_notebook.ipynb"
 	if _, exists := os.Stat(notebook_path); exists != nil {
 		assert.Fail(suite.T(), "Notebook not found at: %v", notebook_path)
 	}
-	convertedText, _ := c.ConvertNotebook(jupytextExecutable, notebook_path)
-	foundSteps, _ := c.FindAllSteps(convertedText)
+	cells, err := c.ReadNotebook(notebook_path)
+	assert.NoError(suite.T(), err)
+	foundSteps, _ := c.FindAllSteps(cells)
 	aggregatedSteps, _ := c.CombineCodeSlicesToSteps(foundSteps)
 	fullRootFile, _ := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
 
@@ -179,17 +170,13 @@ func (suite *ProgramCompileSuite) Test_AMLRootCompile() {
 		assert.Fail(suite.T(), "could not load SAME config file: %v", err)
 	}
 
-	jupytextExecutable, err := exec.LookPath("jupytext")
-	if err != nil {
-		assert.Fail(suite.T(), "Jupytext not installed")
-	}
-
 	notebook_path := "../testdata/notebook/sample_notebook.ipynb"
 	if _, exists := os.Stat(notebook_path); exists != nil {
 		assert.Fail(suite.T(), "Notebook not found at: %v", notebook_path)
 	}
-	convertedText, _ := c.ConvertNotebook(jupytextExecutable, notebook_path)
-	foundSteps, _ := c.FindAllSteps(convertedText)
+	cells, err := c.ReadNotebook(notebook_path)
+	assert.NoError(suite.T(), err)
+	foundSteps, _ := c.FindAllSteps(cells)
 	aggregatedSteps, _ := c.CombineCodeSlicesToSteps(foundSteps)
 	fullRootFile, _ := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
 
@@ -211,10 +198,10 @@ func (suite *ProgramCompileSuite) Test_CompilePipeline() {
 	assert.True(suite.T(), true)
 }
 
-func testStep(T *testing.T, expectedNumberRaw int, expectedNumberCombined int, testString string, testStringName string) {
+func testStep(T *testing.T, expectedNumberRaw int, expectedNumberCombined int, testCells []utils.NotebookCell, testStringName string) {
 	os.Setenv("TEST_PASS", "1")
 	c := utils.GetCompileFunctions()
-	foundSteps, err := c.FindAllSteps(testString)
+	foundSteps, err := c.FindAllSteps(testCells)
 	assert.Equal(T, len(foundSteps), expectedNumberRaw, "%v did not result in %v step. Actual steps: %v", testStringName, expectedNumberRaw, len(foundSteps))
 	assert.NoError(T, err, "%v resulted in an error building slices: %v", testStringName, err)
 
@@ -227,125 +214,54 @@ func TestProgramCompileSuite(t *testing.T) {
 	suite.Run(t, new(ProgramCompileSuite))
 }
 
-var (
-	ZERO_STEPS = `
-# ---
-
-foo = "bar"
-
-# +
-import tensorflow
-`
-	ZERO_STEPS_WITH_PARAMS = `
-# ---
-
-# + tags=["parameters"]
-foo = "bar"
-
-# +
-import tensorflow
-`
-
-	ONE_STEP = `
-# ---
-
-# +
-foo = "bar"
-
-# +
-# + tags=["same_step_1"]
-import tensorflow
-`
-
-	ONE_STEP_WITH_CACHE = `
-# ---
-
-# + tags=["parameters"]
-foo = "bar"
-
-# +
-# + tags=["same_step_1", "cache=P20D"]
-import tensorflow
-`
-
-	TWO_STEPS = `
-# ---
-
-# + tags=["parameters"]
-foo = "bar"
-
-# +
-# + tags=["same_step_1"]
-import tensorflow
-
-# +
-# + tags=["same_step_2"]
-import pytorch
-`
-
-	TWO_STEPS_COMBINE = `
-# ---
-
-# + tags=["parameters"]
-foo = "bar"
-
-# +
-# + tags=["same_step_1"]
-import tensorflow
-
-# +
-# + tags=["same_step_1"]
-import numpy
-
-# +
-# + tags=["same_step_2"]
-import pytorch
-`
-
-	TWO_STEPS_COMBINE_NO_PARAMS = `
-# +
-# + tags=["same_step_1"]
-import tensorflow
-
-# +
-# + tags=["same_step_1"]
-import numpy
-
-# +
-# + tags=["same_step_2"]
-import pytorch
-`
-
-	NOTEBOOKS_WITH_IMPORT = `
-# ---
-# jupyter:
-#   jupytext:
-#     text_representation:
-#       extension: .py
-#       format_name: light
-#       format_version: '1.5'
-#       jupytext_version: 1.11.1
-#   kernelspec:
-#     display_name: Python 3
-#     language: python
-#     name: python3
-# ---
+// codeCell is a notebook code cell with the given tags.
+func codeCell(source string, tags ...string) utils.NotebookCell {
+	return utils.NotebookCell{CellType: utils.CellTypeCode, Source: source, Tags: tags}
+}
 
-# + tags=["parameters"]
-foo = "bar"
-num = 17
+var (
+	ZERO_STEPS = []utils.NotebookCell{
+		codeCell(`foo = "bar"`),
+		codeCell(`import tensorflow`),
+	}
+	ZERO_STEPS_WITH_PARAMS = []utils.NotebookCell{
+		codeCell(`foo = "bar"`, "parameters"),
+		codeCell(`import tensorflow`),
+	}
 
-# +
-import tensorflow
+	ONE_STEP = []utils.NotebookCell{
+		codeCell(`foo = "bar"`),
+		codeCell(`import tensorflow`, "same_step_1"),
+	}
 
-a = 4
+	ONE_STEP_WITH_CACHE = []utils.NotebookCell{
+		codeCell(`foo = "bar"`, "parameters"),
+		codeCell(`import tensorflow`, "same_step_1", "cache=P20D"),
+	}
 
-# +
-from IPython.display import Image
+	TWO_STEPS = []utils.NotebookCell{
+		codeCell(`foo = "bar"`, "parameters"),
+		codeCell(`import tensorflow`, "same_step_1"),
+		codeCell(`import pytorch`, "same_step_2"),
+	}
 
-b = a + 5
+	TWO_STEPS_COMBINE = []utils.NotebookCell{
+		codeCell(`foo = "bar"`, "parameters"),
+		codeCell(`import tensorflow`, "same_step_1"),
+		codeCell(`import numpy`, "same_step_1"),
+		codeCell(`import pytorch`, "same_step_2"),
+	}
 
-url = 'https://same-project.github.io/SAME-samples/automated_notebook/FaroeIslands.jpeg'
+	TWO_STEPS_COMBINE_NO_PARAMS = []utils.NotebookCell{
+		codeCell(`import tensorflow`, "same_step_1"),
+		codeCell(`import numpy`, "same_step_1"),
+		codeCell(`import pytorch`, "same_step_2"),
+	}
 
-from IPython import display`
+	NOTEBOOKS_WITH_IMPORT = []utils.NotebookCell{
+		{CellType: utils.CellTypeMarkdown, Source: "# A notebook importing tensorflow"},
+		codeCell("foo = \"bar\"\nnum = 17", "parameters"),
+		codeCell("import tensorflow\n\na = 4"),
+		codeCell("from IPython.display import Image\n\nb = a + 5\n\nurl = 'https://same-project.github.io/SAME-samples/automated_notebook/FaroeIslands.jpeg'\n\nfrom IPython import display"),
+	}
 )
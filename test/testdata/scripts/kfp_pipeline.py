@@ -0,0 +1,6 @@
+import kfp.dsl as dsl
+
+
+@dsl.pipeline(name="sample", description="A KFP pipeline, handed straight to dsl-compile")
+def sample_pipeline(message: str = "hello"):
+    dsl.ContainerOp(name="echo", image="library/bash:4.4.23", command=["sh", "-c"], arguments=["echo %s" % message])
@@ -0,0 +1,12 @@
+dataset = 'sample_data'
+
+# + tags=["same_step_1"]
+import numpy as np
+
+b = np.sqrt(10)
+# -
+
+print(f"b: {b}")
+
+# + tags=["same_step_2", "environment=default"]
+print("done")
@@ -0,0 +1,37 @@
+# ---
+# jupyter:
+#   jupytext:
+#     text_representation:
+#       extension: .py
+#       format_name: percent
+#       format_version: '1.3'
+#       jupytext_version: 1.11.1
+#   kernelspec:
+#     display_name: Python 3
+#     language: python
+#     name: python3
+# ---
+
+# %% [markdown]
+# # Sample percent script
+# The steps are marked with tags on the cells, the same way as in a notebook.
+
+# %% tags=["parameters"]
+dataset = 'sample_data'
+
+# %%
+import datetime
+
+a = 10
+
+# %% Train the model tags=["same_step_1", "cache=P1D"]
+# %matplotlib inline
+import numpy as np
+
+b = np.sqrt(a)
+
+# %%
+print(f"b: {b}")
+
+# %% tags=["same_step_2"]
+print(f"Time: {datetime.datetime.now()}")
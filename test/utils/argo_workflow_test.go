@@ -0,0 +1,157 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+// argoWorkflowCells is a notebook whose two training steps run side by side, and are joined by the last step.
+var argoWorkflowCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "data = load(epochs)"},
+	{CellType: utils.CellTypeCode, Source: "small = train(data, 1)", Tags: []string{"step=small", "depends_on=same_step_0", "cpu=2", "gpu_type=nvidia-tesla-k80", "retries=2", "timeout=PT1H", "cache=P1D"}},
+	{CellType: utils.CellTypeCode, Source: "large = train(data, 10)", Tags: []string{"step=large", "depends_on=same_step_0", "environment=private"}},
+	{CellType: utils.CellTypeCode, Source: "compare(small, large)", Tags: []string{"step=compare", "depends_on=small", "depends_on=large"}},
+}
+
+func (suite *UtilsSuite) Test_ArgoWorkflow() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(argoWorkflowCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "Argo Training"
+	sameConfigFile.Spec.Run.Parameters = map[string]interface{}{"epochs": 3}
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{
+		"private": {ImageTag: "registry.example.com/train:1", PrivateRegistry: true},
+	}
+	runParameters := utils.RunParameters(sameConfigFile)
+	for stepName, codeBlock := range aggregatedSteps {
+		codeBlock.RunParameters = runParameters
+		aggregatedSteps[stepName] = codeBlock
+	}
+
+	compiledDir, err := ioutil.TempDir("", "same-argo-")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	_, err = c.WriteStepFiles("kubeflow", compiledDir, aggregatedSteps)
+	assert.NoError(suite.T(), err)
+
+	workflow, err := c.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, compiledDir)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "argo-training-", workflow.GenerateName)
+	assert.Equal(suite.T(), "argo-training", workflow.Spec.Entrypoint)
+	assert.Equal(suite.T(), []v1alpha1.Parameter{
+		{Name: "epochs", Value: v1alpha1.AnyStringPtr("3")},
+		{Name: "context", Value: v1alpha1.AnyStringPtr("")},
+		{Name: "metadata_url", Value: v1alpha1.AnyStringPtr("")},
+	}, workflow.Spec.Arguments.Parameters)
+	assert.Equal(suite.T(), "argotraining", workflow.Spec.ImagePullSecrets[0].Name)
+
+	templates := make(map[string]v1alpha1.Template)
+	for _, template := range workflow.Spec.Templates {
+		templates[template.Name] = template
+	}
+	assert.Len(suite.T(), templates, 7)
+
+	// The helper tasks run in the same image as the steps of the default environment
+	assert.Equal(suite.T(), utils.DefaultImageTag, templates["get-run-info"].Container.Image)
+	assert.Equal(suite.T(), utils.DefaultImageTag, templates["create-context-file"].Container.Image)
+
+	// Runs start from the context they are given, which the first steps get from create-context-file
+	assert.Equal(suite.T(), []string{"--context-string", "{{workflow.parameters.context}}", "--output-context", "/tmp/outputs/output_context/data"}, templates["create-context-file"].Container.Args)
+
+	// Steps run after their parents, with the context of each of them
+	tasks := make(map[string]v1alpha1.DAGTask)
+	for _, task := range templates["argo-training"].DAG.Tasks {
+		tasks[task.Name] = task
+	}
+	assert.Equal(suite.T(), []string{"create-context-file", "get-run-info"}, tasks["same-step-0"].Dependencies)
+	assert.Equal(suite.T(), []string{"get-run-info", "same-step-large", "same-step-small"}, tasks["same-step-compare"].Dependencies)
+	assert.Equal(suite.T(), v1alpha1.Artifacts{
+		{Name: "input_context", From: "{{tasks.same-step-small.outputs.artifacts.output_context}}"},
+		{Name: "input_context_same_step_large", From: "{{tasks.same-step-large.outputs.artifacts.output_context}}"},
+	}, tasks["same-step-compare"].Arguments.Artifacts)
+	assert.Equal(suite.T(), []string{
+		"--input-context", "/tmp/inputs/input_context/data",
+		"--input-context-same_step_large", "/tmp/inputs/input_context_same_step_large/data",
+		"--output-context", "/tmp/outputs/output_context/data",
+		"--run-info", "{{inputs.parameters.run_info}}",
+		"--metadata-url", "{{inputs.parameters.metadata_url}}",
+		"--epochs", "{{inputs.parameters.epochs}}",
+	}, templates["same-step-compare"].Container.Args)
+
+	// Each step runs its step file, which carries its source map
+	stepFile, err := ioutil.ReadFile(filepath.Join(compiledDir, "same_step_compare.py"))
+	assert.NoError(suite.T(), err)
+	command := templates["same-step-compare"].Container.Command
+	assert.Equal(suite.T(), string(stepFile), command[len(command)-1])
+	assert.Contains(suite.T(), string(stepFile), `__parser.add_argument("--input-context-same_step_large", dest="input_context_same_step_large_path", type=str, default=None)`)
+	assert.Len(suite.T(), utils.FindSourceMaps(command[len(command)-1]), 1)
+
+	small := templates["same-step-small"]
+	assert.Equal(suite.T(), utils.DefaultImageTag, small.Container.Image)
+	assert.Equal(suite.T(), "2", small.Container.Resources.Limits.Cpu().String())
+	gpus := small.Container.Resources.Limits["nvidia.com/gpu"]
+	assert.Equal(suite.T(), "1", gpus.String())
+	assert.Equal(suite.T(), map[string]string{utils.DefaultGPUNodeSelectorKey: "nvidia-tesla-k80"}, small.NodeSelector)
+	assert.Equal(suite.T(), 2, small.RetryStrategy.Limit.IntValue())
+	assert.Equal(suite.T(), 3600, small.ActiveDeadlineSeconds.IntValue())
+	assert.Equal(suite.T(), "P1D", small.Metadata.Annotations["pipelines.kubeflow.org/max_cache_staleness"])
+	assert.Equal(suite.T(), "small", small.Metadata.Annotations["pipelines.kubeflow.org/task_display_name"])
+	assert.Equal(suite.T(), "registry.example.com/train:1", templates["same-step-large"].Container.Image)
+	assert.Nil(suite.T(), templates["same-step-large"].RetryStrategy)
+
+	// The YAML reads back as the same workflow
+	workflowYAML, err := utils.ArgoWorkflowYAML(workflow)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), string(workflowYAML), "status:")
+	readWorkflow := &v1alpha1.Workflow{}
+	assert.NoError(suite.T(), yaml.Unmarshal(workflowYAML, readWorkflow))
+	assert.Equal(suite.T(), workflow.Spec, readWorkflow.Spec)
+
+	again, err := c.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, compiledDir)
+	assert.NoError(suite.T(), err)
+	againYAML, err := utils.ArgoWorkflowYAML(again)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), string(workflowYAML), string(againYAML))
+}
+
+func (suite *UtilsSuite) Test_ArgoWorkflowNameCollisions() {
+	c := &utils.CompileLive{}
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "collisions"
+
+	for _, stepNames := range [][]string{{"Train", "train"}, {"a_b", "a__b"}} {
+		cells := []utils.NotebookCell{
+			{CellType: utils.CellTypeCode, Source: "x = 1", Tags: []string{"step=" + stepNames[0]}},
+			{CellType: utils.CellTypeCode, Source: "y = 2", Tags: []string{"step=" + stepNames[1]}},
+		}
+		foundSteps, err := c.FindAllSteps(cells)
+		assert.NoError(suite.T(), err)
+		aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+		assert.NoError(suite.T(), err)
+
+		_, err = c.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, "")
+		if assert.Error(suite.T(), err, stepNames) {
+			assert.Contains(suite.T(), err.Error(), "have the same name in the Argo workflow", stepNames)
+		}
+	}
+
+	// The program's DAG is named after the program
+	foundSteps, err := c.FindAllSteps([]utils.NotebookCell{{CellType: utils.CellTypeCode, Source: "x = 1", Tags: []string{"same_step_0"}}})
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	sameConfigFile.Spec.Metadata.Name = "Same Step 0"
+	_, err = c.CreateArgoWorkflow(aggregatedSteps, sameConfigFile, "")
+	assert.EqualError(suite.T(), err, "step same_step_0 and the program's DAG, named after the program ('same-step-0') have the same name in the Argo workflow, 'same-step-0' - rename one of them")
+}
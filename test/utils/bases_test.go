@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_BasesDeepMerge() {
+	sameConfig, err := loaders.V1{}.LoadSAME("../testdata/bases/overlay.yaml")
+	assert.NoError(suite.T(), err)
+
+	spec := sameConfig.Spec
+	assert.Equal(suite.T(), "SharedBase", spec.Metadata.Name)
+	assert.Equal(suite.T(), map[string]string{"team": "data", "project": "overlay"}, spec.Metadata.Labels)
+	assert.Equal(suite.T(), "library/python:3.8-slim-buster", spec.Environments["default"].ImageTag)
+	assert.Equal(suite.T(), "nvidia/cuda:11.2.0-base", spec.Environments["gpu"].ImageTag)
+	assert.Equal(suite.T(), "Base Run", spec.Run.Name)
+	assert.EqualValues(suite.T(), 100, spec.Run.Parameters["epochs"])
+	assert.EqualValues(suite.T(), 0.01, spec.Run.Parameters["learning_rate"])
+	assert.Empty(suite.T(), spec.Bases)
+}
+
+func (suite *UtilsSuite) Test_BasesValidateAfterMerge() {
+	// Neither layer is complete on its own, but the merged file is
+	err := loaders.ValidateSAME("../testdata/bases/overlay.yaml", nil, "")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *UtilsSuite) Test_BasesCycle() {
+	_, err := loaders.ComposeSAME("../testdata/bases/cycle_a.yaml", nil, "")
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "cycle detected in SAME file bases")
+}
+
+func (suite *UtilsSuite) Test_BasesMissing() {
+	_, err := loaders.ComposeSAME("../testdata/bases/does_not_exist.yaml", nil, "")
+	assert.Error(suite.T(), err)
+}
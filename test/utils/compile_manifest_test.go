@@ -0,0 +1,97 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_RootFileIsDeterministic() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	sameConfigFile := &loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "deterministic"
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{
+		"default": {ImageTag: "library/python:3.9-slim-buster"},
+		"gpu":     {ImageTag: "private.example.com/gpu:1", PrivateRegistry: true, Credentials: loaders.RepositoryCredentials{SecretName: "gpu-secret"}},
+		"spark":   {ImageTag: "private.example.com/spark:1", PrivateRegistry: true, Credentials: loaders.RepositoryCredentials{SecretName: "spark-secret"}},
+		"tiny":    {ImageTag: "library/python:3.8-slim-buster", Packages: []string{"requests", "numpy"}},
+	}
+
+	// Environments are kept in a map, so the root file must not depend on the order maps are visited in
+	for _, target := range []string{"kubeflow", "aml"} {
+		first, err := c.CreateRootFile(target, aggregatedSteps, *sameConfigFile)
+		assert.NoError(suite.T(), err, target)
+		for i := 0; i < 10; i++ {
+			rootFile, err := c.CreateRootFile(target, aggregatedSteps, *sameConfigFile)
+			assert.NoError(suite.T(), err, target)
+			assert.Equal(suite.T(), first, rootFile, target)
+		}
+	}
+}
+
+func (suite *UtilsSuite) Test_CompileManifest() {
+	notebookDir, err := ioutil.TempDir("", "same-manifest-notebook-")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(notebookDir)
+	notebookFilePath := filepath.Join(notebookDir, "sample.ipynb")
+	assert.NoError(suite.T(), ioutil.WriteFile(notebookFilePath, []byte(`{"cells": []}`), 0600))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(notebookDir, "helpers.py"), []byte("x = 1\n"), 0600))
+
+	// The output directory is under the notebook's, as it is when compiling to a relative '--output-dir'
+	outputDir := filepath.Join(notebookDir, "compiled")
+	assert.NoError(suite.T(), os.MkdirAll(outputDir, 0700))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(outputDir, "root.py"), []byte("# root\n"), 0600))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(outputDir, "copied.py"), []byte("# copied\n"), 0600))
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "manifest"
+
+	inputs, err := utils.CompileInputs("kubeflow", "v1.0.0", sameConfigFile, notebookFilePath, true, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), inputs, "support:helpers.py")
+	assert.NotContains(suite.T(), inputs, "support:compiled/copied.py")
+
+	manifest, err := utils.NewCompileManifest(inputs, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), manifest.Write(outputDir))
+	manifestRead, err := utils.ReadCompileManifest(outputDir)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), manifest, *manifestRead)
+	assert.True(suite.T(), manifestRead.UpToDate(inputs, outputDir))
+
+	// Any change to the inputs changes the hash identifying the program
+	otherTarget, err := utils.CompileInputs("aml", "v1.0.0", sameConfigFile, notebookFilePath, true, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), manifestRead.UpToDate(otherTarget, outputDir))
+
+	// Upgrading the CLI compiles the program again
+	otherCLI, err := utils.CompileInputs("kubeflow", "v1.1.0", sameConfigFile, notebookFilePath, true, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), manifestRead.UpToDate(otherCLI, outputDir))
+
+	assert.NoError(suite.T(), ioutil.WriteFile(notebookFilePath, []byte(`{"cells": [{}]}`), 0600))
+	changedNotebook, err := utils.CompileInputs("kubeflow", "v1.0.0", sameConfigFile, notebookFilePath, true, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), manifestRead.UpToDate(changedNotebook, outputDir))
+	changedManifest, err := utils.NewCompileManifest(changedNotebook, outputDir)
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), manifest.InputsHash, changedManifest.InputsHash)
+
+	// Editing the compiled output by hand means it has to be compiled again
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(outputDir, "root.py"), []byte("# edited\n"), 0600))
+	assert.False(suite.T(), manifestRead.UpToDate(inputs, outputDir))
+
+	assert.NoError(suite.T(), manifestRead.RemoveOutputs(outputDir))
+	remaining, err := ioutil.ReadDir(outputDir)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), remaining)
+}
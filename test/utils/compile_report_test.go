@@ -0,0 +1,85 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var reportCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "rows = 10"},
+	{CellType: utils.CellTypeMarkdown, Source: "## Training"},
+	{CellType: utils.CellTypeCode, Source: "model = rows * 2", Tags: []string{"step=train", "environment=gpu", "cache=P1D"}},
+	{CellType: utils.CellTypeCode, Source: "score = model + 1", Tags: []string{"step=train", "cache=P2D", "retries=2"}},
+}
+
+func (suite *UtilsSuite) Test_CompileReport() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(reportCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	train := aggregatedSteps["same_step_train"]
+	train.PackagesToInstall = map[string]string{"torch": "", "numpy": ""}
+	aggregatedSteps["same_step_train"] = train
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "report"
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{"gpu": {ImageTag: "example.com/gpu:1"}}
+	sameConfigFile.Spec.Run.Parameters = map[string]interface{}{"epochs": 3}
+	inputs := map[string]string{
+		"target":                "t",
+		"notebook:train.ipynb":  "n",
+		"support:helpers.py":    "h",
+		"support:lib/loader.py": "l",
+	}
+
+	report, err := utils.NewCompileReport("kubeflow", aggregatedSteps, sameConfigFile, inputs)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "report", report.Program)
+	assert.Equal(suite.T(), "train.ipynb", report.Notebook)
+	assert.Equal(suite.T(), []utils.RunParameter{{Name: "epochs", Type: "int", Default: "'3'"}}, report.Parameters)
+	assert.Equal(suite.T(), []string{"helpers.py", "lib/loader.py"}, report.SupportFiles)
+
+	// Settings of later cells of a step, like 'cache=', override the earlier ones in the tags too
+	assert.Equal(suite.T(), []utils.StepReport{
+		{
+			Name:           "same_step_0",
+			DisplayName:    "same_step_0",
+			Cells:          []int{1},
+			Environment:    "default",
+			Image:          utils.DefaultImageTag,
+			Packages:       []string{},
+			CacheStaleness: "P0D",
+			Tags:           []string{},
+			Predecessors:   []string{},
+		},
+		{
+			Name:           "same_step_train",
+			DisplayName:    "train",
+			Cells:          []int{3, 4},
+			Environment:    "gpu",
+			Image:          "example.com/gpu:1",
+			Packages:       []string{"numpy", "torch"},
+			CacheStaleness: "P2D",
+			Tags:           []string{"cache=P2D", "environment=gpu", "retries=2", "step=train"},
+			Predecessors:   []string{"same_step_0"},
+		},
+	}, report.Steps)
+
+	compiledDir, err := ioutil.TempDir("", "same-report-")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	assert.NoError(suite.T(), report.Write(compiledDir))
+	reportRead, err := utils.ReadCompileReport(compiledDir)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), report, *reportRead)
+
+	// The same inputs always give the same report
+	again, err := utils.NewCompileReport("kubeflow", aggregatedSteps, sameConfigFile, inputs)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), report, again)
+}
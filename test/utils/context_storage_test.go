@@ -0,0 +1,99 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ResolveContextStorage() {
+	sameConfigFile := loaders.SameConfig{}
+	contextStorage, err := utils.ResolveContextStorage(sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), contextStorage.Enabled())
+
+	// Everything but the threshold defaults to the artifact store of Kubeflow Pipelines
+	sameConfigFile.Spec.ContextStorage = loaders.ContextStorage{SpillThreshold: "4Mi"}
+	contextStorage, err = utils.ResolveContextStorage(sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), utils.ContextStorage{
+		ThresholdBytes:    4 << 20,
+		Bucket:            "mlpipeline",
+		Path:              "same/contexts",
+		Endpoint:          utils.DefaultContextStorageEndpoint,
+		CredentialsSecret: utils.DefaultContextStorageSecret,
+	}, contextStorage)
+
+	sameConfigFile.Spec.ContextStorage = loaders.ContextStorage{SpillThreshold: "500k", Prefix: "s3://contexts/", Endpoint: "https://s3.amazonaws.com", CredentialsSecret: "s3-credentials"}
+	contextStorage, err = utils.ResolveContextStorage(sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), utils.ContextStorage{
+		ThresholdBytes:    500000,
+		Bucket:            "contexts",
+		Endpoint:          "https://s3.amazonaws.com",
+		CredentialsSecret: "s3-credentials",
+	}, contextStorage)
+
+	for contextStorage, expectedError := range map[loaders.ContextStorage]string{
+		{Prefix: "s3://contexts"}:                        "context_storage needs a spill_threshold (e.g. '4Mi') to spill contexts",
+		{SpillThreshold: "lots"}:                         "context_storage.spill_threshold must be a size with an optional unit (e.g. '4Mi' or '500k'), found: lots",
+		{SpillThreshold: "0"}:                            "context_storage.spill_threshold must be more than 0 bytes, found: 0",
+		{SpillThreshold: "1Mi", Prefix: "gs://contexts"}: "context_storage.prefix must be an 's3://bucket/path' URI, found: gs://contexts",
+		{SpillThreshold: "1Mi", Prefix: "s3:///path"}:    "context_storage.prefix must name a bucket, found: s3:///path",
+	} {
+		sameConfigFile.Spec.ContextStorage = contextStorage
+		_, err := utils.ResolveContextStorage(sameConfigFile)
+		assert.EqualError(suite.T(), err, expectedError)
+	}
+}
+
+func (suite *UtilsSuite) Test_ContextStorageInCompiledFiles() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "spill"
+
+	// Without a threshold, contexts are always passed inline
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), kfpRootFile, "boto3")
+	assert.NotContains(suite.T(), kfpRootFile, "AWS_ACCESS_KEY_ID")
+
+	sameConfigFile.Spec.ContextStorage = loaders.ContextStorage{SpillThreshold: "1Mi", Prefix: "s3://contexts/spilled"}
+	contextStorage, err := utils.ResolveContextStorage(sameConfigFile)
+	assert.NoError(suite.T(), err)
+	for stepName, codeBlock := range aggregatedSteps {
+		codeBlock.ContextStorage = contextStorage
+		aggregatedSteps[stepName] = codeBlock
+	}
+
+	kfpRootFile, err = c.CreateRootFile("kubeflow", aggregatedSteps, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, `packages_to_install=["dill", "requests", "boto3", "numpy",],`)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_3_task.add_env_variable(client.V1EnvVar(name="AWS_SECRET_ACCESS_KEY", value_from=client.V1EnvVarSource(secret_key_ref=client.V1SecretKeySelector(name="mlpipeline-minio-artifact", key="secretkey"))))`)
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, `all_packages = ["dill","azureml.pipeline","azureml.core","boto3",]`)
+	assert.Contains(suite.T(), amlRootFile, "config_default.environment.environment_variables[__credential] = os.environ[__credential]")
+
+	compiledDir, err := ioutil.TempDir("", "same-spill-")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	_, err = c.WriteStepFiles("kubeflow", compiledDir, aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	stepBytes, err := ioutil.ReadFile(filepath.Join(compiledDir, "same_step_1.py"))
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(stepBytes), `return boto3.client("s3", endpoint_url="http://minio-service.kubeflow:9000")`)
+	assert.Contains(suite.T(), string(stepBytes), "if __size > 1048576:")
+	assert.Contains(suite.T(), string(stepBytes), `__key = "spilled/" + f"{__run_id}/same_step_1/{__k}"`)
+	assert.Contains(suite.T(), string(stepBytes), `__context_export = __spill_context(__context_export, __spilled, __spilled_bytes, __run_info_dict["run_id"])`)
+}
@@ -0,0 +1,89 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const pipFreezeOutput = `Delorean==1.0.0
+numpy==1.21.0
+pip==22.0.4
+scikit_learn==1.0.2
+setuptools==58.1.0
+-e git+https://github.com/example/local.git@abc123#egg=local
+# a comment
+`
+
+func (suite *UtilsSuite) Test_ParsePipFreeze() {
+	assert.Equal(suite.T(), map[string]string{
+		"delorean":     "1.0.0",
+		"numpy":        "1.21.0",
+		"pip":          "22.0.4",
+		"scikit-learn": "1.0.2",
+		"setuptools":   "58.1.0",
+	}, utils.ParsePipFreeze(pipFreezeOutput))
+}
+
+func (suite *UtilsSuite) Test_CapturePackages() {
+	current := utils.ParsePipFreeze(pipFreezeOutput)
+
+	// Installed packages are pinned to their installed versions, and declared packages that aren't installed are kept
+	assert.Equal(suite.T(), []string{
+		"delorean==1.0.0",
+		"numpy==1.21.0",
+		"requests>=2.0",
+		"scikit-learn==1.0.2",
+	}, utils.CapturePackages([]string{"numpy", "requests>=2.0", "Scikit-Learn==0.24"}, current))
+
+	assert.Equal(suite.T(), map[string]string{
+		"requests":           "",
+		"Scikit-Learn==0.24": "1.0.2",
+	}, utils.PackageMismatches([]string{"numpy", "delorean==1.0.0", "requests", "Scikit-Learn==0.24"}, current))
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{
+		"default": {Packages: []string{"requests"}, AppendCurrentEnvironment: true},
+		"other":   {Packages: []string{"scipy"}},
+	}
+	environments := sameConfigFile.Spec.Environments
+	assert.True(suite.T(), utils.AppendsCurrentEnvironment(sameConfigFile))
+	utils.AppendCurrentEnvironments(&sameConfigFile, current)
+	assert.Equal(suite.T(), []string{"delorean==1.0.0", "numpy==1.21.0", "requests", "scikit-learn==1.0.2"}, sameConfigFile.Spec.Environments["default"].Packages)
+	assert.Equal(suite.T(), []string{"scipy"}, sameConfigFile.Spec.Environments["other"].Packages)
+	assert.Equal(suite.T(), []string{"requests"}, environments["default"].Packages)
+}
+
+func (suite *UtilsSuite) Test_ConfirmPackagesWithoutAppendedEnvironments() {
+	// Environments that don't append the current environment aren't compared to it
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{"default": {Packages: []string{"same-not-installed"}}}
+	mismatches := (&utils.CompileLive{}).ConfirmPackages(sameConfigFile, map[string]string{})
+	assert.Empty(suite.T(), mismatches)
+}
+
+func (suite *UtilsSuite) Test_SetEnvironmentPackages() {
+	sameFile := `apiVersion: projectsame.io/v1alpha1
+metadata:
+  name: Sample # the experiment name
+environments:
+  default:
+    image_tag: library/python:3.9-slim-buster
+    packages:
+      - requests
+  other:
+    image_tag: library/python:3.7-slim-buster
+`
+	updated, err := loaders.SetEnvironmentPackagesBytes([]byte(sameFile), "default", []string{"numpy==1.21.0", "requests"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(updated), "name: Sample # the experiment name\n")
+	assert.Contains(suite.T(), string(updated), "  default:\n    image_tag: library/python:3.9-slim-buster\n    packages:\n      - numpy==1.21.0\n      - requests\n  other:\n")
+
+	updated, err = loaders.SetEnvironmentPackagesBytes([]byte(sameFile), "gpu", []string{"torch==1.10.0"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(updated), "  gpu:\n    packages:\n      - torch==1.10.0\n")
+
+	updated, err = loaders.SetEnvironmentPackagesBytes([]byte("apiVersion: projectsame.io/v1alpha1\n"), "default", []string{"numpy==1.21.0"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "apiVersion: projectsame.io/v1alpha1\nenvironments:\n  default:\n    packages:\n      - numpy==1.21.0\n", string(updated))
+}
@@ -0,0 +1,99 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// dagCells is a notebook whose feature steps (1 and 2) both start from the loaded data (step 0) and run in
+// parallel, with the training step (3) merging their results.
+var dagCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "data = load()"},
+	{CellType: utils.CellTypeCode, Source: "features_a = a(data)", Tags: []string{"same_step_1"}},
+	{CellType: utils.CellTypeCode, Source: "features_b = b(data)", Tags: []string{"same_step_2", "depends_on=same_step_0"}},
+	{CellType: utils.CellTypeCode, Source: "model = train(features_a, features_b)", Tags: []string{"same_step_3", "depends_on=same_step_1, same_step_2"}},
+}
+
+func (suite *UtilsSuite) Test_StepDependencies() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(dagCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// Steps without depends_on follow the step before them
+	assert.Equal(suite.T(), []string{}, aggregatedSteps["same_step_0"].Parents)
+	assert.Equal(suite.T(), []string{"same_step_0"}, aggregatedSteps["same_step_1"].Parents)
+	assert.Equal(suite.T(), []string{"same_step_0"}, aggregatedSteps["same_step_2"].Parents)
+	assert.Equal(suite.T(), []string{"same_step_1", "same_step_2"}, aggregatedSteps["same_step_3"].Parents)
+
+	order, err := utils.StepOrder(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"same_step_0", "same_step_1", "same_step_2", "same_step_3"}, order)
+
+	sameConfigFile := &loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "dag"
+
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_2_task = same_step_2_op(input_context=same_step_0_task.outputs["output_context"], run_info=`)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_3_task = same_step_3_op(input_context=same_step_1_task.outputs["output_context"], input_context_same_step_2=same_step_2_task.outputs["output_context"], run_info=`)
+	assert.Contains(suite.T(), kfpRootFile, "same_step_2_task.after(same_step_0_task)")
+	assert.Contains(suite.T(), kfpRootFile, "same_step_3_task.after(same_step_1_task)")
+	assert.Contains(suite.T(), kfpRootFile, "same_step_3_task.after(same_step_2_task)")
+	assert.NotContains(suite.T(), kfpRootFile, "same_step_2_task.after(same_step_1_task)")
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, "inputs=[__pipelinedata_context_same_step_1, __pipelinedata_context_same_step_2],")
+	assert.Contains(suite.T(), amlRootFile, "\"--input_context\",\n\t\t\t__pipelinedata_context_same_step_1,\n\t\t\t\"--input_context\",\n\t\t\t__pipelinedata_context_same_step_2,")
+	assert.Contains(suite.T(), amlRootFile, "\"--input_context\",\n\t\t\t__original_context_param,")
+
+	compiledDir, err := ioutil.TempDir("", "SAME-dag-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	_, err = c.WriteStepFiles("kubeflow", compiledDir, map[string]utils.CodeBlock{"same_step_3": aggregatedSteps["same_step_3"]})
+	assert.NoError(suite.T(), err)
+	stepFile, err := ioutil.ReadFile(filepath.Join(compiledDir, "same_step_3.py"))
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(stepFile), "\tinput_context_same_step_2_path: InputPath(str),\n")
+	assert.Contains(suite.T(), string(stepFile), `__merge_contexts(__input_context_strings, ["same_step_1", "same_step_2", ])`)
+}
+
+func (suite *UtilsSuite) Test_StepDependencyErrors() {
+	c := &utils.CompileLive{}
+
+	foundSteps, err := c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_1", "depends_on=same_step_5"}},
+	})
+	assert.NoError(suite.T(), err)
+	_, err = c.CombineCodeSlicesToSteps(foundSteps)
+	assert.EqualError(suite.T(), err, "invalid step dependencies:\nsame_step_1 depends on same_step_5, which is not a step in the notebook (expected one of: same_step_1)")
+
+	foundSteps, err = c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_0", "depends_on=same_step_2"}},
+		{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"same_step_1"}},
+		{CellType: utils.CellTypeCode, Source: "c = 1", Tags: []string{"same_step_2"}},
+	})
+	assert.NoError(suite.T(), err)
+	_, err = c.CombineCodeSlicesToSteps(foundSteps)
+	assert.EqualError(suite.T(), err, "the step dependencies form a cycle: same_step_0 -> same_step_1 -> same_step_2 -> same_step_0")
+
+	// A bare depends_on makes a step a root, and dependencies from several cells of a step add up
+	foundSteps, err = c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_0"}},
+		{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"same_step_1", "depends_on="}},
+		{CellType: utils.CellTypeCode, Source: "c = 1", Tags: []string{"same_step_2", "depends_on=same_step_0"}},
+		{CellType: utils.CellTypeCode, Source: "d = 1", Tags: []string{"same_step_2", "depends_on=same_step_1"}},
+	})
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{}, aggregatedSteps["same_step_1"].Parents)
+	assert.Equal(suite.T(), []string{"same_step_0", "same_step_1"}, aggregatedSteps["same_step_2"].Parents)
+}
@@ -0,0 +1,79 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ParseDotEnv() {
+	vars, err := utils.ParseDotEnv("test.env", []byte(`
+# comment
+export PLAIN=value # trailing comment
+DOUBLE="line one\nline two"
+SINGLE='not \n escaped'
+EMPTY=
+`))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{
+		"PLAIN":  "value",
+		"DOUBLE": "line one\nline two",
+		"SINGLE": `not \n escaped`,
+		"EMPTY":  "",
+	}, vars)
+
+	_, err = utils.ParseDotEnv("test.env", []byte("NO_EQUALS_SIGN\n"))
+	assert.EqualError(suite.T(), err, "test.env:1: expected KEY=VALUE, found: NO_EQUALS_SIGN")
+
+	_, err = utils.ParseDotEnv("test.env", []byte("OK=1\nUNTERMINATED=\"oops\n"))
+	assert.Contains(suite.T(), err.Error(), "test.env:2: unterminated quoted value")
+}
+
+func (suite *UtilsSuite) Test_IsSecretEnvVar() {
+	for _, name := range []string{"DB_PASSWORD", "AWS_SECRET_ACCESS_KEY", "GITHUB_TOKEN", "api_key", "AZURE_CREDENTIALS"} {
+		assert.True(suite.T(), utils.IsSecretEnvVar(name), name)
+	}
+	for _, name := range []string{"DATA_DIR", "LOG_LEVEL", "MONKEY_COUNT", "TOKENIZER"} {
+		assert.False(suite.T(), utils.IsSecretEnvVar(name), name)
+	}
+}
+
+func (suite *UtilsSuite) Test_EnvVarsByEnvironment() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/envfiles/same.yaml")
+	assert.NoError(suite.T(), err)
+	sameConfigFile.Spec.ConfigFilePath = "../testdata/envfiles/same.yaml"
+
+	envVars, err := utils.EnvVarsByEnvironment(*sameConfigFile)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), map[string]string{"DATA_DIR": "/mnt/data", "GREETING": `hello "world"`, "MODEL_NAME": "resnet"}, envVars["default"].Plain)
+	assert.Equal(suite.T(), map[string]string{"DB_PASSWORD": "hunter2"}, envVars["default"].Secrets)
+
+	assert.Equal(suite.T(), "/mnt/gpu_data", envVars["gpu"].Plain["DATA_DIR"])
+	assert.Equal(suite.T(), map[string]string{"DB_PASSWORD": "hunter2", "HF_TOKEN": "abc123"}, envVars["gpu"].Secrets)
+}
+
+func (suite *UtilsSuite) Test_EnvVarsInRootFile() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/envfiles/same.yaml")
+	assert.NoError(suite.T(), err)
+	sameConfigFile.Spec.ConfigFilePath = "../testdata/envfiles/same.yaml"
+
+	aggregatedSteps := map[string]utils.CodeBlock{
+		"same_step_0": {StepIdentifier: "same_step_0", EnvironmentName: "default", Code: "print(1)"},
+		"same_step_1": {StepIdentifier: "same_step_1", EnvironmentName: "gpu", Code: "print(2)"},
+	}
+
+	c := &utils.CompileLive{}
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_0_task.add_env_variable(client.V1EnvVar(name="GREETING", value="hello \"world\""))`)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_1_task.add_env_variable(client.V1EnvVar(name="HF_TOKEN", value_from=client.V1EnvVarSource(secret_key_ref=client.V1SecretKeySelector(name="envfilessample-gpu-env", key="HF_TOKEN"))))`)
+	assert.NotContains(suite.T(), kfpRootFile, "hunter2")
+	assert.NotContains(suite.T(), kfpRootFile, "abc123")
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, `config_gpu.environment.environment_variables["DATA_DIR"] = "/mnt/gpu_data"`)
+	assert.Contains(suite.T(), amlRootFile, `config_default.environment.environment_variables["DB_PASSWORD"] = os.environ["DB_PASSWORD"]`)
+	assert.NotContains(suite.T(), amlRootFile, "hunter2")
+}
@@ -0,0 +1,109 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// failurePolicyCells is a notebook whose flaky data pull (step 1) is retried, and whose optional report
+// (step 2) may fail without failing the pipeline.
+var failurePolicyCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "import requests"},
+	{CellType: utils.CellTypeCode, Source: "data = requests.get(url)", Tags: []string{"same_step_1", "retries=3", "timeout=PT2H"}},
+	{CellType: utils.CellTypeCode, Source: "report(data)", Tags: []string{"same_step_2", "continue_on_failure=true", "retries=1", "timeout=PT10M"}},
+}
+
+func (suite *UtilsSuite) Test_StepFailurePolicy() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(failurePolicyCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	policy := aggregatedSteps["same_step_1"].FailurePolicy
+	assert.Equal(suite.T(), 3, policy.RetryCount())
+	assert.Equal(suite.T(), 7200, policy.TimeoutSeconds())
+	assert.False(suite.T(), policy.ContinuesOnFailure())
+	assert.True(suite.T(), aggregatedSteps["same_step_2"].FailurePolicy.ContinuesOnFailure())
+	assert.Equal(suite.T(), utils.StepFailurePolicy{}, aggregatedSteps["same_step_0"].FailurePolicy)
+
+	sameConfigFile := &loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "failures"
+
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, "\tsame_step_1_task.set_retry(3)\n\tsame_step_1_task.set_timeout(7200)\n")
+	assert.NotContains(suite.T(), kfpRootFile, "same_step_0_task.set_retry")
+
+	// A step that continues on failure retries and times itself out, as KFP doing it would fail the pipeline
+	step2Policy := aggregatedSteps["same_step_2"].FailurePolicy
+	assert.Equal(suite.T(), 0, step2Policy.PlatformRetryCount())
+	assert.Equal(suite.T(), 0, step2Policy.PlatformTimeoutSeconds())
+	assert.Equal(suite.T(), 600, step2Policy.StepTimeoutSeconds())
+	assert.Equal(suite.T(), 0, policy.StepTimeoutSeconds())
+	assert.NotContains(suite.T(), kfpRootFile, "same_step_2_task.set_retry")
+	assert.NotContains(suite.T(), kfpRootFile, "same_step_2_task.set_timeout")
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, "config_same_step_1.max_run_duration_seconds = 7200")
+	assert.Contains(suite.T(), amlRootFile, "runconfig=config_same_step_1,")
+	assert.NotContains(suite.T(), amlRootFile, "config_same_step_0.max_run_duration_seconds")
+	assert.NotContains(suite.T(), amlRootFile, "config_same_step_2.max_run_duration_seconds")
+
+	compiledDir, err := ioutil.TempDir("", "SAME-failures-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	_, err = c.WriteStepFiles("kubeflow", compiledDir, map[string]utils.CodeBlock{"same_step_2": aggregatedSteps["same_step_2"]})
+	assert.NoError(suite.T(), err)
+	stepFile, err := ioutil.ReadFile(filepath.Join(compiledDir, "same_step_2.py"))
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(stepFile), "\t__output_context_string = __input_context_string\n\t__attempts = 1 + 1\n")
+	assert.Contains(suite.T(), string(stepFile), "\t\t\t__signal.alarm(600)\n\t\t\t__output_context_string = __run_step()\n")
+
+	_, err = c.WriteStepFiles("aml", compiledDir, map[string]utils.CodeBlock{"same_step_1": aggregatedSteps["same_step_1"]})
+	assert.NoError(suite.T(), err)
+	stepFile, err = ioutil.ReadFile(filepath.Join(compiledDir, "same_step_1", "same_step_1.py"))
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(stepFile), "\t__attempts = 3 + 1\n")
+	assert.Contains(suite.T(), string(stepFile), "\t\t\traise\n")
+	assert.NotContains(suite.T(), string(stepFile), "__signal")
+}
+
+func (suite *UtilsSuite) Test_StepFailurePolicyErrors() {
+	errors := map[string]string{
+		"retries=-1":                "invalid tag 'retries=-1': retries must be a whole number of times to retry the step, found: -1",
+		"timeout=2h":                "invalid tag 'timeout=2h': timeout must be an ISO 8601 duration in weeks, days, hours, minutes and seconds (e.g. 'PT2H' or 'P1DT30M'), found: 2h",
+		"timeout=PT":                "invalid tag 'timeout=PT': timeout must be an ISO 8601 duration in weeks, days, hours, minutes and seconds (e.g. 'PT2H' or 'P1DT30M'), found: PT",
+		"timeout=PT0S":              "invalid tag 'timeout=PT0S': timeout must be longer than zero, found: PT0S",
+		"continue_on_failure=maybe": "invalid tag 'continue_on_failure=maybe': continue_on_failure must be true or false, found: maybe",
+	}
+	for tag, expected := range errors {
+		_, err := (&utils.CompileLive{}).FindAllSteps([]utils.NotebookCell{
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_1", tag}},
+		})
+		assert.EqualError(suite.T(), err, expected)
+	}
+
+	durations := map[string]int{
+		"PT2H":      7200,
+		"P1DT30M":   88200,
+		"P1W":       604800,
+		"PT1.5S":    2,
+		"PT90M10S":  5410,
+		"P2D":       172800,
+		"PT0H1M0S":  60,
+		"PT0.001S":  1,
+		"P0DT0H60S": 60,
+	}
+	for duration, expected := range durations {
+		seconds, err := utils.ParseISODuration(duration)
+		assert.NoError(suite.T(), err, duration)
+		assert.Equal(suite.T(), expected, seconds, duration)
+	}
+}
@@ -0,0 +1,91 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const importsSource = `import os, sys as system
+import numpy as np
+from sklearn.linear_model import (
+    LinearRegression,
+    LogisticRegression,
+)
+import xml.etree.ElementTree as ET, PIL.Image
+from . import helpers
+from .models import Model
+try: import ujson as json
+except ImportError: import json
+
+text = """
+import not_a_module
+"""
+note = 'from also_not_a_module import x'  # import nor_this
+raise ValueError("bad") from None
+total = 1 + \
+    2; import yaml
+
+def load():
+    import pandas
+    return [x for x in range(3)]
+`
+
+func (suite *UtilsSuite) Test_FindImports() {
+	assert.Equal(suite.T(), []string{"os", "sys", "numpy", "sklearn", "xml", "PIL", "ujson", "json", "yaml", "pandas"}, utils.FindImports(importsSource))
+
+	// IPython magics are commented out before the source is scanned, and strings with prefixes are skipped
+	assert.Equal(suite.T(), []string{"torch"}, utils.FindImports("# !pip install tensorflow\nprefix = rb'import requests'\nf'{1}'; import torch\n"))
+	assert.Equal(suite.T(), []string{}, utils.FindImports(""))
+}
+
+func (suite *UtilsSuite) Test_FindDistributions() {
+	importMap, err := utils.DefaultImportMap()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "scikit-learn", importMap["sklearn"])
+
+	distributions, unknownModules := utils.FindDistributions(importsSource+"import same_unknown_module\n", importMap)
+	assert.Equal(suite.T(), []string{"Pillow", "PyYAML", "numpy", "pandas", "scikit-learn", "ujson"}, distributions)
+	assert.Equal(suite.T(), []string{"same_unknown_module"}, unknownModules)
+
+	// The SAME file overrides the bundled table, and modules next to the pipeline source are never installed
+	sourceDir, err := ioutil.TempDir("", "SAME-imports-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(sourceDir)
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(sourceDir, "helpers.py"), []byte(""), 0600))
+	assert.NoError(suite.T(), os.MkdirAll(filepath.Join(sourceDir, "models"), 0700))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(sourceDir, "models", "__init__.py"), []byte(""), 0600))
+
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.ImportMap = map[string]string{"numpy": "numpy==1.21.0", "same_unknown_module": "same-unknown", "PIL": ""}
+	importMap, err = utils.ImportMapFor(sameConfigFile, sourceDir)
+	assert.NoError(suite.T(), err)
+	distributions, unknownModules = utils.FindDistributions("import numpy, helpers, models.resnet, same_unknown_module\nfrom PIL import Image\n", importMap)
+	assert.Equal(suite.T(), []string{"numpy==1.21.0", "same-unknown"}, distributions)
+	assert.Equal(suite.T(), []string{}, unknownModules)
+}
+
+func (suite *UtilsSuite) Test_WriteStepFilesFindsPackages() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "import os\nimport pandas as pd"},
+		{CellType: utils.CellTypeCode, Source: "from sklearn import svm\nimport cv2", Tags: []string{"same_step_1"}},
+	})
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	compiledDir, err := ioutil.TempDir("", "SAME-imports-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	packages, err := c.WriteStepFiles("kubeflow", compiledDir, aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]map[string]string{
+		"same_step_0": {"pandas": ""},
+		"same_step_1": {"opencv-python": "", "scikit-learn": ""},
+	}, packages)
+}
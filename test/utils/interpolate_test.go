@@ -0,0 +1,90 @@
+package utils_test
+
+import (
+	"os"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+)
+
+func (suite *UtilsSuite) Test_InterpolateSAME() {
+	os.Setenv("SAME_TEST_IMAGE", "library/python")
+	defer os.Unsetenv("SAME_TEST_IMAGE")
+	os.Unsetenv("SAME_TEST_UNSET")
+
+	interpolated, err := loaders.InterpolateSAME("same.yaml", []byte(`environments:
+  default:
+    image_tag: ${env:SAME_TEST_IMAGE}:${param:tag:-latest}
+    private_registry: ${env:SAME_TEST_UNSET:-false}
+# ${env:SAME_TEST_UNSET}
+run:
+  name: $${env:SAME_TEST_IMAGE}
+`), map[string]string{"tag": "3.9"}, "")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), `environments:
+  default:
+    image_tag: library/python:3.9
+    private_registry: false
+# ${env:SAME_TEST_UNSET}
+run:
+  name: ${env:SAME_TEST_IMAGE}
+`, string(interpolated))
+}
+
+func (suite *UtilsSuite) Test_InterpolateSAMEValues() {
+	os.Setenv("SAME_TEST_VALUE", "main # not a comment\nworkflow:\n  type: aml")
+	defer os.Unsetenv("SAME_TEST_VALUE")
+	os.Unsetenv("SAME_TEST_UNSET")
+
+	// Values stay the scalar they were interpolated into, whatever they contain
+	interpolated, err := loaders.InterpolateSAME("same.yaml", []byte(`metadata:
+  name: ${env:SAME_TEST_VALUE}
+  labels:
+    quoted: "${param:quote}"
+    retries: ${param:retries}
+    port: "${param:retries}"
+  version: 1 # ${env:SAME_TEST_UNSET}
+`), map[string]string{"quote": `it's "quoted": yes`, "retries": "3"}, "")
+	assert.NoError(suite.T(), err)
+
+	var spec map[string]interface{}
+	assert.NoError(suite.T(), yaml.Unmarshal(interpolated, &spec))
+	assert.Equal(suite.T(), map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "main # not a comment\nworkflow:\n  type: aml",
+			"labels": map[string]interface{}{
+				"quoted":  `it's "quoted": yes`,
+				"retries": 3.0,
+				"port":    "3",
+			},
+			"version": 1.0,
+		},
+	}, spec)
+	assert.Contains(suite.T(), string(interpolated), "version: 1 # ${env:SAME_TEST_UNSET}\n")
+}
+
+func (suite *UtilsSuite) Test_InterpolateSAMEGit() {
+	interpolated, err := loaders.InterpolateSAME("same.yaml", []byte(`sha: ${git:sha}`), nil, ".")
+	assert.NoError(suite.T(), err)
+	assert.Regexp(suite.T(), `^sha: [0-9a-f]{40}\n$`, string(interpolated))
+
+	_, err = loaders.InterpolateSAME("same.yaml", []byte(`sha: ${git:tag}`), nil, ".")
+	assert.Contains(suite.T(), err.Error(), "unknown git reference 'tag'")
+}
+
+func (suite *UtilsSuite) Test_InterpolateSAMEErrors() {
+	os.Unsetenv("SAME_TEST_UNSET")
+
+	_, err := loaders.InterpolateSAME("same.yaml", []byte(`metadata:
+  name: ${env:SAME_TEST_UNSET}
+  version: ${param:version} ${unknown:thing}
+`), nil, "")
+	errs, ok := err.(loaders.InterpolationErrors)
+	assert.True(suite.T(), ok)
+	assert.Len(suite.T(), errs, 3)
+	assert.Equal(suite.T(), "same.yaml:2:9: ${env:SAME_TEST_UNSET}: the environment variable is not set and there is no default", errs[0].Error())
+	assert.Equal(suite.T(), 3, errs[1].Line)
+	assert.Equal(suite.T(), 12, errs[1].Column)
+	assert.Contains(suite.T(), errs[2].Message, "unknown reference type 'unknown'")
+}
@@ -0,0 +1,131 @@
+package utils_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+)
+
+const v1alpha1SAMEFile = `apiVersion: projectsame.io/v1alpha1
+metadata:
+  name: Sample # the experiment name
+pipeline:
+  package: sample.ipynb
+resources:
+  nodePoodName: pool
+  disks:
+    - name: data
+      volumeMount:
+        mountPath: /mnt/data
+environments:
+  private:
+    private_registry: true
+    repository_credentials:
+      secretname: regcred
+dataSets:
+  - name: training data
+    url: data.csv
+    makeLocalCopy: true
+`
+
+func (suite *UtilsSuite) Test_MigrateToV1Alpha2() {
+	migrated, err := loaders.MigrateSAMEBytes("same.yaml", []byte(v1alpha1SAMEFile), "projectsame.io/v1alpha2")
+	assert.NoError(suite.T(), err)
+
+	migratedString := string(migrated)
+	assert.Contains(suite.T(), migratedString, "apiVersion: projectsame.io/v1alpha2")
+	assert.Contains(suite.T(), migratedString, "name: Sample # the experiment name")
+	assert.Contains(suite.T(), migratedString, "node_pool_name: pool")
+	assert.Contains(suite.T(), migratedString, "volume_mount:\n        mount_path: /mnt/data")
+	assert.Contains(suite.T(), migratedString, "secret_name: regcred")
+	assert.Contains(suite.T(), migratedString, "datasets:\n  - name: training data")
+	assert.Contains(suite.T(), migratedString, "make_local_copy: true")
+
+	roundTripped, err := loaders.MigrateSAMEBytes("same.yaml", migrated, "projectsame.io/v1alpha1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), v1alpha1SAMEFile, string(roundTripped))
+}
+
+func (suite *UtilsSuite) Test_ConvertHubRoundTrip() {
+	// Every field of the hub types is set, so a field a conversion leaves out fails the round trip
+	hub := &loaders.SameSpec{}
+	fillValue(reflect.ValueOf(hub).Elem(), "value")
+
+	assert.Equal(suite.T(), hub, loaders.V1Alpha1{}.ConvertToHub(loaders.V1Alpha1{}.ConvertFromHub(hub)))
+	assert.Equal(suite.T(), hub, loaders.V1Alpha2{}.ConvertToHub(loaders.V1Alpha2{}.ConvertFromHub(hub)))
+}
+
+// fillValue sets every field of v to a value that isn't its zero value, with one entry in each slice and map.
+func fillValue(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillValue(v.Field(i), path+"."+v.Type().Field(i).Name)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillValue(v.Index(0), path+"[0]")
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillValue(value, path+"[key]")
+		v.SetMapIndex(reflect.ValueOf("key"), value)
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem(), path)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(path))
+	case reflect.String:
+		v.SetString(path)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int:
+		v.SetInt(int64(len(path)))
+	case reflect.Float64:
+		v.SetFloat(float64(len(path)))
+	default:
+		panic(fmt.Sprintf("fillValue: unhandled kind %v at %v", v.Kind(), path))
+	}
+}
+
+func (suite *UtilsSuite) Test_LoadSameSpecAcrossVersions() {
+	tmpDir, _ := ioutil.TempDir(os.TempDir(), "SAME-migrate-*")
+	defer os.RemoveAll(tmpDir)
+
+	migrated, err := loaders.MigrateSAMEBytes("same.yaml", []byte(v1alpha1SAMEFile), "projectsame.io/v1alpha2")
+	assert.NoError(suite.T(), err)
+
+	v1alpha1Path := filepath.Join(tmpDir, "v1alpha1.yaml")
+	v1alpha2Path := filepath.Join(tmpDir, "v1alpha2.yaml")
+	_ = ioutil.WriteFile(v1alpha1Path, []byte(v1alpha1SAMEFile), 0600)
+	_ = ioutil.WriteFile(v1alpha2Path, migrated, 0600)
+
+	v1alpha1Config, err := loaders.V1{}.LoadSAME(v1alpha1Path)
+	assert.NoError(suite.T(), err)
+	v1alpha2Config, err := loaders.V1{}.LoadSAME(v1alpha2Path)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), "pool", v1alpha2Config.Spec.Resources.NodePoolName)
+	assert.Equal(suite.T(), "/mnt/data", v1alpha2Config.Spec.Resources.Disks[0].VolumeMount.MountPath)
+	assert.Equal(suite.T(), "regcred", v1alpha2Config.Spec.Environments["private"].Credentials.SecretName)
+	assert.True(suite.T(), v1alpha2Config.Spec.DataSets[0].MakeLocalCopy)
+
+	// Apart from the version, both files load to the same thing
+	v1alpha2Config.Spec.APIVersion = v1alpha1Config.Spec.APIVersion
+	assert.Equal(suite.T(), v1alpha1Config.Spec, v1alpha2Config.Spec)
+
+	marshalled, err := loaders.V1{}.MarshalSAME(*v1alpha2Config)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(marshalled), "makeLocalCopy: true")
+}
+
+func (suite *UtilsSuite) Test_MigrateUnknownVersion() {
+	_, err := loaders.MigrateSAMEBytes("same.yaml", []byte(v1alpha1SAMEFile), "projectsame.io/v9")
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "unsupported apiVersion 'projectsame.io/v9'")
+}
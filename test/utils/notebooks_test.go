@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ReadNotebook() {
+	cells, err := utils.ReadNotebook("../testdata/notebook/sample_notebook.ipynb")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 13, len(cells))
+	assert.Equal(suite.T(), utils.CellTypeCode, cells[0].CellType)
+	assert.Equal(suite.T(), []string{"parameters"}, cells[0].Tags)
+	assert.Equal(suite.T(), "dataset = 'sample_data'\ngpu_type = 'A100'", cells[0].Source)
+	assert.Equal(suite.T(), []string{"same_step_1", "image=python:3.8-slim-buster"}, cells[4].Tags)
+	assert.Nil(suite.T(), cells[1].Tags)
+
+	foundSteps, err := (&utils.CompileLive{}).FindAllSteps(cells)
+	assert.NoError(suite.T(), err)
+	codeBlocks, err := (&utils.CompileLive{}).CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(codeBlocks))
+	assert.Contains(suite.T(), codeBlocks["same_step_0"].Code, "import tensorflow")
+	assert.Contains(suite.T(), codeBlocks["same_step_1"].Code, "import numpy as np")
+	assert.Contains(suite.T(), codeBlocks["same_step_2"].Code, "a = a + 5")
+}
+
+func (suite *UtilsSuite) Test_ParseNotebook() {
+	// Sources can be a single string or a list of lines, and tags are read exactly as they are in the metadata
+	cells, err := utils.ParseNotebook([]byte(`{
+  "nbformat": 4,
+  "nbformat_minor": 5,
+  "metadata": {},
+  "cells": [
+    {"cell_type": "markdown", "metadata": {}, "source": "# tags=[\"same_step_9\"]"},
+    {"cell_type": "code", "metadata": {"tags": ["same_step_1", "cache=P1D", "environment=gpu"]}, "source": ["%matplotlib inline\n", "import numpy\n", "!pip install pandas"], "outputs": [], "execution_count": null},
+    {"cell_type": "code", "metadata": {"tags": ["weird], tag"]}, "source": "%%bash\necho hello", "outputs": [], "execution_count": null},
+    {"cell_type": "raw", "metadata": {}, "source": "not code"}
+  ]
+}`))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, len(cells))
+	assert.Equal(suite.T(), "%matplotlib inline\nimport numpy\n!pip install pandas", cells[1].Source)
+	assert.Equal(suite.T(), []string{"weird], tag"}, cells[2].Tags)
+
+	// Magics and shell escapes are commented out, and markdown and raw cells have no code
+	assert.Equal(suite.T(), "# %matplotlib inline\nimport numpy\n# !pip install pandas\n", cells[1].PythonSource())
+	assert.Equal(suite.T(), "# %%bash\n# echo hello\n", cells[2].PythonSource())
+	assert.Equal(suite.T(), "", cells[0].PythonSource())
+
+	foundSteps, err := (&utils.CompileLive{}).FindAllSteps(cells)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(foundSteps))
+	assert.Equal(suite.T(), "same_step_1", foundSteps[0].StepName)
+	assert.Equal(suite.T(), "P1D", foundSteps[0].CacheValue)
+	assert.Equal(suite.T(), "gpu", foundSteps[0].EnvironmentName)
+	assert.Equal(suite.T(), "same_step_1", foundSteps[1].StepName)
+	assert.Equal(suite.T(), []string{"weird], tag"}, foundSteps[1].Tags)
+}
+
+func (suite *UtilsSuite) Test_ParseNotebookErrors() {
+	_, err := utils.ParseNotebook([]byte(`{"nbformat": 3, "worksheets": []}`))
+	assert.Contains(suite.T(), err.Error(), "only nbformat 4 notebooks are supported")
+
+	_, err = utils.ParseNotebook([]byte(`not json`))
+	assert.Contains(suite.T(), err.Error(), "not a valid notebook")
+
+	_, err = utils.ParseNotebook([]byte(`{"nbformat": 4, "cells": [{"cell_type": "code", "metadata": {"tags": "same_step_1"}, "source": ""}]}`))
+	assert.Contains(suite.T(), err.Error(), "cell 0: metadata.tags must be a list of strings")
+
+	_, err = (&utils.CompileLive{}).FindAllSteps([]utils.NotebookCell{{CellType: utils.CellTypeCode, Tags: []string{"same_step_one"}}})
+	assert.Contains(suite.T(), err.Error(), "step tags must be 'same_step_' followed by a number")
+}
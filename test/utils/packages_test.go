@@ -0,0 +1,96 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// packagesCells is a notebook whose later steps use some of what the first step imports and makes
+var packagesCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "import numpy as np\nimport pandas as pd\nimport requests\nfrom sklearn.svm import SVC\n\ndf = pd.read_csv('data.csv')\nmodel = SVC()\ncount = 3"},
+	{CellType: utils.CellTypeCode, Source: "values = np.zeros(count)", Tags: []string{"same_step_1"}},
+	{CellType: utils.CellTypeCode, Source: "del df, model, SVC\ntotal = sum(values)", Tags: []string{"same_step_2"}},
+	{CellType: utils.CellTypeCode, Source: "print(total)", Tags: []string{"same_step_3"}},
+}
+
+func (suite *UtilsSuite) Test_ContextModules() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// Imported modules are only needed by the steps using them, but values are needed to load the context.
+	// 'total' comes from 'values', which comes from numpy.
+	contextModules, err := utils.ContextModules(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string][]string{
+		"same_step_0": {},
+		"same_step_1": {"numpy", "pandas", "sklearn"},
+		"same_step_2": {"numpy", "pandas", "sklearn"},
+		"same_step_3": {"numpy"},
+	}, contextModules)
+
+	contextDistributions, err := utils.ContextDistributions(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"numpy", "pandas", "scikit-learn"}, contextDistributions["same_step_1"])
+}
+
+func (suite *UtilsSuite) Test_ContextModulesTracing() {
+	traced := map[string][]string{
+		"import torch\ndef make():\n    return torch.zeros(1)\n":                                         {"torch"},
+		"import torch\nclass Model(torch.nn.Module):\n    pass\n":                                        {"torch"},
+		"import numpy as np\nx = 1\nx += np.ones(1)\n":                                                   {"numpy"},
+		"import numpy as np\nx = np.ones(1)\nx = 1\n":                                                    {},
+		"import pandas as pd\nwith open('a') as f: data = pd.read_csv(f)\n":                              {"pandas"},
+		"import pandas as pd\ndata = {}\ndata['a'] = pd.DataFrame()\n":                                   {"pandas"},
+		"import numpy as np\nfor row in np.eye(2):\n    pass\n":                                          {"numpy"},
+		"import numpy as np\na, (b, *c) = 1, np.ones(3)\n":                                               {"numpy"},
+		"import numpy as np\nscale: float = np.float64(2)\n":                                             {"numpy"},
+		"import numpy as np\nsame = 1 == np.ones(1)\n":                                                   {"numpy"},
+		"import numpy as np\nf = lambda x=1: np.ones(x)\n":                                               {"numpy"},
+		"import numpy as np\nif np.ones(1) >= 0: flag = True\n":                                          {},
+		"from . import helpers\nresult = helpers.run()\n":                                                {},
+		"import pickle\nfrom sklearn.svm import SVC\nwith open('m') as f:\n    model = pickle.load(f)\n": {"pickle", "sklearn"},
+		"import joblib\nimport torch\nmodel = joblib.load('m')\n":                                        {"joblib", "torch"},
+		"import numpy as np\ndef local():\n    x = np.ones(1)\n    return 1\ny = local\n":                {"numpy"},
+	}
+	for source, expected := range traced {
+		// The second step reads its variables dynamically, so it loads all of them
+		contextModules, err := utils.ContextModules(map[string]utils.CodeBlock{
+			"same_step_0": {StepIdentifier: "same_step_0", Code: source},
+			"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: "print(globals())", Parents: []string{"same_step_0"}},
+		})
+		assert.NoError(suite.T(), err, source)
+		assert.Equal(suite.T(), expected, contextModules["same_step_1"], source)
+	}
+}
+
+func (suite *UtilsSuite) Test_PerStepPackages() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// The packages a step imports are set by 'same program compile', see WriteStepFiles
+	step := aggregatedSteps["same_step_0"]
+	step.PackagesToInstall = map[string]string{"numpy": "", "pandas": "", "requests": "", "scikit-learn": ""}
+	aggregatedSteps["same_step_0"] = step
+
+	sameConfigFile := &loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "packages"
+
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, `packages_to_install=["dill", "requests", "numpy","pandas","requests","scikit-learn",],`)
+	assert.Contains(suite.T(), kfpRootFile, `packages_to_install=["dill", "requests", "numpy","pandas","scikit-learn",],`)
+	assert.Contains(suite.T(), kfpRootFile, `packages_to_install=["dill", "requests", "numpy",],`)
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, `config_same_step_3.environment = config_default.environment.clone("COMPUTE_same_step_3")`)
+	assert.Contains(suite.T(), amlRootFile, "\tfor package in [\"numpy\",]:\n\t\tconfig_same_step_3.environment.python.conda_dependencies.add_pip_package(package)\n")
+	assert.Contains(suite.T(), amlRootFile, "runconfig=config_same_step_3,")
+}
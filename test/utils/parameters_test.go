@@ -0,0 +1,168 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ParameterCheckValue() {
+	min, max := 1.0, 10.0
+	epochs := loaders.Parameter{Type: "int", Min: &min, Max: &max}
+
+	value, err := epochs.ParseValue("5")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5, value)
+
+	_, err = epochs.ParseValue("five")
+	assert.EqualError(suite.T(), err, "'five' is not a valid int")
+	_, err = epochs.ParseValue("11")
+	assert.EqualError(suite.T(), err, "'11' is more than the maximum of 10")
+	_, err = epochs.CheckValue(0.5)
+	assert.EqualError(suite.T(), err, "expected a int, found float '0.5'")
+
+	learningRate := loaders.Parameter{Type: "float"}
+	value, err = learningRate.CheckValue(1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1.0, value)
+
+	optimizer := loaders.Parameter{Enum: []interface{}{"adam", "sgd"}}
+	_, err = optimizer.ParseValue("rmsprop")
+	assert.EqualError(suite.T(), err, "'rmsprop' is not one of: adam, sgd")
+
+	layers := loaders.Parameter{Type: "list"}
+	value, err = layers.ParseValue("[64, 32]")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []interface{}{64.0, 32.0}, value)
+	_, err = layers.ParseValue(`{"a": 1}`)
+	assert.EqualError(suite.T(), err, `expected a list, found dict '{"a":1}'`)
+
+	assert.Equal(suite.T(), "bool", loaders.Parameter{Default: true}.ParameterType())
+	assert.Equal(suite.T(), "string", loaders.Parameter{}.ParameterType())
+}
+
+func (suite *UtilsSuite) Test_EncodeParameterValue() {
+	assert.Equal(suite.T(), "hello", loaders.EncodeParameterValue("hello"))
+	assert.Equal(suite.T(), "0.841", loaders.EncodeParameterValue(0.841))
+	assert.Equal(suite.T(), "true", loaders.EncodeParameterValue(true))
+	assert.Equal(suite.T(), "[64,32]", loaders.EncodeParameterValue([]interface{}{64, 32}))
+	assert.Equal(suite.T(), `{"cat":0}`, loaders.EncodeParameterValue(map[interface{}]interface{}{"cat": 0}))
+}
+
+func (suite *UtilsSuite) Test_ResolveRunParameters() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/parameters/same.yaml")
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), sameConfigFile.Spec.ValidateParameters())
+
+	resolved, err := sameConfigFile.Spec.ResolveRunParameters(sameConfigFile.Spec.Run, map[string]string{"optimizer": "sgd", "labels": `{"bird": 2}`})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]interface{}{
+		"epochs":        20,
+		"learning_rate": 0.01,
+		"optimizer":     "sgd",
+		"layers":        []interface{}{64, 32},
+		"augment":       false,
+		"labels":        map[string]interface{}{"bird": 2.0},
+	}, resolved)
+
+	smoke, err := sameConfigFile.Spec.RunProfile("smoke")
+	assert.NoError(suite.T(), err)
+	resolved, err = sameConfigFile.Spec.ResolveRunParameters(smoke, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, resolved["epochs"])
+	assert.Equal(suite.T(), []interface{}{8}, resolved["layers"])
+
+	_, err = sameConfigFile.Spec.ResolveRunParameters(sameConfigFile.Spec.Run, map[string]string{"epochs": "0", "optimizer": "rmsprop", "dropout": "0.5"})
+	assert.EqualError(suite.T(), err, `invalid run parameters:
+dropout: is not declared under 'parameters', expected one of: augment, epochs, labels, layers, learning_rate, optimizer
+epochs: '0' is less than the minimum of 1
+optimizer: 'rmsprop' is not one of: adam, sgd`)
+}
+
+func (suite *UtilsSuite) Test_InterpolateRunParameters() {
+	// References resolve to the parameters the run starts with, declared defaults and the run profile included
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/parameters/interpolated.yaml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "adam for 20 epochs", sameConfigFile.Spec.Run.Description)
+
+	sameConfigFile, err = loaders.V1{Params: map[string]string{"optimizer": "sgd"}, Profile: "smoke"}.LoadSAME("../testdata/parameters/interpolated.yaml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "sgd for 1 epochs", sameConfigFile.Spec.Run.Description)
+
+	// Parameters are checked the same way as when the run is started
+	_, err = loaders.V1{Params: map[string]string{"dropout": "0.5"}}.LoadSAME("../testdata/parameters/interpolated.yaml")
+	assert.Contains(suite.T(), err.Error(), "dropout: is not declared under 'parameters'")
+	_, err = loaders.V1{Params: map[string]string{"epochs": "many"}}.LoadSAME("../testdata/parameters/interpolated.yaml")
+	assert.Contains(suite.T(), err.Error(), "epochs: 'many' is not a valid int")
+}
+
+func (suite *UtilsSuite) Test_ValidateParameterDeclarations() {
+	min, max := 10.0, 1.0
+	spec := loaders.SameSpec{
+		Parameters: map[string]loaders.Parameter{
+			"epochs":    {Type: "integer"},
+			"batch":     {Type: "int", Min: &min, Max: &max},
+			"optimizer": {Type: "string", Default: "adam", Enum: []interface{}{"sgd", 1}},
+			"required":  {Type: "int"},
+		},
+	}
+	assert.EqualError(suite.T(), spec.ValidateParameters(), `parameters.batch: min (10) is more than max (1)
+parameters.epochs.type: unknown type 'integer', expected one of: string, int, float, bool, list, dict
+parameters.optimizer.enum: expected a string, found int '1'
+parameters.optimizer.default: 'adam' is not one of: sgd, 1`)
+
+	spec = loaders.SameSpec{
+		Parameters: map[string]loaders.Parameter{"required": {Type: "int"}},
+		Runs:       map[string]loaders.Run{"smoke": {Parameters: map[string]interface{}{"required": "one"}}},
+	}
+	assert.EqualError(suite.T(), spec.ValidateParameters(), `runs.smoke.parameters.required: expected a int, found string 'one'`)
+
+	_, err := spec.ResolveRunParameters(spec.Run, nil)
+	assert.Error(suite.T(), err)
+	_, err = loaders.SameSpec{Parameters: map[string]loaders.Parameter{"required": {Type: "int"}}}.ResolveRunParameters(loaders.Run{}, nil)
+	assert.EqualError(suite.T(), err, "invalid run parameters:\nrequired: has no default and was not set by the run or with --run-param")
+}
+
+func (suite *UtilsSuite) Test_RunParametersInGeneratedFiles() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/parameters/same.yaml")
+	assert.NoError(suite.T(), err)
+	sameConfigFile.Spec.ConfigFilePath = "../testdata/parameters/same.yaml"
+
+	runParameters := utils.RunParameters(*sameConfigFile)
+	assert.Equal(suite.T(), []utils.RunParameter{
+		{Name: "augment", Type: "bool", Default: "'false'"},
+		{Name: "epochs", Type: "int", Default: "'20'"},
+		{Name: "labels", Type: "dict", Default: `'{"cat":0,"dog":1}'`},
+		{Name: "layers", Type: "list", Default: "'[64,32]'"},
+		{Name: "learning_rate", Type: "float", Default: "'0.01'"},
+		{Name: "optimizer", Type: "string", Default: "'adam'"},
+	}, runParameters)
+
+	aggregatedSteps := map[string]utils.CodeBlock{
+		"same_step_0": {StepIdentifier: "same_step_0", EnvironmentName: "default", Code: "print(layers)", RunParameters: runParameters},
+	}
+
+	c := &utils.CompileLive{}
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, `def root(augment='false', epochs='20', labels='{"cat":0,"dog":1}', layers='[64,32]', learning_rate='0.01', optimizer='adam', context='', metadata_url=''):`)
+	assert.Contains(suite.T(), kfpRootFile, `metadata_url=metadata_url, augment=augment, epochs=epochs, labels=labels, layers=layers, learning_rate=learning_rate, optimizer=optimizer)`)
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, "\"--layers\",\n\t\t\tlayers,")
+
+	compiledDir, err := ioutil.TempDir("", "SAME-parameters-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(compiledDir)
+	_, err = c.WriteStepFiles("kubeflow", compiledDir, aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	stepFile, err := ioutil.ReadFile(filepath.Join(compiledDir, "same_step_0.py"))
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(stepFile), "\tlayers='[64,32]',\n")
+	assert.Contains(suite.T(), string(stepFile), `"layers": __decode_parameter(layers, "list"),`)
+}
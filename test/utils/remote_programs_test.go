@@ -0,0 +1,104 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+// newProgramRepo creates a repository with a SAME file and the notebook it uses in a subdirectory, tagged v1.2.
+func (suite *UtilsSuite) newProgramRepo() string {
+	dir, err := ioutil.TempDir("", "SAME-remote-*")
+	assert.NoError(suite.T(), err)
+
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), os.MkdirAll(filepath.Join(dir, "subdir"), 0700))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(dir, "subdir", "custom.yaml"), []byte("metadata:\n  name: remote\npipeline:\n  package: notebook.ipynb\n"), 0600))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(dir, "subdir", "notebook.ipynb"), []byte("{}"), 0600))
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(dir, "same.yaml"), []byte("metadata:\n  name: root\n"), 0600))
+
+	worktree, err := repo.Worktree()
+	assert.NoError(suite.T(), err)
+	_, err = worktree.Add(".")
+	assert.NoError(suite.T(), err)
+	commit, err := worktree.Commit("Add program", &git.CommitOptions{Author: &object.Signature{Name: "SAME", Email: "same@example.com", When: time.Now()}})
+	assert.NoError(suite.T(), err)
+	_, err = repo.CreateTag("v1.2", commit, nil)
+	assert.NoError(suite.T(), err)
+
+	// Later commits must not show up in a fetch of v1.2
+	assert.NoError(suite.T(), ioutil.WriteFile(filepath.Join(dir, "subdir", "later.py"), []byte("print('later')\n"), 0600))
+	_, err = worktree.Add("subdir/later.py")
+	assert.NoError(suite.T(), err)
+	_, err = worktree.Commit("Add later.py", &git.CommitOptions{Author: &object.Signature{Name: "SAME", Email: "same@example.com", When: time.Now()}})
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("master")}))
+
+	return dir
+}
+
+func (suite *UtilsSuite) Test_FetchRemoteProgram() {
+	repoDir := suite.newProgramRepo()
+	defer os.RemoveAll(repoDir)
+	cacheDir, err := ioutil.TempDir("", "SAME-cache-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(cacheDir)
+
+	source := "git::file://" + repoDir + "//subdir/custom.yaml?ref=v1.2"
+	program, err := utils.FetchRemoteProgram(source, cacheDir, "")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), program.Cached)
+	assert.Equal(suite.T(), "git::file://"+repoDir+"?ref=v1.2", program.Source)
+	assert.Equal(suite.T(), filepath.Join(program.Dir, "subdir", "custom.yaml"), program.ConfigFilePath)
+	assert.Equal(suite.T(), cacheDir, filepath.Dir(program.Dir))
+
+	// The whole tree at the ref is fetched, not just the SAME file
+	assert.FileExists(suite.T(), filepath.Join(program.Dir, "subdir", "notebook.ipynb"))
+	assert.FileExists(suite.T(), filepath.Join(program.Dir, "same.yaml"))
+	assert.NoFileExists(suite.T(), filepath.Join(program.Dir, "subdir", "later.py"))
+
+	// Later fetches reuse the cache, even for other files in the same tree
+	cached, err := utils.FetchRemoteProgram("git::file://"+repoDir+"?ref=v1.2", cacheDir, "")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), cached.Cached)
+	assert.Equal(suite.T(), program.Dir, cached.Dir)
+	assert.Equal(suite.T(), filepath.Join(program.Dir, "same.yaml"), cached.ConfigFilePath)
+
+	// A different ref is a different tree
+	latest, err := utils.FetchRemoteProgram("git::file://"+repoDir+"//subdir/custom.yaml", cacheDir, "")
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), latest.Cached)
+	assert.NotEqual(suite.T(), program.Dir, latest.Dir)
+	assert.FileExists(suite.T(), filepath.Join(latest.Dir, "subdir", "later.py"))
+}
+
+func (suite *UtilsSuite) Test_FetchRemoteProgramErrors() {
+	repoDir := suite.newProgramRepo()
+	defer os.RemoveAll(repoDir)
+	cacheDir, err := ioutil.TempDir("", "SAME-cache-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(cacheDir)
+
+	_, err = utils.FetchRemoteProgram("git::file://"+repoDir+"//missing.yaml", cacheDir, "")
+	assert.Contains(suite.T(), err.Error(), "could not find the SAME file 'missing.yaml'")
+
+	_, err = utils.FetchRemoteProgram("git::file://"+repoDir+"//../same.yaml", cacheDir, "")
+	assert.Contains(suite.T(), err.Error(), "must be inside the fetched tree")
+
+	// A failed fetch leaves nothing behind in the cache
+	_, err = utils.FetchRemoteProgram("git::file://"+filepath.Join(repoDir, "nothing-here"), cacheDir, "")
+	assert.Contains(suite.T(), err.Error(), "could not download")
+	entries, err := ioutil.ReadDir(cacheDir)
+	assert.NoError(suite.T(), err)
+	for _, entry := range entries {
+		assert.FileExists(suite.T(), filepath.Join(cacheDir, entry.Name(), "same.yaml"))
+	}
+}
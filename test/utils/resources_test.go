@@ -0,0 +1,117 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// resourceCells is a notebook with a small preprocessing step, a training step that needs a big machine and a
+// GPU, and an evaluation step that runs in an environment with its own default resources.
+var resourceCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeCode, Source: "data = load()"},
+	{CellType: utils.CellTypeCode, Source: "model = train(data)", Tags: []string{"same_step_1", "cpu=4", "memory=8Gi"}},
+	{CellType: utils.CellTypeCode, Source: "model.save()", Tags: []string{"same_step_1", "gpu_type=nvidia-tesla-t4"}},
+	{CellType: utils.CellTypeCode, Source: "evaluate(model)", Tags: []string{"same_step_2", "environment=eval", "memory=2Gi"}},
+}
+
+func resourcesSameConfig() loaders.SameConfig {
+	sameConfigFile := loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "resources"
+	sameConfigFile.Spec.Environments = map[string]loaders.Environment{
+		"eval": {
+			ImageTag:  "library/python:3.9-slim-buster",
+			Resources: loaders.StepResources{CPU: "500m", Memory: "1Gi"},
+		},
+	}
+	return sameConfigFile
+}
+
+func (suite *UtilsSuite) Test_StepResources() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(resourceCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// Resources set in any cell of a step add up
+	assert.Equal(suite.T(), loaders.StepResources{CPU: "4", Memory: "8Gi", GPUType: "nvidia-tesla-t4"}, aggregatedSteps["same_step_1"].Resources)
+
+	sameConfigFile := resourcesSameConfig()
+	resources, err := utils.ResolveStepResources(aggregatedSteps["same_step_1"], sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), loaders.StepResources{CPU: "4", Memory: "8Gi", GPU: "1", GPUType: "nvidia-tesla-t4"}, resources)
+
+	// Tags override the defaults of the environment
+	resources, err = utils.ResolveStepResources(aggregatedSteps["same_step_2"], sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), loaders.StepResources{CPU: "500m", Memory: "2Gi"}, resources)
+
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, "\tsame_step_1_task.set_cpu_limit(\"4\")\n\tsame_step_1_task.set_memory_limit(\"8Gi\")\n\tsame_step_1_task.set_gpu_limit(\"1\")\n")
+	assert.Contains(suite.T(), kfpRootFile, `same_step_1_task.add_node_selector_constraint("accelerator", "nvidia-tesla-t4")`)
+	assert.Contains(suite.T(), kfpRootFile, `same_step_2_task.set_cpu_limit("500m")`)
+	assert.NotContains(suite.T(), kfpRootFile, "same_step_0_task.set_cpu_limit")
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, `compute_target_samenc4as_t4_v3 = get_compute_target(ws, "samenc4as-t4-v3", "Standard_NC4as_T4_v3")`)
+	assert.Contains(suite.T(), amlRootFile, `compute_target_sameds2_v2 = get_compute_target(ws, "sameds2-v2", "Standard_DS2_v2")`)
+	assert.Contains(suite.T(), amlRootFile, "compute_target=compute_target_samenc4as_t4_v3,")
+	assert.Contains(suite.T(), amlRootFile, "compute_target=compute_target,")
+}
+
+func (suite *UtilsSuite) Test_StepResourceSizes() {
+	sizes := map[loaders.StepResources]string{
+		{CPU: "2"}:                                      "Standard_DS2_v2",
+		{CPU: "1500m", Memory: "10Gi"}:                  "Standard_DS3_v2",
+		{Memory: "100G"}:                                "Standard_E16s_v3",
+		{GPU: "1"}:                                      "Standard_NC4as_T4_v3",
+		{GPU: "2"}:                                      "Standard_NC64as_T4_v3",
+		{GPU: "1", GPUType: "nvidia-tesla-t4"}:          "Standard_NC4as_T4_v3",
+		{GPU: "1", GPUType: "nvidia-a10"}:               "Standard_NV36ads_A10_v5",
+		{GPU: "2", GPUType: "a100"}:                     "Standard_NC48ads_A100_v4",
+		{GPU: "1", GPUType: "Standard_NC24ads_A100_v4"}: "Standard_NC24ads_A100_v4",
+	}
+	for resources, expected := range sizes {
+		vmSize, err := utils.AMLVMSize(resources)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, vmSize, resources)
+	}
+
+	_, err := utils.AMLVMSize(loaders.StepResources{GPU: "1", GPUType: "nvidia-tesla-k80"})
+	assert.EqualError(suite.T(), err, "no AML VM size has 0 cores, 0Gi of memory and 1 GPUs of type nvidia-tesla-k80")
+
+	key, value := utils.GPUNodeSelector("cloud.google.com/gke-accelerator=nvidia-tesla-p100")
+	assert.Equal(suite.T(), "cloud.google.com/gke-accelerator", key)
+	assert.Equal(suite.T(), "nvidia-tesla-p100", value)
+
+	// A GPU type in the SAME file's resources applies to steps that ask for GPUs
+	sameConfigFile := resourcesSameConfig()
+	sameConfigFile.Spec.Resources.GPU.Type = "nvidia-tesla-v100"
+	resources, err := utils.ResolveStepResources(utils.CodeBlock{EnvironmentName: "default", Resources: loaders.StepResources{GPU: "2"}}, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "nvidia-tesla-v100", resources.GPUType)
+	resources, err = utils.ResolveStepResources(utils.CodeBlock{EnvironmentName: "default"}, sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), loaders.StepResources{}, resources)
+}
+
+func (suite *UtilsSuite) Test_StepResourceErrors() {
+	c := &utils.CompileLive{}
+	_, err := c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_1", "memory=8GB"}},
+	})
+	assert.EqualError(suite.T(), err, "invalid tag 'memory=8GB': memory must be a size with an optional unit (e.g. '8Gi' or '512Mi'), found: 8GB")
+
+	_, err = c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_1", "gpu=half"}},
+	})
+	assert.EqualError(suite.T(), err, "invalid tag 'gpu=half': gpu must be a whole number of GPUs, found: half")
+
+	sameConfigFile := resourcesSameConfig()
+	sameConfigFile.Spec.Environments["eval"] = loaders.Environment{Resources: loaders.StepResources{CPU: "two"}}
+	_, err = utils.ResolveStepResources(utils.CodeBlock{EnvironmentName: "eval"}, sameConfigFile)
+	assert.EqualError(suite.T(), err, "environment eval: resources: cpu must be a number of cores (e.g. '2') or millicores (e.g. '500m'), found: two")
+}
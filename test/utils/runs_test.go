@@ -0,0 +1,61 @@
+package utils_test
+
+import (
+	"io/ioutil"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_RunProfiles() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/runs/same.yaml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"full", "smoke"}, sameConfigFile.Spec.RunProfileNames())
+
+	run, err := sameConfigFile.Spec.RunProfile("")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "default-run", run.Name)
+	assert.Equal(suite.T(), "RunProfilesSample", run.Experiment)
+	assert.Equal(suite.T(), map[string]interface{}{"epochs": 10, "learning_rate": 0.01}, run.Parameters)
+
+	run, err = sameConfigFile.Spec.RunProfile("smoke")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "smoke", run.Name)
+	assert.Equal(suite.T(), "A quick check that the pipeline still runs end to end", run.Description)
+	assert.Equal(suite.T(), "RunProfilesSample", run.Experiment)
+	assert.Equal(suite.T(), map[string]interface{}{"epochs": 1, "learning_rate": 0.01}, run.Parameters)
+
+	run, err = sameConfigFile.Spec.RunProfile("full")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "full-training", run.Name)
+	assert.Equal(suite.T(), "RunProfilesSample-full", run.Experiment)
+	assert.Equal(suite.T(), map[string]interface{}{"epochs": 100, "learning_rate": 0.01, "batch_size": 256}, run.Parameters)
+
+	// Picking a profile must not change the run in the file
+	assert.Equal(suite.T(), 10, sameConfigFile.Spec.Run.Parameters["epochs"])
+
+	_, err = sameConfigFile.Spec.RunProfile("nightly")
+	assert.EqualError(suite.T(), err, "no run profile named 'nightly', expected one of: full, smoke")
+}
+
+func (suite *UtilsSuite) Test_RunProfilesValidateAndMigrate() {
+	assert.NoError(suite.T(), loaders.ValidateSAME("../testdata/runs/same.yaml", nil, ""))
+
+	errs := loaders.ValidateSAMEBytes("same.yaml", []byte(`
+metadata:
+  name: test
+pipeline:
+  package: sample.ipynb
+runs:
+  smoke:
+    parameters: [1, 2]
+`))
+	assert.Len(suite.T(), errs, 1)
+
+	contents, err := ioutil.ReadFile("../testdata/runs/same.yaml")
+	assert.NoError(suite.T(), err)
+	migrated, err := loaders.MigrateSAMEBytes("same.yaml", contents, loaders.LatestAPIVersion)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(migrated), "runs:\n  smoke:")
+	assert.Contains(suite.T(), string(migrated), "experiment: RunProfilesSample-full")
+}
@@ -0,0 +1,101 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_DetectSourceFormat() {
+	formats := map[string]string{
+		"../testdata/notebook/sample_notebook.ipynb": utils.SourceFormatNotebook,
+		"../testdata/scripts/percent.py":             utils.SourceFormatPercent,
+		"../testdata/scripts/light.lgt.py":           utils.SourceFormatLight,
+		"../testdata/scripts/kfp_pipeline.py":        "",
+	}
+	for filePath, expected := range formats {
+		cells, err := utils.ReadPipelineSource(filePath)
+		if expected == "" {
+			assert.Contains(suite.T(), err.Error(), "kfp_pipeline.py has no cell markers")
+			assert.False(suite.T(), utils.IsNotebookSource(filePath))
+			continue
+		}
+		assert.NoError(suite.T(), err, filePath)
+		assert.NotEmpty(suite.T(), cells, filePath)
+		assert.True(suite.T(), utils.IsNotebookSource(filePath), filePath)
+	}
+
+	// Without a header or telling extension, the cell markers give the format away
+	format, err := utils.DetectSourceFormat("steps.py", []byte("import os\n# %% tags=[\"same_step_1\"]\nprint(1)\n"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), utils.SourceFormatPercent, format)
+	format, err = utils.DetectSourceFormat("steps.py", []byte("import os\n# +\nprint(1)\n"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), utils.SourceFormatLight, format)
+	format, err = utils.DetectSourceFormat("steps.pct.py", []byte("print(1)\n"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), utils.SourceFormatPercent, format)
+
+	_, err = utils.DetectSourceFormat("steps.py", []byte("# ---\n# jupyter:\n#   jupytext:\n#     text_representation:\n#       format_name: sphinx\n# ---\n"))
+	assert.Contains(suite.T(), err.Error(), "is in the jupytext 'sphinx' format")
+	_, err = utils.DetectSourceFormat("steps.R", []byte(""))
+	assert.Contains(suite.T(), err.Error(), "expected an .ipynb notebook or a .py script")
+}
+
+func (suite *UtilsSuite) Test_ParsePercentScript() {
+	cells, err := utils.ReadPipelineSource("../testdata/scripts/percent.py")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6, len(cells))
+	assert.Equal(suite.T(), utils.CellTypeMarkdown, cells[0].CellType)
+	assert.Equal(suite.T(), []string{"parameters"}, cells[1].Tags)
+	assert.Equal(suite.T(), "dataset = 'sample_data'", cells[1].Source)
+	assert.Equal(suite.T(), []string{"same_step_1", "cache=P1D"}, cells[3].Tags)
+	assert.Equal(suite.T(), "# %matplotlib inline\nimport numpy as np\n\nb = np.sqrt(a)", cells[3].Source)
+
+	// Cells map to steps the same way notebook cells do
+	foundSteps, err := (&utils.CompileLive{}).FindAllSteps(cells)
+	assert.NoError(suite.T(), err)
+	codeBlocks, err := (&utils.CompileLive{}).CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(codeBlocks))
+	assert.Contains(suite.T(), codeBlocks["same_step_0"].Code, "import datetime")
+	assert.NotContains(suite.T(), codeBlocks["same_step_0"].Code, "Sample percent script")
+	assert.Contains(suite.T(), codeBlocks["same_step_1"].Code, `print(f"b: {b}")`)
+	assert.Equal(suite.T(), "P1D", codeBlocks["same_step_1"].CacheValue)
+	assert.Contains(suite.T(), codeBlocks["same_step_2"].Code, "datetime.datetime.now()")
+
+	// Code before the first marker is a cell of its own
+	cells, err = utils.ParsePercentScript("import os\n\n# %% [md]\n# Notes\n# %% tags=[\"same_step_1\"] other={\"a\": [1, 2]}\nprint(1)\n")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(cells))
+	assert.Equal(suite.T(), "import os", cells[0].Source)
+	assert.Equal(suite.T(), map[string]interface{}{"a": []interface{}{float64(1), float64(2)}}, cells[2].Metadata["other"])
+}
+
+func (suite *UtilsSuite) Test_ParseLightScript() {
+	cells, err := utils.ReadPipelineSource("../testdata/scripts/light.lgt.py")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, len(cells))
+	assert.Equal(suite.T(), "dataset = 'sample_data'", cells[0].Source)
+	assert.Equal(suite.T(), []string{"same_step_1"}, cells[1].Tags)
+	assert.Equal(suite.T(), "import numpy as np\n\nb = np.sqrt(10)", cells[1].Source)
+	assert.Nil(suite.T(), cells[2].Tags)
+	assert.Equal(suite.T(), []string{"same_step_2", "environment=default"}, cells[3].Tags)
+
+	foundSteps, err := (&utils.CompileLive{}).FindAllSteps(cells)
+	assert.NoError(suite.T(), err)
+	codeBlocks, err := (&utils.CompileLive{}).CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(codeBlocks))
+	assert.Contains(suite.T(), codeBlocks["same_step_1"].Code, `print(f"b: {b}")`)
+}
+
+func (suite *UtilsSuite) Test_ParseScriptErrors() {
+	_, err := utils.ParsePercentScript("# ---\n# jupyter: {}\n# ---\n\n# %% tags=[\"same_step_1\"\nprint(1)\n")
+	assert.Contains(suite.T(), err.Error(), "line 5: the value of 'tags' in the cell marker is not valid JSON")
+
+	_, err = utils.ParsePercentScript("# %% [unknown]\n")
+	assert.Contains(suite.T(), err.Error(), "line 1: unknown cell type 'unknown'")
+
+	_, err = utils.ParseLightScript("print(1)\n# + tags=\"same_step_1\"\n")
+	assert.Contains(suite.T(), err.Error(), "line 2: tags must be a list of strings")
+}
@@ -0,0 +1,103 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var sourceMapCells = []utils.NotebookCell{
+	{CellType: utils.CellTypeMarkdown, Source: "# Training"},
+	{CellType: utils.CellTypeCode, Source: "x = 1\nprint(x)"},
+	{CellType: utils.CellTypeCode, Source: "y = x + 1", Tags: []string{"same_step_1"}},
+	{CellType: utils.CellTypeMarkdown, Source: "Now it fails"},
+	{CellType: utils.CellTypeCode, Source: "z = y\n\nraise ValueError('boom')"},
+}
+
+func (suite *UtilsSuite) Test_SourceCells() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(sourceMapCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// Cells are counted as Jupyter shows them, markdown cells included
+	assert.Equal(suite.T(), []utils.SourceCell{{Cell: 2, StepLine: 1, Lines: 2}}, aggregatedSteps["same_step_0"].SourceCells)
+	assert.Equal(suite.T(), []utils.SourceCell{{Cell: 3, StepLine: 1, Lines: 1}, {Cell: 5, StepLine: 3, Lines: 3}}, aggregatedSteps["same_step_1"].SourceCells)
+
+	// Without step tags, the cells are joined by blank lines into one step
+	untaggedCells := make([]utils.NotebookCell, 0, len(sourceMapCells))
+	for _, cell := range sourceMapCells {
+		cell.Tags = nil
+		untaggedCells = append(untaggedCells, cell)
+	}
+	foundSteps, err = c.FindAllSteps(untaggedCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err = c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []utils.SourceCell{{Cell: 2, StepLine: 1, Lines: 2}, {Cell: 3, StepLine: 4, Lines: 1}, {Cell: 5, StepLine: 6, Lines: 3}}, aggregatedSteps["same_step_0"].SourceCells)
+}
+
+func (suite *UtilsSuite) Test_SourceMapsOfStepFiles() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(sourceMapCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+	for stepName, codeBlock := range aggregatedSteps {
+		codeBlock.SourceFile = "training.ipynb"
+		aggregatedSteps[stepName] = codeBlock
+	}
+
+	for _, target := range []string{"kubeflow", "aml"} {
+		compiledDir, err := ioutil.TempDir("", "same-source-map-")
+		assert.NoError(suite.T(), err)
+		defer os.RemoveAll(compiledDir)
+
+		_, err = c.WriteStepFiles(target, compiledDir, aggregatedSteps)
+		assert.NoError(suite.T(), err, target)
+		sourceMaps, err := utils.ReadSourceMaps(compiledDir)
+		assert.NoError(suite.T(), err, target)
+		assert.Equal(suite.T(), []utils.StepSourceMap{
+			{Step: "same_step_0", Notebook: "training.ipynb", Offset: 6, Cells: []utils.SourceCell{{Cell: 2, StepLine: 1, Lines: 2}}},
+			{Step: "same_step_1", Notebook: "training.ipynb", Offset: 6, Cells: []utils.SourceCell{{Cell: 3, StepLine: 1, Lines: 1}, {Cell: 5, StepLine: 3, Lines: 3}}},
+		}, sourceMaps, target)
+
+		// Each step carries its own source map, so it can be read back from the program of a run
+		stepFile := filepath.Join(compiledDir, "same_step_1.py")
+		if target == "aml" {
+			stepFile = filepath.Join(compiledDir, "same_step_1", "same_step_1.py")
+		}
+		stepBytes, err := ioutil.ReadFile(stepFile)
+		assert.NoError(suite.T(), err, target)
+		assert.Contains(suite.T(), string(stepBytes), `exec(compile(__inner_code_to_execute, "<same_step_1>", "exec"), __variables_to_mount, __loc)`, target)
+		assert.Equal(suite.T(), sourceMaps[1:], utils.FindSourceMaps(string(stepBytes)), target)
+	}
+}
+
+func (suite *UtilsSuite) Test_RewriteTracebacks() {
+	sourceMaps := []utils.StepSourceMap{
+		{Step: "same_step_1", Notebook: "training.ipynb", Offset: 6, Cells: []utils.SourceCell{{Cell: 3, StepLine: 1, Lines: 1}, {Cell: 5, StepLine: 3, Lines: 3}}},
+	}
+	logs := `Traceback (most recent call last):
+  File "/tmp/tmp.kbPq3/ephemeral_component.py", line 146, in __inner_main
+    exec(compile(__inner_code_to_execute, "<same_step_1>", "exec"), __variables_to_mount, __loc)
+  File "<same_step_1>", line 11, in <module>
+  File "<same_step_1>", line 7, in helper
+  File "<same_step_1>", line 40, in <module>
+  File "<same_step_2>", line 8, in <module>
+ValueError: boom
+`
+	assert.Equal(suite.T(), `Traceback (most recent call last):
+  File "/tmp/tmp.kbPq3/ephemeral_component.py", line 146, in __inner_main
+    exec(compile(__inner_code_to_execute, "<same_step_1>", "exec"), __variables_to_mount, __loc)
+  File "training.ipynb", cell 5, line 3, in <module>
+  File "training.ipynb", cell 3, line 1, in helper
+  File "<same_step_1>", line 40, in <module>
+  File "<same_step_2>", line 8, in <module>
+ValueError: boom
+`, utils.RewriteTracebacks(logs, sourceMaps))
+}
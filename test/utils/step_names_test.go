@@ -0,0 +1,112 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_StepOrderIsNumeric() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_10"}},
+		{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"same_step_2"}},
+		{CellType: utils.CellTypeCode, Source: "c = 1", Tags: []string{"same_step_1"}},
+	})
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), []string{"same_step_1", "same_step_2", "same_step_10"}, utils.SortedStepNames(aggregatedSteps))
+	order, err := utils.StepOrder(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"same_step_1", "same_step_2", "same_step_10"}, order)
+	assert.Equal(suite.T(), []string{"same_step_2"}, aggregatedSteps["same_step_10"].Parents)
+}
+
+func (suite *UtilsSuite) Test_NamedSteps() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "import pandas"},
+		{CellType: utils.CellTypeCode, Source: "data = load()", Tags: []string{"same_step=load_data"}},
+		{CellType: utils.CellTypeCode, Source: "data = clean(data)"},
+		{CellType: utils.CellTypeCode, Source: "model = train(data)", Tags: []string{"step=train", "order=5"}},
+		{CellType: utils.CellTypeCode, Source: "model.save()", Tags: []string{"step=train"}},
+		{CellType: utils.CellTypeCode, Source: "report(data)", Tags: []string{"step=report", "depends_on=load_data"}},
+	})
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// Named steps follow the steps above them, unless they are given an order
+	assert.Equal(suite.T(), []string{"same_step_0", "same_step_load_data", "same_step_train", "same_step_report"}, utils.SortedStepNames(aggregatedSteps))
+	assert.Equal(suite.T(), 1, aggregatedSteps["same_step_load_data"].Index)
+	assert.Equal(suite.T(), 5, aggregatedSteps["same_step_train"].Index)
+	assert.Equal(suite.T(), 6, aggregatedSteps["same_step_report"].Index)
+	assert.Equal(suite.T(), "load_data", aggregatedSteps["same_step_load_data"].DisplayName)
+	assert.Equal(suite.T(), "data = load()\n\ndata = clean(data)\n\n", aggregatedSteps["same_step_load_data"].Code)
+	assert.Equal(suite.T(), "model = train(data)\n\nmodel.save()\n\n", aggregatedSteps["same_step_train"].Code)
+	assert.Equal(suite.T(), []string{"same_step_load_data"}, aggregatedSteps["same_step_train"].Parents)
+	assert.Equal(suite.T(), []string{"same_step_load_data"}, aggregatedSteps["same_step_report"].Parents)
+
+	sameConfigFile := &loaders.SameConfig{}
+	sameConfigFile.Spec.Metadata.Name = "named"
+	kfpRootFile, err := c.CreateRootFile("kubeflow", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), kfpRootFile, "import same_step_load_data\n")
+	assert.Contains(suite.T(), kfpRootFile, `same_step_train_task.set_display_name("train")`)
+
+	amlRootFile, err := c.CreateRootFile("aml", aggregatedSteps, *sameConfigFile)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), amlRootFile, `name="load_data",`)
+	assert.Contains(suite.T(), amlRootFile, "run_pipeline_definition = [same_step_0_step, same_step_load_data_step, same_step_train_step, same_step_report_step]")
+}
+
+func (suite *UtilsSuite) Test_NamedStepErrors() {
+	errors := map[string][]utils.NotebookCell{
+		"steps train and evaluate both have the order index 2": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=train", "order=2"}},
+			{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=evaluate", "order=2"}},
+		},
+		"steps same_step_1 and train both have the order index 1": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_1"}},
+			{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=train", "order=1"}},
+		},
+		"step train has two order indexes, 1 and 3": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=train", "order=1"}},
+			{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=train", "order=3"}},
+		},
+		"two steps are named train, in cells 1 and 4 - give them different names": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=train"}},
+			{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=evaluate"}},
+			{CellType: utils.CellTypeMarkdown, Source: "# Again"},
+			{CellType: utils.CellTypeCode, Source: "c = 1", Tags: []string{"step=train"}},
+		},
+		"step train is tagged both 'same_step=train' and 'step=train' - use one of them": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step=train"}},
+			{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=train"}},
+		},
+		"step names must start with a letter and only hold letters, digits and underscores, found: step=load-data": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=load-data"}},
+		},
+		"order must be a whole number, found: order=first": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=train", "order=first"}},
+		},
+		"step tags must be 'same_step_' followed by a number, or 'step=' followed by a name, found: same_step_train": {
+			{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"same_step_train"}},
+		},
+	}
+	for expected, cells := range errors {
+		_, err := (&utils.CompileLive{}).FindAllSteps(cells)
+		assert.EqualError(suite.T(), err, expected)
+	}
+
+	// Dependencies and cycles are reported with the names the steps were given
+	foundSteps, err := (&utils.CompileLive{}).FindAllSteps([]utils.NotebookCell{
+		{CellType: utils.CellTypeCode, Source: "a = 1", Tags: []string{"step=train", "depends_on=evaluate"}},
+		{CellType: utils.CellTypeCode, Source: "b = 1", Tags: []string{"step=evaluate"}},
+	})
+	assert.NoError(suite.T(), err)
+	_, err = (&utils.CompileLive{}).CombineCodeSlicesToSteps(foundSteps)
+	assert.EqualError(suite.T(), err, "the step dependencies form a cycle: train -> evaluate -> train")
+}
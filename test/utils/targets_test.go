@@ -0,0 +1,55 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ResolveTarget() {
+	target, err := loaders.ResolveTarget("", "", "")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "kubeflow", target)
+
+	target, err = loaders.ResolveTarget("", "", "aml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "aml", target)
+
+	target, err = loaders.ResolveTarget("", "kubeflow", "aml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "kubeflow", target)
+
+	target, err = loaders.ResolveTarget("aml", "kubeflow", "kubeflow")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "aml", target)
+
+	_, err = loaders.ResolveTarget("argo", "kubeflow", "")
+	assert.EqualError(suite.T(), err, "--target: unknown target 'argo', expected one of: kubeflow, aml")
+	_, err = loaders.ResolveTarget("", "", "sagemaker")
+	assert.EqualError(suite.T(), err, "the 'target' setting: unknown target 'sagemaker', expected one of: kubeflow, aml")
+}
+
+func (suite *UtilsSuite) Test_LoadSAMEWorkflowType() {
+	sameConfigFile, err := loaders.V1{}.LoadSAME("../testdata/same.yaml")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "kubeflow", sameConfigFile.Spec.Workflow.Type)
+
+	dir, err := ioutil.TempDir("", "SAME-target-*")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(dir)
+	sameFilePath := filepath.Join(dir, "same.yaml")
+	assert.NoError(suite.T(), ioutil.WriteFile(sameFilePath, []byte(`
+metadata:
+  name: test
+pipeline:
+  package: sample.ipynb
+workflow:
+  type: airflow
+`), 0600))
+
+	_, err = loaders.V1{}.LoadSAME(sameFilePath)
+	assert.EqualError(suite.T(), err, "workflow.type in "+sameFilePath+": unknown target 'airflow', expected one of: kubeflow, aml")
+}
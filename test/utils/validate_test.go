@@ -0,0 +1,68 @@
+package utils_test
+
+import (
+	"github.com/azure-octo/same-cli/cmd/sameconfig/loaders"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_ValidateGoodSAMEFile() {
+	err := loaders.ValidateSAME("../testdata/notebook/same.yaml", nil, "")
+	assert.NoError(suite.T(), err, "Expected the sample notebook SAME file to be valid")
+}
+
+func (suite *UtilsSuite) Test_ValidateUnknownKey() {
+	errs := loaders.ValidateSAMEBytes("same.yaml", []byte(`apiVersion: projectsame.io/v1alpha1
+metadata:
+  name: Sample
+pipeline:
+  package: sample.ipynb
+enviroments:
+  default:
+    image_tag: library/python:3.9-slim-buster
+`))
+	assert.Len(suite.T(), errs, 1)
+	assert.Equal(suite.T(), 6, errs[0].Line)
+	assert.Equal(suite.T(), 1, errs[0].Column)
+	assert.Equal(suite.T(), "enviroments", errs[0].Path)
+	assert.Contains(suite.T(), errs[0].Error(), "did you mean 'environments'?")
+}
+
+func (suite *UtilsSuite) Test_ValidateWrongType() {
+	errs := loaders.ValidateSAMEBytes("same.yaml", []byte(`apiVersion: projectsame.io/v1alpha1
+metadata:
+  name: Sample
+pipeline:
+  package: sample.ipynb
+environments:
+  default:
+    private_registry: sometimes
+    packages: numpy
+`))
+	assert.Len(suite.T(), errs, 2)
+	assert.Equal(suite.T(), "environments.default.private_registry", errs[0].Path)
+	assert.Equal(suite.T(), 8, errs[0].Line)
+	assert.Contains(suite.T(), errs[0].Message, "expected a bool")
+	assert.Equal(suite.T(), "environments.default.packages", errs[1].Path)
+	assert.Contains(suite.T(), errs[1].Message, "expected a list")
+}
+
+func (suite *UtilsSuite) Test_ValidateMissingRequiredFields() {
+	errs := loaders.ValidateSAMEBytes("same.yaml", []byte(`apiVersion: projectsame.io/v1alpha1
+metadata:
+  version: 0.0.1
+`))
+	paths := []string{}
+	for _, err := range errs {
+		paths = append(paths, err.Path)
+	}
+	assert.ElementsMatch(suite.T(), []string{"metadata.name", "pipeline.package"}, paths)
+}
+
+func (suite *UtilsSuite) Test_ValidateUnknownAPIVersion() {
+	errs := loaders.ValidateSAMEBytes("same.yaml", []byte(`apiVersion: projectsame.io/v9
+metadata:
+  name: Sample
+`))
+	assert.Len(suite.T(), errs, 1)
+	assert.Contains(suite.T(), errs[0].Message, "unsupported apiVersion")
+}
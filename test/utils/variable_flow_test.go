@@ -0,0 +1,163 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/azure-octo/same-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *UtilsSuite) Test_StepVariables() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	stepVariables, err := utils.StepVariablesFor(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+
+	// 'pd' and 'requests' aren't used after the first step, so aren't passed on. Later steps only get what the
+	// steps after them still use - 'count' isn't needed after the second step. 'values' and 'total' are made by
+	// the steps passing them on, and builtins like 'print' aren't variables.
+	assert.Equal(suite.T(), []string{"SVC", "count", "df", "model", "np"}, stepVariables["same_step_0"].Exports)
+	assert.Equal(suite.T(), []string{"SVC", "df", "model", "values"}, stepVariables["same_step_1"].Exports)
+	assert.Equal(suite.T(), []string{"SVC", "count", "df", "model", "np"}, stepVariables["same_step_1"].Loads)
+	assert.Equal(suite.T(), []string{"total"}, stepVariables["same_step_2"].Exports)
+	assert.Equal(suite.T(), []string{}, stepVariables["same_step_3"].Exports)
+	assert.False(suite.T(), stepVariables["same_step_0"].ExportsAll)
+
+	flows, err := utils.VariableFlows(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []utils.VariableFlow{
+		{From: "same_step_0", To: "same_step_1", Variables: []string{"SVC", "count", "df", "model"}},
+		{From: "same_step_1", To: "same_step_2", Variables: []string{"SVC", "df", "model", "values"}},
+		{From: "same_step_2", To: "same_step_3", Variables: []string{"total"}},
+	}, flows)
+}
+
+func (suite *UtilsSuite) Test_StepVariablesOfParallelSteps() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(dagCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	// 'data' isn't used by the training step, so isn't passed to it by either of the feature steps
+	flows, err := utils.VariableFlows(aggregatedSteps)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []utils.VariableFlow{
+		{From: "same_step_0", To: "same_step_1", Variables: []string{"data"}},
+		{From: "same_step_0", To: "same_step_2", Variables: []string{"data"}},
+		{From: "same_step_1", To: "same_step_3", Variables: []string{"features_a"}},
+		{From: "same_step_2", To: "same_step_3", Variables: []string{"features_b"}},
+	}, flows)
+}
+
+func (suite *UtilsSuite) Test_StepVariablesTracing() {
+	used := map[string][]string{
+		"print(total)\n": {"total"},
+		"print(f'{total:.{width}f} {{literal}}')": {"total", "width"},
+		"print(rf\"{df['a']!r}\")\n":              {"df"},
+		"for row in rows:\n    pass\n":            {"rows"},
+		"x.total = 1\n":                           {"x"},
+		"print(f\"{a != b:{'>'}10} {c}\")\n":      {"a", "b", "c"},
+		// Names are only read from the context until the step binds them, unless it might not have
+		"url = 'x'\nprint(url)\n":          {},
+		"x = x + 1\n":                      {"x"},
+		"x += 1\n":                         {"x"},
+		"a[i] = 1\n":                       {"a", "i"},
+		"if a:\n    url = 1\nprint(url)\n": {"a", "url"},
+		"for i in range(3):\n    url = i\nprint(url, i)\n":  {"i", "url"},
+		"def f():\n    return url\nurl = 1\nf()\n":          {"url"},
+		"import os\nx = os.sep\ndel x\nprint(x)\n":          {"x"},
+		"print(a, sep=b)\n":                                 {"a", "b"},
+		"def f(x, y=z):\n    w = x + y\n    return w + v\n": {"v", "z"},
+		"def f():\n    global g\n    g = 1\n    return g\n": {"g"},
+	}
+	for source, expected := range used {
+		stepVariables, err := utils.StepVariablesFor(map[string]utils.CodeBlock{
+			"same_step_0": {StepIdentifier: "same_step_0", Code: "pass"},
+			"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: source, Parents: []string{"same_step_0"}},
+		})
+		assert.NoError(suite.T(), err, source)
+		assert.Equal(suite.T(), expected, stepVariables["same_step_0"].Exports, source)
+		assert.False(suite.T(), stepVariables["same_step_0"].ExportsAll, source)
+	}
+
+	// Builtins are only passed on when a step binds them itself
+	stepVariables, err := utils.StepVariablesFor(map[string]utils.CodeBlock{
+		"same_step_0": {StepIdentifier: "same_step_0", Code: "sum = 0"},
+		"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: "print(sum, len([]))", Parents: []string{"same_step_0"}},
+	})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"sum"}, stepVariables["same_step_0"].Exports)
+
+	// A step binding a variable passes on its own value, unless it continues on failure, when the steps after it
+	// may get the context it started from instead
+	for _, continueOnFailure := range []string{"false", "true"} {
+		stepVariables, err := utils.StepVariablesFor(map[string]utils.CodeBlock{
+			"same_step_0": {StepIdentifier: "same_step_0", Code: "url = 'a'"},
+			"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: "url = 'b'", Parents: []string{"same_step_0"}, FailurePolicy: utils.StepFailurePolicy{ContinueOnFailure: continueOnFailure}},
+			"same_step_2": {StepIdentifier: "same_step_2", Index: 2, Code: "print(url)", Parents: []string{"same_step_1"}},
+		})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []string{"url"}, stepVariables["same_step_1"].Exports, continueOnFailure)
+		if continueOnFailure == "true" {
+			assert.Equal(suite.T(), []string{"url"}, stepVariables["same_step_0"].Exports)
+			assert.Equal(suite.T(), []string{"url"}, stepVariables["same_step_1"].Loads)
+		} else {
+			assert.Equal(suite.T(), []string{}, stepVariables["same_step_0"].Exports)
+			assert.Equal(suite.T(), []string{}, stepVariables["same_step_1"].Loads)
+		}
+	}
+
+	// Steps reading variables by names only known when they run get every variable
+	for _, source := range []string{"print(globals()['x'])", "eval('x + 1')", "print(vars())", "print(locals())"} {
+		stepVariables, err := utils.StepVariablesFor(map[string]utils.CodeBlock{
+			"same_step_0": {StepIdentifier: "same_step_0", Code: "pass"},
+			"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: source, Parents: []string{"same_step_0"}},
+			"same_step_2": {StepIdentifier: "same_step_2", Index: 2, Code: "pass", Parents: []string{"same_step_1"}},
+		})
+		assert.NoError(suite.T(), err, source)
+		assert.True(suite.T(), stepVariables["same_step_0"].ExportsAll, source)
+		assert.True(suite.T(), stepVariables["same_step_1"].LoadsAll, source)
+		assert.False(suite.T(), stepVariables["same_step_1"].ExportsAll, source)
+	}
+	for _, source := range []string{"print(vars(x))", "print(dir(x))", "x.eval()"} {
+		stepVariables, err := utils.StepVariablesFor(map[string]utils.CodeBlock{
+			"same_step_0": {StepIdentifier: "same_step_0", Code: "pass"},
+			"same_step_1": {StepIdentifier: "same_step_1", Index: 1, Code: source, Parents: []string{"same_step_0"}},
+		})
+		assert.NoError(suite.T(), err, source)
+		assert.False(suite.T(), stepVariables["same_step_0"].ExportsAll, source)
+	}
+}
+
+func (suite *UtilsSuite) Test_StepFilesPassOnlyUsedVariables() {
+	c := &utils.CompileLive{}
+	foundSteps, err := c.FindAllSteps(packagesCells)
+	assert.NoError(suite.T(), err)
+	aggregatedSteps, err := c.CombineCodeSlicesToSteps(foundSteps)
+	assert.NoError(suite.T(), err)
+
+	for _, target := range []string{"kubeflow", "aml"} {
+		compiledDir, err := ioutil.TempDir("", "same-variables-")
+		assert.NoError(suite.T(), err)
+		defer os.RemoveAll(compiledDir)
+
+		_, err = c.WriteStepFiles(target, compiledDir, aggregatedSteps)
+		assert.NoError(suite.T(), err, target)
+
+		stepFile := filepath.Join(compiledDir, "same_step_2.py")
+		if target == "aml" {
+			stepFile = filepath.Join(compiledDir, "same_step_2", "same_step_2.py")
+		}
+		stepBytes, err := ioutil.ReadFile(stepFile)
+		assert.NoError(suite.T(), err, target)
+		assert.Contains(suite.T(), string(stepBytes), `__loads = frozenset(["SVC", "df", "model", "values", ])`, target)
+		assert.Contains(suite.T(), string(stepBytes), `__exports = frozenset(["total", ])`, target)
+	}
+}